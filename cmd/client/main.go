@@ -4,21 +4,33 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	service "homework-1/internal/api"
 	"homework-1/internal/utils"
 	"homework-1/pkg/api/proto/orders_grpc/v1/orders_grpc/v1"
 	"log"
 	"os"
 	"strings"
+	"time"
 )
 
 const (
 	target = "localhost:50051"
+
+	// operatorTokenEnv Токен сотрудника, выпускается командой operator-token.
+	operatorTokenEnv = "PVZ_OPERATOR_TOKEN"
 )
 
 func main() {
+	token := os.Getenv(operatorTokenEnv)
+	if token == "" {
+		log.Fatalf("%s is not set, issue a token with: go run ./cmd/operator-token -operator <name>", operatorTokenEnv)
+	}
+
 	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		log.Fatal(err)
@@ -28,7 +40,7 @@ func main() {
 
 	client := orders_grpc.NewOrdersServiceClient(conn)
 
-	ctx := context.Background()
+	ctx := metadata.AppendToOutgoingContext(context.Background(), service.AuthorizationMetadataKey, "Bearer "+token)
 
 	runClient(ctx, client)
 }
@@ -60,24 +72,55 @@ func runClient(ctx context.Context, client orders_grpc.OrdersServiceClient) {
 func proceedCommand(ctx context.Context, req interface{}, client orders_grpc.OrdersServiceClient) {
 	switch req.(type) {
 	case *orders_grpc.AddOrderRequest:
-		_, errAdd := client.AddOrder(ctx, req.(*orders_grpc.AddOrderRequest))
+		resp, errAdd := client.AddOrder(ctx, req.(*orders_grpc.AddOrderRequest))
 		if errAdd != nil {
 			st := status.Convert(errAdd)
-			log.Printf("Ошибка добавления заказа: %v, %v", st.Code(), st.Message())
+			log.Printf("Ошибка добавления заказа: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
+		}
+		log.Printf("Заказ добавлен успешно, ID: %d\n", resp.GetOrderId())
+		if resp.GetCell() != "" {
+			log.Printf("Положите заказ в ячейку %s\n", resp.GetCell())
 		}
-		log.Println("Заказ добавлен успешно")
 	case *orders_grpc.ReturnOrderRequest:
 		_, errReturn := client.ReturnOrder(ctx, req.(*orders_grpc.ReturnOrderRequest))
 		if errReturn != nil {
 			st := status.Convert(errReturn)
-			log.Printf("Ошибка возврата заказа: %v, %v", st.Code(), st.Message())
+			log.Printf("Ошибка возврата заказа: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
 		}
 		log.Println("Заказ возвращен успешно")
+	case *orders_grpc.MoveOrderRequest:
+		resp, errMove := client.MoveOrder(ctx, req.(*orders_grpc.MoveOrderRequest))
+		if errMove != nil {
+			st := status.Convert(errMove)
+			log.Printf("Ошибка перемещения заказа: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
+		}
+		log.Printf("Заказ перемещен: %v\n", resp.GetOrder())
 	case *orders_grpc.ReceiveOrdersRequest:
 		resp, errReceive := client.ReceiveOrders(ctx, req.(*orders_grpc.ReceiveOrdersRequest))
 		if errReceive != nil {
 			st := status.Convert(errReceive)
-			log.Printf("Ошибка получения заказов: %v, %v", st.Code(), st.Message())
+			log.Printf("Ошибка получения заказов: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
+		}
+		for _, order := range resp.GetOrders() {
+			log.Printf("Заказ: %v\n", order)
+		}
+		if len(resp.GetPickCells()) > 0 {
+			log.Printf("Заберите заказы из ячеек: %s\n", strings.Join(resp.GetPickCells(), ", "))
+		}
+	case *orders_grpc.StartTryOnRequest:
+		resp, errTryOn := client.StartTryOn(ctx, req.(*orders_grpc.StartTryOnRequest))
+		if errTryOn != nil {
+			st := status.Convert(errTryOn)
+			log.Printf("Ошибка выдачи заказов на примерку: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
+		}
+		for _, order := range resp.GetOrders() {
+			log.Printf("Заказ на примерке до %s: %v\n", order.GetTryOnUntil().AsTime().Local().Format(time.DateTime), order)
+		}
+	case *orders_grpc.ConfirmTryOnRequest:
+		resp, errConfirm := client.ConfirmTryOn(ctx, req.(*orders_grpc.ConfirmTryOnRequest))
+		if errConfirm != nil {
+			st := status.Convert(errConfirm)
+			log.Printf("Ошибка завершения примерки: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
 		}
 		for _, order := range resp.GetOrders() {
 			log.Printf("Заказ: %v\n", order)
@@ -87,26 +130,117 @@ func proceedCommand(ctx context.Context, req interface{}, client orders_grpc.Ord
 		resp, errGet := client.GetOrders(ctx, req.(*orders_grpc.GetOrdersRequest))
 		if errGet != nil {
 			st := status.Convert(errGet)
-			log.Printf("Ошибка получения заказов: %v, %v", st.Code(), st.Message())
+			log.Printf("Ошибка получения заказов: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
 		}
 		for _, order := range resp.GetOrders() {
 			log.Printf("Заказ: %v\n", order)
 		}
+		if resp.GetNextPageToken() != "" {
+			log.Printf("Следующая страница: %s\n", resp.GetNextPageToken())
+		}
 	case *orders_grpc.CreateRefundRequest:
-		_, errRefund := client.CreateRefund(ctx, req.(*orders_grpc.CreateRefundRequest))
+		resp, errRefund := client.CreateRefund(ctx, req.(*orders_grpc.CreateRefundRequest))
 		if errRefund != nil {
 			st := status.Convert(errRefund)
-			log.Printf("Ошибка создания возврата: %v, %v", st.Code(), st.Message())
+			log.Printf("Ошибка создания возврата: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
+		}
+		log.Printf("Возврат создан успешно, ID: %d\n", resp.GetRefund().GetRefundId())
+	case *orders_grpc.DecideRefundRequest:
+		resp, errDecide := client.DecideRefund(ctx, req.(*orders_grpc.DecideRefundRequest))
+		if errDecide != nil {
+			st := status.Convert(errDecide)
+			log.Printf("Ошибка решения по возврату: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
+		}
+		log.Printf("Возврат: %v\n", resp.GetRefund())
+	case *orders_grpc.GetRefundRequest:
+		resp, errGetRefund := client.GetRefund(ctx, req.(*orders_grpc.GetRefundRequest))
+		if errGetRefund != nil {
+			st := status.Convert(errGetRefund)
+			log.Printf("Ошибка получения возврата: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
+		}
+		log.Printf("Возврат: %v\n", resp.GetRefund())
+		for _, event := range resp.GetEvents() {
+			log.Printf("Событие: %v\n", event)
 		}
-		log.Println("Возврат создан успешно")
 	case *orders_grpc.GetRefundsRequest:
 		resp, errGetRefunds := client.GetRefunds(ctx, req.(*orders_grpc.GetRefundsRequest))
 		if errGetRefunds != nil {
 			st := status.Convert(errGetRefunds)
-			log.Printf("Ошибка получения возвратов: %v, %v", st.Code(), st.Message())
+			log.Printf("Ошибка получения возвратов: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
 		}
 		for _, refund := range resp.GetRefunds() {
 			log.Printf("Возврат: %v\n", refund)
 		}
+		log.Printf("Всего возвратов: %d\n", resp.GetTotalCount())
+		if resp.GetNextPageToken() != "" {
+			log.Printf("Следующая страница: %s\n", resp.GetNextPageToken())
+		}
+	case *orders_grpc.GetOrderHistoryRequest:
+		resp, errHistory := client.GetOrderHistory(ctx, req.(*orders_grpc.GetOrderHistoryRequest))
+		if errHistory != nil {
+			st := status.Convert(errHistory)
+			log.Printf("Ошибка получения истории заказа: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
+		}
+		for _, event := range resp.GetEvents() {
+			log.Printf("Событие: %v\n", event)
+		}
+	case *orders_grpc.ListPackageTypesRequest:
+		resp, errList := client.ListPackageTypes(ctx, req.(*orders_grpc.ListPackageTypesRequest))
+		if errList != nil {
+			st := status.Convert(errList)
+			log.Printf("Ошибка получения каталога упаковок: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
+		}
+		for _, pack := range resp.GetPackageTypes() {
+			log.Printf("Упаковка: %v\n", pack)
+		}
+	case *orders_grpc.CreatePackageTypeRequest:
+		resp, errCreate := client.CreatePackageType(ctx, req.(*orders_grpc.CreatePackageTypeRequest))
+		if errCreate != nil {
+			st := status.Convert(errCreate)
+			log.Printf("Ошибка добавления упаковки: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
+		}
+		log.Printf("Упаковка добавлена: %v\n", resp.GetPackageType())
+	case *orders_grpc.UpdatePackageTypeRequest:
+		resp, errUpdate := client.UpdatePackageType(ctx, req.(*orders_grpc.UpdatePackageTypeRequest))
+		if errUpdate != nil {
+			st := status.Convert(errUpdate)
+			log.Printf("Ошибка изменения упаковки: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
+		}
+		log.Printf("Упаковка изменена: %v\n", resp.GetPackageType())
+	case *orders_grpc.RetirePackageTypeRequest:
+		resp, errRetire := client.RetirePackageType(ctx, req.(*orders_grpc.RetirePackageTypeRequest))
+		if errRetire != nil {
+			st := status.Convert(errRetire)
+			log.Printf("Ошибка вывода упаковки из оборота: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
+		}
+		log.Printf("Упаковка выведена из оборота: %v\n", resp.GetPackageType())
+	case *orders_grpc.GetQuoteRequest:
+		resp, errQuote := client.GetQuote(ctx, req.(*orders_grpc.GetQuoteRequest))
+		if errQuote != nil {
+			st := status.Convert(errQuote)
+			log.Printf("Ошибка подбора упаковки: %v, %v%s", st.Code(), st.Message(), describeDetails(st))
+		}
+		if errQuote == nil && len(resp.GetOptions()) == 0 {
+			log.Println("Заказ не подходит ни одной упаковке")
+		}
+		for _, option := range resp.GetOptions() {
+			log.Printf("Вариант: %v\n", option)
+		}
+	}
+}
+
+// describeDetails Добавляет к сообщению код причины и нарушенные поля из деталей статуса.
+func describeDetails(st *status.Status) string {
+	var b strings.Builder
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			fmt.Fprintf(&b, " [%s]", d.GetReason())
+		case *errdetails.BadRequest:
+			for _, violation := range d.GetFieldViolations() {
+				fmt.Fprintf(&b, " (поле %s)", violation.GetField())
+			}
+		}
 	}
+	return b.String()
 }
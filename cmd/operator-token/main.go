@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"homework-1/internal/config"
+	"homework-1/internal/models"
+	"homework-1/internal/services/operatortoken"
+	"log"
+	"time"
+)
+
+const cfgPath = "config/config.yaml"
+
+// Выпускает токен сотрудника пункта выдачи с секретом сервера. Токен передается клиентом
+// в переменной окружения PVZ_OPERATOR_TOKEN, а через HTTP-шлюз в заголовке Authorization: Bearer <токен>.
+func main() {
+	operator := flag.String("operator", "", "идентификатор сотрудника пункта выдачи")
+	admin := flag.Bool("admin", false, "выдать роль администратора, которая меняет каталог упаковок")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig(cfgPath)
+	if err != nil {
+		log.Fatalf("error while reading config: %v", err)
+	}
+
+	tokens, err := operatortoken.New(cfg.OperatorTokenConfig)
+	if err != nil {
+		log.Fatalf("failed to configure operator tokens: %v", err)
+	}
+
+	role := operatortoken.RoleOperator
+	if *admin {
+		role = operatortoken.RoleAdmin
+	}
+
+	token, err := tokens.Issue(operatortoken.Claims{Operator: models.Operator(*operator), Role: role}, time.Now())
+	if err != nil {
+		log.Fatalf("failed to issue operator token: %v", err)
+	}
+
+	fmt.Println(token)
+}
@@ -8,7 +8,18 @@ import (
 	"homework-1/internal/cache"
 	"homework-1/internal/config"
 	"homework-1/internal/http"
+	"homework-1/internal/infrastructure/kafka"
+	"homework-1/internal/infrastructure/messaging"
+	"homework-1/internal/infrastructure/outbox"
 	"homework-1/internal/module"
+	"homework-1/internal/services/expiry"
+	"homework-1/internal/services/intake"
+	"homework-1/internal/services/operatortoken"
+	"homework-1/internal/services/packaging"
+	"homework-1/internal/services/pickupcode"
+	"homework-1/internal/services/pickuppoint"
+	"homework-1/internal/services/policy"
+	"homework-1/internal/services/tryon"
 	"homework-1/internal/storage"
 	"homework-1/internal/tracing"
 	"homework-1/pkg/api/proto/orders_grpc/v1/orders_grpc/v1"
@@ -17,6 +28,7 @@ import (
 	"os"
 	"sync"
 	"time"
+	_ "time/tzdata"
 )
 
 const (
@@ -30,49 +42,130 @@ func main() {
 	defer cancel()
 
 	cfg := getConfig()
-	s := initDB(cfg)
+	s := initDB(ctx, cfg)
+
+	points := pickuppoint.NewDirectory(s)
+
+	rules, errPolicy := policy.NewEngine(cfgPath)
+	if errPolicy != nil {
+		log.Fatalf("failed to load pickup point policy: %v", errPolicy)
+	}
+
+	tokens, errTokens := operatortoken.New(cfg.OperatorTokenConfig)
+	if errTokens != nil {
+		log.Fatalf("failed to configure operator tokens: %v", errTokens)
+	}
+
+	codes, errCodes := pickupcode.New(cfg.PickupCodeConfig)
+	if errCodes != nil {
+		log.Fatalf("failed to configure pickup codes: %v", errCodes)
+	}
 
 	ordersModule := module.NewModule(module.Deps{
-		Storage: s,
+		Storage:      s,
+		Points:       points,
+		Policy:       rules,
+		PickupCodes:  codes,
+		TryOnTimeout: time.Duration(cfg.TryOnConfig.TimeoutMinutes) * time.Minute,
 	})
 
-	orderService := initOrderService(ctx, cfg, ordersModule)
+	seeded, errSeed := ordersModule.SeedPackages(ctx, packaging.FromConfig(cfg.PackagingConfig))
+	if errSeed != nil {
+		log.Fatalf("failed to seed packaging catalog: %v", errSeed)
+	}
+	log.Printf("packaging catalog: %d package types added from config", seeded)
+
+	seededPoints, errSeedPoints := ordersModule.SeedPickupPoints(ctx, pickuppoint.FromConfig(cfg.PickupPoints))
+	if errSeedPoints != nil {
+		log.Fatalf("failed to seed pickup points: %v", errSeedPoints)
+	}
+	log.Printf("pickup points: %d points added from config", seededPoints)
+
+	redis := cache.MustNew(ctx, cfg.RedisConfig.Url, cfg.RedisConfig.Password, cfg.RedisConfig.DB, time.Duration(cfg.RedisConfig.TTL)*time.Second)
+	orderService := initOrderService(redis, ordersModule, points)
+	idempotency := cache.NewIdempotency(redis,
+		time.Duration(cfg.IdempotencyConfig.WindowSeconds)*time.Second,
+		time.Duration(cfg.IdempotencyConfig.PendingSeconds)*time.Second)
 
 	tracing.MustSetup(ctx, "orders-service")
 
 	var wg sync.WaitGroup
 
+	gateway, err := http.NewGateway(ctx, fmt.Sprintf("localhost:%d", grpcPort))
+	if err != nil {
+		log.Fatalf("failed to create gateway: %v", err)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		http.MustRun(ctx, 5*time.Second, fmt.Sprintf(":%d", cfg.HttpConfig.Port), gateway)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		runGrpc(orderService, tokens, ordersModule, idempotency)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		rules.Run(ctx, time.Duration(cfg.PolicyConfig.ReloadSeconds)*time.Second)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		runOutboxRelay(ctx, cfg, s)
+	}()
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		http.MustRun(ctx, 5*time.Second, fmt.Sprintf(":%d", cfg.HttpConfig.Port))
+		runDeliveryIntake(ctx, cfg, ordersModule, orderService.Redis)
 	}()
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		runGrpc(orderService)
+		sweeper := tryon.NewSweeper(tryon.Deps{Module: ordersModule, Redis: orderService.Redis},
+			time.Duration(cfg.TryOnConfig.IntervalSeconds)*time.Second, cfg.TryOnConfig.BatchSize)
+		sweeper.Run(ctx)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sweeper := expiry.NewSweeper(expiry.Deps{Module: ordersModule, Redis: orderService.Redis},
+			time.Duration(cfg.ExpiryConfig.IntervalSeconds)*time.Second, cfg.ExpiryConfig.BatchSize)
+		sweeper.Run(ctx)
 	}()
 
 	wg.Wait()
 }
 
-func initOrderService(ctx context.Context, cfg *config.Config, ordersModule *module.Module) *service.OrderService {
-	redis := cache.MustNew(ctx, cfg.RedisConfig.Url, cfg.RedisConfig.Password, cfg.RedisConfig.DB, time.Duration(cfg.RedisConfig.TTL)*time.Second)
+func initOrderService(redis cache.CacheInterface, ordersModule *module.Module, points pickuppoint.Provider) *service.OrderService {
 	ordersService := &service.OrderService{
 		Module: ordersModule,
 		Redis:  redis,
+		Points: points,
 	}
 	return ordersService
 }
 
-func runGrpc(ordersService *service.OrderService) {
+func runGrpc(ordersService *service.OrderService, tokens service.OperatorTokens, points service.OperatorPoints, idempotency cache.IdempotencyInterface) {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", grpcPort))
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		service.ErrorsUnaryInterceptor,
+		service.PointUnaryInterceptor(tokens, points),
+		service.ValidateUnaryInterceptor,
+		service.IdempotencyUnaryInterceptor(idempotency),
+	))
 	orders_grpc.RegisterOrdersServiceServer(grpcServer, ordersService)
 
 	if err = grpcServer.Serve(lis); err != nil {
@@ -80,6 +173,79 @@ func runGrpc(ordersService *service.OrderService) {
 	}
 }
 
+// runOutboxRelay Недоступность Kafka при старте не останавливает сервер: события копятся в outbox,
+// а подключение повторяется, пока не завершится контекст.
+func runOutboxRelay(ctx context.Context, cfg *config.Config, s storage.Storage) {
+	interval := time.Duration(cfg.OutboxConfig.IntervalMs) * time.Millisecond
+
+	producer, ok := connectKafka(ctx, interval, func() (*messaging.Producer, error) {
+		return messaging.NewKafkaProducer(cfg.KafkaConfig.Brokers)
+	})
+	if !ok {
+		return
+	}
+	defer producer.Close()
+
+	relay := outbox.NewRelay(outbox.Deps{
+		Storage:  s,
+		Sender:   kafka.NewKafkaSender(producer, cfg.KafkaConfig.Topic),
+		Notifier: kafka.NewKafkaSender(producer, cfg.KafkaConfig.PickupCodeTopic),
+	}, interval, cfg.OutboxConfig.BatchSize)
+	relay.Run(ctx)
+}
+
+// runDeliveryIntake Принимает манифесты поставок из Kafka и отвечает результатом приема каждой позиции.
+func runDeliveryIntake(ctx context.Context, cfg *config.Config, ordersModule module.ModuleInterface, redis cache.CacheInterface) {
+	interval := time.Duration(cfg.OutboxConfig.IntervalMs) * time.Millisecond
+
+	producer, ok := connectKafka(ctx, interval, func() (*messaging.Producer, error) {
+		return messaging.NewKafkaProducer(cfg.KafkaConfig.Brokers)
+	})
+	if !ok {
+		return
+	}
+	defer producer.Close()
+
+	consumer, ok := connectKafka(ctx, interval, func() (*messaging.Consumer, error) {
+		return messaging.NewKafkaConsumer(cfg.KafkaConfig.Brokers)
+	})
+	if !ok {
+		return
+	}
+	defer consumer.SingleConsumer.Close()
+
+	deliveryIntake := intake.NewIntake(intake.Deps{
+		Module: ordersModule,
+		Sender: kafka.NewKafkaSender(producer, cfg.KafkaConfig.ManifestReplyTopic),
+		Redis:  redis,
+	})
+
+	receiver := kafka.NewKafkaReceiver(consumer, cfg.KafkaConfig.ManifestTopic)
+	if err := receiver.SubscribeHandler(deliveryIntake.Handle); err != nil {
+		log.Printf("failed to subscribe to delivery manifests: %v", err)
+		return
+	}
+
+	<-ctx.Done()
+	receiver.Stop()
+}
+
+// connectKafka Повторяет подключение к Kafka с заданным интервалом, пока оно не удастся или не завершится контекст.
+func connectKafka[T any](ctx context.Context, interval time.Duration, connect func() (T, error)) (T, bool) {
+	client, err := connect()
+	for err != nil {
+		log.Printf("failed to connect to kafka, retrying in %s: %v", interval, err)
+		select {
+		case <-ctx.Done():
+			return client, false
+		case <-time.After(interval):
+		}
+		client, err = connect()
+	}
+
+	return client, true
+}
+
 func getConfig() *config.Config {
 	cfg, errCfg := config.LoadConfig(cfgPath)
 	if errCfg != nil {
@@ -89,13 +255,13 @@ func getConfig() *config.Config {
 	return cfg
 }
 
-func initDB(cfg *config.Config) *storage.PostgresDB {
+func initDB(ctx context.Context, cfg *config.Config) *storage.PostgresDB {
 	connUrl := fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable",
 		cfg.DatabaseConfig.User, cfg.DatabaseConfig.Password,
 		cfg.DatabaseConfig.Host, cfg.DatabaseConfig.Port,
 		cfg.DatabaseConfig.Name)
 
-	s, errStorage := storage.NewStorage(connUrl)
+	s, errStorage := storage.NewStorage(ctx, connUrl)
 	if errStorage != nil {
 		fmt.Printf("error while initializing storage: %s\n", errStorage)
 		os.Exit(1)
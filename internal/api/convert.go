@@ -0,0 +1,466 @@
+package api
+
+import (
+	"context"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"homework-1/internal/models"
+	"homework-1/internal/services/pickuppoint"
+	"homework-1/pkg/api/proto/orders_grpc/v1/orders_grpc/v1"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// costFromRequest Устаревшее поле cost переводится в копейки через десятичную запись, поэтому 10.1 становится ровно 1010,
+// а сумма с долями копеек отклоняется, а не округляется.
+func costFromRequest(ctx context.Context, request *orders_grpc.AddOrderRequest) (models.Money, error) {
+	if costMoney := request.GetCostMoney(); costMoney != nil {
+		return moneyFromProto(costMoney), nil
+	}
+
+	if request.GetCost() != 0 {
+		warnDeprecated(ctx, "cost", "cost_money")
+	}
+	return models.ParseMoney(strconv.FormatFloat(request.GetCost(), 'f', -1, 64), models.CurrencyRUB)
+}
+
+// expirationFromRequest Устаревшая строковая дата разбирается по местному времени пункта запроса, а не в UTC.
+func (o *OrderService) expirationFromRequest(ctx context.Context, request *orders_grpc.AddOrderRequest) (time.Time, error) {
+	if expiration := request.GetExpiration(); expiration != nil {
+		return expiration.AsTime(), nil
+	}
+
+	if request.GetExpirationTime() != "" {
+		warnDeprecated(ctx, "expiration_time", "expiration")
+	}
+
+	point, errPoint := pickuppoint.Current(ctx, o.Points, o.PickupPoint)
+	if errPoint != nil {
+		return time.Time{}, errPoint
+	}
+	return point.ParseDate(request.GetExpirationTime())
+}
+
+// packagingFromRequest Слои из package_layers важнее одиночного package_type. В package_type можно передать и
+// несколько слоев через +, как их показывает Order.package_type.
+func packagingFromRequest(request *orders_grpc.AddOrderRequest) models.Packaging {
+	if layers := request.GetPackageLayers(); len(layers) > 0 {
+		return packagingFromProto(layers)
+	}
+	return models.ParsePackaging(request.GetPackageType())
+}
+
+func packagingFromProto(layers []string) models.Packaging {
+	packaging := make(models.Packaging, 0, len(layers))
+	for _, layer := range layers {
+		packaging = append(packaging, models.PackageType(layer))
+	}
+	return packaging
+}
+
+func packagingToProto(packaging models.Packaging) []string {
+	layers := make([]string, 0, len(packaging))
+	for _, layer := range packaging {
+		layers = append(layers, string(layer))
+	}
+	return layers
+}
+
+// pickCells Ячейки выданных заказов по порядку адресов: так сотрудник обходит стеллажи за один проход.
+func pickCells(orders []models.Order) []string {
+	seen := make(map[models.CellCode]struct{}, len(orders))
+	var cells []string
+	for _, order := range orders {
+		if order.PickedFrom == "" {
+			continue
+		}
+		if _, ok := seen[order.PickedFrom]; ok {
+			continue
+		}
+		seen[order.PickedFrom] = struct{}{}
+		cells = append(cells, string(order.PickedFrom))
+	}
+	sort.Strings(cells)
+	return cells
+}
+
+// externalFromRequest Устаревший order_id в AddOrder принимается как внешний номер с источником legacy:
+// так сохраняется поведение клиентов, которые передавали номер заказа продавца в order_id.
+func externalFromRequest(ctx context.Context, request *orders_grpc.AddOrderRequest) models.ExternalRef {
+	if external := request.GetExternal(); external != nil {
+		return externalFromProto(external)
+	}
+
+	warnDeprecated(ctx, "order_id", "external")
+	return models.ExternalRef{Source: models.LegacySource, Number: strconv.FormatInt(request.GetOrderId(), 10)}
+}
+
+// resolveOrderID Возвращает идентификатор заказа, переданного в запросе идентификатором или внешним номером.
+// order_id не ищется среди номеров legacy: такой номер может совпасть с идентификатором другого заказа.
+func (o *OrderService) resolveOrderID(ctx context.Context, orderId int64, external *orders_grpc.ExternalOrderRef) (models.ID, error) {
+	if external == nil {
+		return models.ID(orderId), nil
+	}
+
+	return o.Module.ResolveOrderID(ctx, externalFromProto(external))
+}
+
+// resolveOrderIDs Идентификаторы пачки заказов: сначала переданные идентификаторами, затем внешними номерами.
+func (o *OrderService) resolveOrderIDs(ctx context.Context, orderIds []int64, externals []*orders_grpc.ExternalOrderRef) ([]models.ID, error) {
+	ids := make([]models.ID, 0, len(orderIds)+len(externals))
+	for _, id := range orderIds {
+		ids = append(ids, models.ID(id))
+	}
+	for _, external := range externals {
+		id, errResolve := o.resolveOrderID(ctx, 0, external)
+		if errResolve != nil {
+			return nil, errResolve
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func externalFromProto(ref *orders_grpc.ExternalOrderRef) models.ExternalRef {
+	return models.ExternalRef{Source: ref.GetSource(), Number: ref.GetNumber()}
+}
+
+func externalToProto(ref models.ExternalRef) *orders_grpc.ExternalOrderRef {
+	if ref == (models.ExternalRef{}) {
+		return nil
+	}
+
+	return &orders_grpc.ExternalOrderRef{Source: ref.Source, Number: ref.Number}
+}
+
+var (
+	orderSorts = map[orders_grpc.OrderSort]models.OrderSort{
+		orders_grpc.OrderSort_ORDER_SORT_UNSPECIFIED:     models.SortByExpiration,
+		orders_grpc.OrderSort_ORDER_SORT_EXPIRATION_TIME: models.SortByExpiration,
+		orders_grpc.OrderSort_ORDER_SORT_RECEIVED_TIME:   models.SortByReceived,
+	}
+	orderStates = map[orders_grpc.OrderState]models.OrderState{
+		orders_grpc.OrderState_ORDER_STATE_UNSPECIFIED: "",
+		orders_grpc.OrderState_ORDER_STATE_AT_POINT:    models.StateAtPoint,
+		orders_grpc.OrderState_ORDER_STATE_RECEIVED:    models.StateReceived,
+		orders_grpc.OrderState_ORDER_STATE_REFUNDED:    models.StateRefunded,
+	}
+)
+
+func ordersQueryFromRequest(request *orders_grpc.GetOrdersRequest) (models.OrdersQuery, error) {
+	query := models.OrdersQuery{
+		CustomerID: models.ID(request.GetCustomerId()),
+		Limit:      int(request.GetN()),
+		Sort:       orderSorts[request.GetSort()],
+		Descending: request.GetDescending(),
+		State:      orderStates[request.GetState()],
+		Package:    models.PackageType(request.GetPackageType()),
+	}
+
+	if token := request.GetPageToken(); token != "" {
+		var cursor models.OrdersCursor
+		if err := decodePageToken(token, &cursor); err != nil {
+			return models.OrdersQuery{}, err
+		}
+		query.After = &cursor
+	}
+
+	return query, nil
+}
+
+// isFullOrdersList Запрос всех заказов клиента в порядке по умолчанию, как до появления страниц и фильтров.
+func isFullOrdersList(query models.OrdersQuery) bool {
+	return query.Limit == 0 && query.After == nil && query.Sort == models.SortByExpiration &&
+		!query.Descending && query.State == "" && query.Package == ""
+}
+
+func ordersPageToProto(page models.OrdersPage) (*orders_grpc.GetOrdersResponse, error) {
+	resp := &orders_grpc.GetOrdersResponse{}
+	for _, order := range page.Orders {
+		resp.Orders = append(resp.Orders, orderToProto(order))
+	}
+
+	if page.Next != nil {
+		token, err := encodePageToken(page.Next)
+		if err != nil {
+			return nil, err
+		}
+		resp.NextPageToken = token
+	}
+
+	return resp, nil
+}
+
+// refundsQueryFromRequest Номер страницы переводится в смещение, только если клиент не передал page_token.
+func refundsQueryFromRequest(ctx context.Context, request *orders_grpc.GetRefundsRequest) (models.RefundsQuery, error) {
+	query := models.RefundsQuery{Limit: int(request.GetLimit())}
+
+	if request.GetFrom() != nil {
+		query.From = request.GetFrom().AsTime()
+	}
+	if request.GetTo() != nil {
+		query.To = request.GetTo().AsTime()
+	}
+
+	if token := request.GetPageToken(); token != "" {
+		var cursor models.RefundsCursor
+		if err := decodePageToken(token, &cursor); err != nil {
+			return models.RefundsQuery{}, err
+		}
+		query.After = &cursor
+		return query, nil
+	}
+
+	if request.GetPage() > 0 {
+		warnDeprecated(ctx, "page", "page_token")
+		query.Offset = int(request.GetPage()) * query.Limit
+	}
+
+	return query, nil
+}
+
+func refundsPageToProto(page models.RefundsPage) (*orders_grpc.GetRefundsResponse, error) {
+	resp := &orders_grpc.GetRefundsResponse{TotalCount: int64(page.Total)}
+	for _, refund := range page.Refunds {
+		resp.Refunds = append(resp.Refunds, orderToProto(refund))
+	}
+
+	if page.Next != nil {
+		token, err := encodePageToken(page.Next)
+		if err != nil {
+			return nil, err
+		}
+		resp.NextPageToken = token
+	}
+
+	return resp, nil
+}
+
+func moneyFromProto(money *orders_grpc.Money) models.Money {
+	currency := models.Currency(money.GetCurrency())
+	if currency == "" {
+		currency = models.CurrencyRUB
+	}
+
+	return models.NewMoney(money.GetAmountMinor(), currency)
+}
+
+func moneyToProto(money models.Money) *orders_grpc.Money {
+	return &orders_grpc.Money{
+		AmountMinor: money.Amount,
+		Currency:    string(money.Currency),
+	}
+}
+
+// orderToProto Поля cost и pack_cost заполняются для старых клиентов и могут быть неточными, точная сумма в полях Money.
+func orderToProto(order models.Order) *orders_grpc.Order {
+	resp := &orders_grpc.Order{
+		OrderId:        int64(order.OrderID),
+		CustomerId:     int64(order.CustomerID),
+		ExpirationTime: timestamppb.New(order.ExpirationTime),
+		Received:       order.ReceivedByCustomer,
+		Refunded:       order.Refunded,
+		PackageType:    order.Package.String(),
+		PackageLayers:  packagingToProto(order.Package),
+		Weight:         float64(order.Weight),
+		Cost:           float64(order.Cost.Amount) / 100,
+		PackCost:       float64(order.PackageCost.Amount) / 100,
+		CostMoney:      moneyToProto(order.Cost),
+		PackCostMoney:  moneyToProto(order.PackageCost),
+		External:       externalToProto(order.External),
+		Cell:           string(order.Cell),
+	}
+
+	if total, err := order.GetTotalCost(); err == nil {
+		resp.TotalCost = moneyToProto(total)
+	}
+	if order.Refunded {
+		resp.RefundedTime = timestamppb.New(order.RefundedTime)
+	}
+	if !order.TryOnUntil.IsZero() {
+		resp.TryOnUntil = timestamppb.New(order.TryOnUntil)
+	}
+	for _, item := range order.Items {
+		resp.Items = append(resp.Items, itemToProto(item))
+	}
+
+	return resp
+}
+
+// itemsFromProto Идентификатор и состояние товара назначает сервис, в запросе они не учитываются.
+func itemsFromProto(items []*orders_grpc.OrderItem) []models.OrderItem {
+	if len(items) == 0 {
+		return nil
+	}
+	result := make([]models.OrderItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, models.OrderItem{
+			SKU:   item.GetSku(),
+			Name:  item.GetName(),
+			Price: moneyFromProto(item.GetPrice()),
+		})
+	}
+	return result
+}
+
+func itemToProto(item models.OrderItem) *orders_grpc.OrderItem {
+	return &orders_grpc.OrderItem{
+		ItemId: int64(item.ID),
+		Sku:    item.SKU,
+		Name:   item.Name,
+		Price:  moneyToProto(item.Price),
+		Status: itemStatusesToProto[item.Status],
+	}
+}
+
+func idsFromProto(ids []int64) []models.ID {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]models.ID, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, models.ID(id))
+	}
+	return result
+}
+
+func idsToProto(ids []models.ID) []int64 {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, int64(id))
+	}
+	return result
+}
+
+var (
+	refundReasons = map[orders_grpc.RefundReason]models.RefundReason{
+		orders_grpc.RefundReason_REFUND_REASON_UNSPECIFIED:      models.RefundReasonOther,
+		orders_grpc.RefundReason_REFUND_REASON_DAMAGED:          models.RefundReasonDamaged,
+		orders_grpc.RefundReason_REFUND_REASON_WRONG_ITEM:       models.RefundReasonWrongItem,
+		orders_grpc.RefundReason_REFUND_REASON_NOT_AS_DESCRIBED: models.RefundReasonNotAsDescribed,
+		orders_grpc.RefundReason_REFUND_REASON_DEFECTIVE:        models.RefundReasonDefective,
+		orders_grpc.RefundReason_REFUND_REASON_CHANGED_MIND:     models.RefundReasonChangedMind,
+		orders_grpc.RefundReason_REFUND_REASON_OTHER:            models.RefundReasonOther,
+	}
+	refundReasonsToProto = map[models.RefundReason]orders_grpc.RefundReason{
+		models.RefundReasonDamaged:        orders_grpc.RefundReason_REFUND_REASON_DAMAGED,
+		models.RefundReasonWrongItem:      orders_grpc.RefundReason_REFUND_REASON_WRONG_ITEM,
+		models.RefundReasonNotAsDescribed: orders_grpc.RefundReason_REFUND_REASON_NOT_AS_DESCRIBED,
+		models.RefundReasonDefective:      orders_grpc.RefundReason_REFUND_REASON_DEFECTIVE,
+		models.RefundReasonChangedMind:    orders_grpc.RefundReason_REFUND_REASON_CHANGED_MIND,
+		models.RefundReasonOther:          orders_grpc.RefundReason_REFUND_REASON_OTHER,
+	}
+	refundStatesToProto = map[models.RefundState]orders_grpc.RefundState{
+		models.RefundRequested:       orders_grpc.RefundState_REFUND_STATE_REQUESTED,
+		models.RefundInspected:       orders_grpc.RefundState_REFUND_STATE_INSPECTED,
+		models.RefundApproved:        orders_grpc.RefundState_REFUND_STATE_APPROVED,
+		models.RefundRejected:        orders_grpc.RefundState_REFUND_STATE_REJECTED,
+		models.RefundHandedToCourier: orders_grpc.RefundState_REFUND_STATE_HANDED_TO_COURIER,
+	}
+	packageKinds = map[orders_grpc.PackageKind]models.PackageKind{
+		orders_grpc.PackageKind_PACKAGE_KIND_UNSPECIFIED: models.PackageKindContainer,
+		orders_grpc.PackageKind_PACKAGE_KIND_CONTAINER:   models.PackageKindContainer,
+		orders_grpc.PackageKind_PACKAGE_KIND_WRAPPING:    models.PackageKindWrapping,
+	}
+	packageKindsToProto = map[models.PackageKind]orders_grpc.PackageKind{
+		models.PackageKindContainer: orders_grpc.PackageKind_PACKAGE_KIND_CONTAINER,
+		models.PackageKindWrapping:  orders_grpc.PackageKind_PACKAGE_KIND_WRAPPING,
+	}
+	itemStatusesToProto = map[models.ItemStatus]orders_grpc.ItemStatus{
+		models.ItemAtPoint:           orders_grpc.ItemStatus_ITEM_STATUS_AT_POINT,
+		models.ItemIssued:            orders_grpc.ItemStatus_ITEM_STATUS_ISSUED,
+		models.ItemDeclined:          orders_grpc.ItemStatus_ITEM_STATUS_DECLINED,
+		models.ItemRefundRequested:   orders_grpc.ItemStatus_ITEM_STATUS_REFUND_REQUESTED,
+		models.ItemRefunded:          orders_grpc.ItemStatus_ITEM_STATUS_REFUNDED,
+		models.ItemReturnedToCourier: orders_grpc.ItemStatus_ITEM_STATUS_RETURNED_TO_COURIER,
+	}
+	refundDecisions = map[orders_grpc.RefundDecision]models.RefundDecision{
+		orders_grpc.RefundDecision_REFUND_DECISION_INSPECT: models.DecisionInspect,
+		orders_grpc.RefundDecision_REFUND_DECISION_APPROVE: models.DecisionApprove,
+		orders_grpc.RefundDecision_REFUND_DECISION_REJECT:  models.DecisionReject,
+	}
+	tryOnOutcomes = map[orders_grpc.TryOnOutcome]models.TryOnOutcome{
+		orders_grpc.TryOnOutcome_TRY_ON_OUTCOME_KEEP:   models.TryOnKeep,
+		orders_grpc.TryOnOutcome_TRY_ON_OUTCOME_REJECT: models.TryOnReject,
+		orders_grpc.TryOnOutcome_TRY_ON_OUTCOME_RETURN: models.TryOnReturn,
+	}
+)
+
+func refundToProto(refund models.Refund) *orders_grpc.Refund {
+	return &orders_grpc.Refund{
+		RefundId:   int64(refund.ID),
+		OrderId:    int64(refund.OrderID),
+		CustomerId: int64(refund.CustomerID),
+		Reason:     refundReasonsToProto[refund.Reason],
+		Comment:    refund.Comment,
+		State:      refundStatesToProto[refund.State],
+		Amount:     moneyToProto(refund.Amount),
+		ItemIds:    idsToProto(refund.Items),
+		CreatedAt:  timestamppb.New(refund.CreatedAt),
+		UpdatedAt:  timestamppb.New(refund.UpdatedAt),
+	}
+}
+
+func refundChangeToProto(change models.RefundChange) *orders_grpc.RefundEvent {
+	return &orders_grpc.RefundEvent{
+		RefundId:  int64(change.RefundID),
+		StateFrom: string(change.From),
+		StateTo:   string(change.To),
+		Comment:   change.Comment,
+		Operator:  string(change.Operator),
+		Time:      timestamppb.New(change.ChangedAt),
+	}
+}
+
+func packageFromProto(pack *orders_grpc.PackageType) models.PackageSpec {
+	return models.PackageSpec{
+		Type:       models.PackageType(pack.GetName()),
+		Kind:       packageKinds[pack.GetKind()],
+		Price:      moneyFromProto(pack.GetPrice()),
+		MinWeight:  models.Kilo(pack.GetMinWeight()),
+		MaxWeight:  models.Kilo(pack.GetMaxWeight()),
+		Dimensions: dimensionsFromProto(pack.GetDimensions()),
+		AllowWrap:  pack.GetAllowWrap(),
+	}
+}
+
+func dimensionsFromProto(dimensions *orders_grpc.Dimensions) models.Dimensions {
+	return models.Dimensions{
+		Length: models.Centimeter(dimensions.GetLengthCm()),
+		Width:  models.Centimeter(dimensions.GetWidthCm()),
+		Height: models.Centimeter(dimensions.GetHeightCm()),
+	}
+}
+
+func quoteToProto(quote models.Quote) *orders_grpc.PackageQuote {
+	return &orders_grpc.PackageQuote{
+		PackageType:   quote.Package.String(),
+		PackageLayers: packagingToProto(quote.Package),
+		PackCost:      moneyToProto(quote.PackageCost),
+		TotalCost:     moneyToProto(quote.Total),
+	}
+}
+
+func packageToProto(spec models.PackageSpec) *orders_grpc.PackageType {
+	resp := &orders_grpc.PackageType{
+		Name:      string(spec.Type),
+		Kind:      packageKindsToProto[spec.Kind],
+		Price:     moneyToProto(spec.Price),
+		MinWeight: float64(spec.MinWeight),
+		MaxWeight: float64(spec.MaxWeight),
+		Dimensions: &orders_grpc.Dimensions{
+			LengthCm: int32(spec.Dimensions.Length),
+			WidthCm:  int32(spec.Dimensions.Width),
+			HeightCm: int32(spec.Dimensions.Height),
+		},
+		AllowWrap: spec.AllowWrap,
+	}
+	if spec.Retired() {
+		resp.RetiredAt = timestamppb.New(spec.RetiredAt)
+	}
+
+	return resp
+}
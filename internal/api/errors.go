@@ -0,0 +1,247 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"homework-1/internal/models"
+	"homework-1/internal/module"
+	"homework-1/internal/services/operatortoken"
+	"homework-1/internal/services/packaging"
+	"homework-1/internal/services/shelving"
+	"homework-1/internal/storage"
+	"time"
+)
+
+// ErrorDomain Домен, в котором определены коды причин ErrorInfo.
+const ErrorDomain = "orders.pvz"
+
+// Коды причин, которые клиент получает в ErrorInfo.Reason.
+const (
+	ReasonOrderNotFound      = "ORDER_NOT_FOUND"
+	ReasonOrderExists        = "ORDER_EXISTS"
+	ReasonHistoryNotFound    = "HISTORY_NOT_FOUND"
+	ReasonRefundNotAllowed   = "REFUND_NOT_ALLOWED"
+	ReasonRefundNotFound     = "REFUND_NOT_FOUND"
+	ReasonRefundExists       = "REFUND_EXISTS"
+	ReasonRefundReason       = "INVALID_REFUND_REASON"
+	ReasonDecisionNotAllowed = "REFUND_DECISION_NOT_ALLOWED"
+	ReasonDecisionComment    = "DECISION_COMMENT_REQUIRED"
+	ReasonReturnNotAllowed   = "RETURN_NOT_ALLOWED"
+	ReasonReceiveNotAllowed  = "RECEIVE_NOT_ALLOWED"
+	ReasonTryOnNotAllowed    = "TRY_ON_NOT_ALLOWED"
+	ReasonTryOnTimedOut      = "TRY_ON_TIMED_OUT"
+	ReasonTryOnOutcome       = "INVALID_TRY_ON_OUTCOME"
+	ReasonPickupCode         = "PICKUP_CODE_INVALID"
+	ReasonPickupLocked       = "PICKUP_CODE_LOCKED"
+	ReasonOperatorNoPoint    = "OPERATOR_NOT_ASSIGNED"
+	ReasonOperatorToken      = "OPERATOR_TOKEN_INVALID"
+	ReasonAdminRequired      = "ADMIN_REQUIRED"
+	ReasonInvalidItems       = "INVALID_ORDER_ITEMS"
+	ReasonNoFreeCell         = "NO_FREE_CELL"
+	ReasonCellNotFound       = "CELL_NOT_FOUND"
+	ReasonCellFull           = "CELL_FULL"
+	ReasonCellTooSmall       = "CELL_TOO_SMALL"
+	ReasonMoveNotAllowed     = "MOVE_NOT_ALLOWED"
+	ReasonTransition         = "STATUS_TRANSITION_NOT_ALLOWED"
+	ReasonPageOutOfRange     = "PAGE_OUT_OF_RANGE"
+	ReasonWrongExpiration    = "WRONG_EXPIRATION"
+	ReasonStoragePeriod      = "STORAGE_PERIOD_EXCEEDED"
+	ReasonWeightExceeded     = "WEIGHT_EXCEEDED"
+	ReasonInvalidPackage     = "INVALID_PACKAGE"
+	ReasonInvalidCombination = "INVALID_PACKAGE_COMBINATION"
+	ReasonNoPackage          = "NO_PACKAGE_FITS"
+	ReasonWeightTooLow       = "WEIGHT_BELOW_MINIMUM"
+	ReasonSizeExceeded       = "SIZE_EXCEEDED"
+	ReasonPackageNotFound    = "PACKAGE_TYPE_NOT_FOUND"
+	ReasonPackageExists      = "PACKAGE_TYPE_EXISTS"
+	ReasonInvalidPackageType = "INVALID_PACKAGE_TYPE"
+	ReasonInvalidMoney       = "INVALID_MONEY"
+	ReasonCurrencyMismatch   = "CURRENCY_MISMATCH"
+	ReasonInvalidDate        = "INVALID_DATE"
+	ReasonInvalidExternal    = "INVALID_EXTERNAL_REF"
+	ReasonIdempotencyReused  = "IDEMPOTENCY_KEY_REUSED"
+	ReasonInProgress         = "REQUEST_IN_PROGRESS"
+	ReasonConflict           = "CONCURRENT_MODIFICATION"
+	ReasonInvalidPageToken   = "INVALID_PAGE_TOKEN"
+	ReasonInvalidArgument    = "INVALID_ARGUMENT"
+	ReasonCanceled           = "CANCELED"
+	ReasonDeadlineExceeded   = "DEADLINE_EXCEEDED"
+	ReasonInternal           = "INTERNAL"
+)
+
+// Имена полей запросов, которые попадают в BadRequest.FieldViolations и в метаданные ErrorInfo.
+const (
+	fieldMetadataKey    = "field"
+	orderIdField        = "order_id"
+	orderIdsField       = "order_ids"
+	externalField       = "external"
+	expirationTimeField = "expiration_time"
+	weightField         = "weight"
+	dimensionsField     = "dimensions"
+	costField           = "cost"
+	costMoneyField      = "cost_money"
+	packageTypeField    = "package_type"
+	packageLayersField  = "package_layers"
+	pageField           = "page"
+	pageTokenField      = "page_token"
+	refundIdField       = "refund_id"
+	reasonField         = "reason"
+	decisionField       = "decision"
+	commentField        = "comment"
+	nameField           = "name"
+	pickupCodeField     = "pickup_code"
+	itemsField          = "items"
+	decisionsField      = "decisions"
+	cellField           = "cell"
+)
+
+const internalMessage = "internal server error"
+
+// errorMapping Соответствие доменной ошибки коду gRPC. Поле field заполняется, если ошибка вызвана конкретным полем запроса.
+type errorMapping struct {
+	err    error
+	code   codes.Code
+	reason string
+	field  string
+}
+
+// errorMappings Порядок важен: ошибки операций (ErrRefund, ErrRefundDecision, ErrReturn, ErrReceive, ErrTryOn) объединяются с ErrTransition,
+// поэтому проверяются раньше, чтобы клиент получил причину на уровне операции.
+var errorMappings = []errorMapping{
+	{err: storage.ErrOrderNotFound, code: codes.NotFound, reason: ReasonOrderNotFound, field: orderIdField},
+	{err: module.ErrHistoryNotFound, code: codes.NotFound, reason: ReasonHistoryNotFound, field: orderIdField},
+	{err: storage.ErrOrderExists, code: codes.AlreadyExists, reason: ReasonOrderExists, field: orderIdField},
+	{err: storage.ErrRefundNotFound, code: codes.NotFound, reason: ReasonRefundNotFound, field: refundIdField},
+	{err: storage.ErrRefundExists, code: codes.AlreadyExists, reason: ReasonRefundExists, field: orderIdField},
+	{err: module.ErrRefund, code: codes.FailedPrecondition, reason: ReasonRefundNotAllowed, field: orderIdField},
+	{err: module.ErrReturn, code: codes.FailedPrecondition, reason: ReasonReturnNotAllowed, field: orderIdField},
+	{err: module.ErrReceive, code: codes.FailedPrecondition, reason: ReasonReceiveNotAllowed, field: orderIdsField},
+	{err: module.ErrTryOn, code: codes.FailedPrecondition, reason: ReasonTryOnNotAllowed, field: decisionsField},
+	{err: module.ErrTryOnTimeout, code: codes.FailedPrecondition, reason: ReasonTryOnTimedOut, field: decisionsField},
+	{err: module.ErrTryOnOutcome, code: codes.InvalidArgument, reason: ReasonTryOnOutcome, field: decisionsField},
+	{err: module.ErrPickupCode, code: codes.PermissionDenied, reason: ReasonPickupCode, field: pickupCodeField},
+	{err: module.ErrPickupLocked, code: codes.ResourceExhausted, reason: ReasonPickupLocked, field: pickupCodeField},
+	{err: operatortoken.ErrInvalidToken, code: codes.Unauthenticated, reason: ReasonOperatorToken},
+	{err: ErrAdminRequired, code: codes.PermissionDenied, reason: ReasonAdminRequired},
+	{err: storage.ErrOperatorNotAssigned, code: codes.PermissionDenied, reason: ReasonOperatorNoPoint},
+	{err: module.ErrRefundDecision, code: codes.FailedPrecondition, reason: ReasonDecisionNotAllowed, field: decisionField},
+	{err: module.ErrTransition, code: codes.FailedPrecondition, reason: ReasonTransition},
+	{err: module.ErrItems, code: codes.InvalidArgument, reason: ReasonInvalidItems, field: itemsField},
+	{err: module.ErrMove, code: codes.FailedPrecondition, reason: ReasonMoveNotAllowed, field: orderIdField},
+	{err: shelving.ErrNoCell, code: codes.ResourceExhausted, reason: ReasonNoFreeCell},
+	{err: shelving.ErrUnknownCell, code: codes.NotFound, reason: ReasonCellNotFound, field: cellField},
+	{err: storage.ErrCellNotFound, code: codes.NotFound, reason: ReasonCellNotFound, field: cellField},
+	{err: shelving.ErrCellFull, code: codes.FailedPrecondition, reason: ReasonCellFull, field: cellField},
+	{err: shelving.ErrCellSize, code: codes.FailedPrecondition, reason: ReasonCellTooSmall, field: cellField},
+	{err: module.ErrRefundReason, code: codes.InvalidArgument, reason: ReasonRefundReason, field: reasonField},
+	{err: module.ErrDecisionComment, code: codes.InvalidArgument, reason: ReasonDecisionComment, field: commentField},
+	{err: module.ErrExternalRef, code: codes.InvalidArgument, reason: ReasonInvalidExternal, field: externalField},
+	{err: module.ErrPageToken, code: codes.InvalidArgument, reason: ReasonInvalidPageToken, field: pageTokenField},
+	{err: module.ErrPagination, code: codes.OutOfRange, reason: ReasonPageOutOfRange, field: pageField},
+	{err: module.ErrWrongExpiration, code: codes.InvalidArgument, reason: ReasonWrongExpiration, field: expirationTimeField},
+	{err: module.ErrStoragePeriod, code: codes.InvalidArgument, reason: ReasonStoragePeriod, field: expirationTimeField},
+	{err: packaging.ErrWeightExceeded, code: codes.InvalidArgument, reason: ReasonWeightExceeded, field: weightField},
+	{err: packaging.ErrWeightTooLow, code: codes.InvalidArgument, reason: ReasonWeightTooLow, field: weightField},
+	{err: packaging.ErrSizeExceeded, code: codes.InvalidArgument, reason: ReasonSizeExceeded, field: dimensionsField},
+	{err: module.ErrNoPackage, code: codes.FailedPrecondition, reason: ReasonNoPackage, field: packageTypeField},
+	{err: packaging.ErrInvalidCombination, code: codes.InvalidArgument, reason: ReasonInvalidCombination, field: packageLayersField},
+	{err: packaging.ErrInvalidPackage, code: codes.InvalidArgument, reason: ReasonInvalidPackage, field: packageTypeField},
+	{err: packaging.ErrInvalidSpec, code: codes.InvalidArgument, reason: ReasonInvalidPackageType, field: packageTypeField},
+	{err: storage.ErrPackageNotFound, code: codes.NotFound, reason: ReasonPackageNotFound, field: nameField},
+	{err: storage.ErrPackageExists, code: codes.AlreadyExists, reason: ReasonPackageExists, field: nameField},
+	{err: models.ErrInvalidMoney, code: codes.InvalidArgument, reason: ReasonInvalidMoney, field: costField},
+	{err: models.ErrMoneyOverflow, code: codes.InvalidArgument, reason: ReasonInvalidMoney, field: costMoneyField},
+	{err: models.ErrCurrencyMismatch, code: codes.InvalidArgument, reason: ReasonCurrencyMismatch, field: costMoneyField},
+	{err: ErrIdempotencyKeyReused, code: codes.InvalidArgument, reason: ReasonIdempotencyReused},
+	{err: ErrInvalidIdempotencyKey, code: codes.InvalidArgument, reason: ReasonIdempotencyReused},
+	{err: ErrRequestInProgress, code: codes.Aborted, reason: ReasonInProgress},
+	{err: storage.ErrConflict, code: codes.Aborted, reason: ReasonConflict},
+	{err: context.Canceled, code: codes.Canceled, reason: ReasonCanceled},
+	{err: context.DeadlineExceeded, code: codes.DeadlineExceeded, reason: ReasonDeadlineExceeded},
+}
+
+// ToStatus Преобразует ошибку обработчика в статус gRPC с деталями ErrorInfo и, для ошибок в аргументах, BadRequest.
+// Неизвестные ошибки отдаются как Internal без текста исходной ошибки.
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if violations := fieldViolations(err); len(violations) > 0 {
+		return validationStatus(err, violations)
+	}
+
+	mapping, ok := findMapping(err)
+	if !ok {
+		st, errDetails := status.New(codes.Internal, internalMessage).WithDetails(&errdetails.ErrorInfo{
+			Reason: ReasonInternal,
+			Domain: ErrorDomain,
+		})
+		if errDetails != nil {
+			return status.Error(codes.Internal, internalMessage)
+		}
+
+		return st.Err()
+	}
+
+	info := &errdetails.ErrorInfo{
+		Reason: mapping.reason,
+		Domain: ErrorDomain,
+	}
+	if mapping.field != "" {
+		info.Metadata = map[string]string{fieldMetadataKey: mapping.field}
+	}
+
+	details := []protoadapt.MessageV1{info}
+	if mapping.code == codes.InvalidArgument && mapping.field != "" {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: mapping.field, Description: err.Error()},
+			},
+		})
+	}
+
+	st, errDetails := status.New(mapping.code, err.Error()).WithDetails(details...)
+	if errDetails != nil {
+		return status.Error(mapping.code, err.Error())
+	}
+
+	return st.Err()
+}
+
+func findMapping(err error) (errorMapping, bool) {
+	var mapping errorMapping
+	found := false
+	for _, m := range errorMappings {
+		if errors.Is(err, m.err) {
+			mapping, found = m, true
+			break
+		}
+	}
+
+	var errParse *time.ParseError
+	if !found && errors.As(err, &errParse) {
+		mapping, found = errorMapping{code: codes.InvalidArgument, reason: ReasonInvalidDate, field: expirationTimeField}, true
+	}
+
+	return mapping, found
+}
+
+// ErrorsUnaryInterceptor Переводит ошибки обработчиков OrderService в статусы gRPC.
+func ErrorsUnaryInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, ToStatus(err)
+	}
+
+	return resp, nil
+}
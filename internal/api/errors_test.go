@@ -0,0 +1,366 @@
+//go:build integration
+// +build integration
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"homework-1/internal/config"
+	"homework-1/internal/module"
+	"homework-1/internal/services/operatortoken"
+	"homework-1/internal/services/packaging"
+	"homework-1/internal/services/shelving"
+	"homework-1/internal/storage"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	mockcache "homework-1/internal/cache/mocks"
+	"homework-1/internal/models"
+	mockmodule "homework-1/internal/module/mocks"
+	"homework-1/pkg/api/proto/orders_grpc/v1/orders_grpc/v1"
+)
+
+func statusDetails(t *testing.T, err error) (*status.Status, *errdetails.ErrorInfo, *errdetails.BadRequest) {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+
+	var info *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			badRequest = d
+		}
+	}
+
+	return st, info, badRequest
+}
+
+func TestToStatus(t *testing.T) {
+	t.Run("Заказ не найден", func(t *testing.T) {
+		err := ToStatus(fmt.Errorf("module.ReturnOrder error: %w", storage.ErrOrderNotFound))
+
+		st, info, badRequest := statusDetails(t, err)
+		assert.Equal(t, codes.NotFound, st.Code())
+		require.NotNil(t, info)
+		assert.Equal(t, ReasonOrderNotFound, info.GetReason())
+		assert.Equal(t, orderIdField, info.GetMetadata()[fieldMetadataKey])
+		assert.Nil(t, badRequest)
+	})
+
+	t.Run("Заказ уже существует", func(t *testing.T) {
+		st, info, _ := statusDetails(t, ToStatus(storage.ErrOrderExists))
+		assert.Equal(t, codes.AlreadyExists, st.Code())
+		assert.Equal(t, ReasonOrderExists, info.GetReason())
+	})
+
+	t.Run("Причина операции важнее недопустимого перехода статуса", func(t *testing.T) {
+		err := fmt.Errorf("%w: %w", module.ErrRefund, module.ErrTransition)
+
+		st, info, _ := statusDetails(t, ToStatus(err))
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, ReasonRefundNotAllowed, info.GetReason())
+	})
+
+	t.Run("Неверный код выдачи и блокировка", func(t *testing.T) {
+		st, info, _ := statusDetails(t, ToStatus(fmt.Errorf("module.ReceiveOrders error: %w: 2 attempts left", module.ErrPickupCode)))
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+		assert.Equal(t, ReasonPickupCode, info.GetReason())
+		assert.Equal(t, pickupCodeField, info.GetMetadata()[fieldMetadataKey])
+
+		st, info, _ = statusDetails(t, ToStatus(fmt.Errorf("module.ReceiveOrders error: %w", module.ErrPickupLocked)))
+		assert.Equal(t, codes.ResourceExhausted, st.Code())
+		assert.Equal(t, ReasonPickupLocked, info.GetReason())
+	})
+
+	t.Run("Неверные товары заказа", func(t *testing.T) {
+		st, info, _ := statusDetails(t, ToStatus(fmt.Errorf("module.RefundOrder error: %w: item 11 is not issued in order 1", module.ErrItems)))
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, ReasonInvalidItems, info.GetReason())
+		assert.Equal(t, itemsField, info.GetMetadata()[fieldMetadataKey])
+	})
+
+	t.Run("Время примерки вышло", func(t *testing.T) {
+		st, info, _ := statusDetails(t, ToStatus(fmt.Errorf("module.ConfirmTryOn error: %w: order 1", module.ErrTryOnTimeout)))
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, ReasonTryOnTimedOut, info.GetReason())
+		assert.Equal(t, decisionsField, info.GetMetadata()[fieldMetadataKey])
+	})
+
+	t.Run("Заказ не на примерке", func(t *testing.T) {
+		st, info, _ := statusDetails(t, ToStatus(fmt.Errorf("module.ConfirmTryOn error: %w: %w: issued -> ready_for_pickup", module.ErrTryOn, module.ErrTransition)))
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, ReasonTryOnNotAllowed, info.GetReason())
+	})
+
+	t.Run("Сотрудник не закреплен за пунктом", func(t *testing.T) {
+		st, info, _ := statusDetails(t, ToStatus(fmt.Errorf("module.OperatorPoint error: %w", storage.ErrOperatorNotAssigned)))
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+		assert.Equal(t, ReasonOperatorNoPoint, info.GetReason())
+	})
+
+	t.Run("Недействительный токен сотрудника", func(t *testing.T) {
+		st, info, _ := statusDetails(t, ToStatus(fmt.Errorf("operatortoken.Verify error: %w: bad signature", operatortoken.ErrInvalidToken)))
+		assert.Equal(t, codes.Unauthenticated, st.Code())
+		assert.Equal(t, ReasonOperatorToken, info.GetReason())
+	})
+
+	t.Run("Каталог упаковок меняет не администратор", func(t *testing.T) {
+		st, info, _ := statusDetails(t, ToStatus(fmt.Errorf("/orders_grpc.OrdersService/RetirePackageType error: %w: anna", ErrAdminRequired)))
+		assert.Equal(t, codes.PermissionDenied, st.Code())
+		assert.Equal(t, ReasonAdminRequired, info.GetReason())
+	})
+
+	t.Run("Заказ не помещается в упаковку", func(t *testing.T) {
+		st, info, badRequest := statusDetails(t, ToStatus(fmt.Errorf("module.AddOrder error: %w: bag is 30x20x5 cm", packaging.ErrSizeExceeded)))
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, ReasonSizeExceeded, info.GetReason())
+		require.NotNil(t, badRequest)
+		assert.Equal(t, dimensionsField, badRequest.GetFieldViolations()[0].GetField())
+	})
+
+	t.Run("Нет свободной ячейки", func(t *testing.T) {
+		st, info, _ := statusDetails(t, ToStatus(fmt.Errorf("module.AddOrder error: %w: 25 kg", shelving.ErrNoCell)))
+		assert.Equal(t, codes.ResourceExhausted, st.Code())
+		assert.Equal(t, ReasonNoFreeCell, info.GetReason())
+	})
+
+	t.Run("Заказ не помещается в ячейку", func(t *testing.T) {
+		st, info, _ := statusDetails(t, ToStatus(fmt.Errorf("module.MoveOrder error: %w: A-01-01", shelving.ErrCellSize)))
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, ReasonCellTooSmall, info.GetReason())
+		assert.Equal(t, cellField, info.GetMetadata()[fieldMetadataKey])
+	})
+
+	t.Run("Превышен вес упаковки", func(t *testing.T) {
+		st, info, badRequest := statusDetails(t, ToStatus(fmt.Errorf("module.AddOrder error: %w", packaging.ErrWeightExceeded)))
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, ReasonWeightExceeded, info.GetReason())
+		require.NotNil(t, badRequest)
+		require.Len(t, badRequest.GetFieldViolations(), 1)
+		assert.Equal(t, weightField, badRequest.GetFieldViolations()[0].GetField())
+	})
+
+	t.Run("Конфликт конкурирующих транзакций", func(t *testing.T) {
+		st, info, _ := statusDetails(t, ToStatus(fmt.Errorf("storage.ChangeStatuses error: %w", storage.ErrConflict)))
+		assert.Equal(t, codes.Aborted, st.Code())
+		assert.Equal(t, ReasonConflict, info.GetReason())
+	})
+
+	t.Run("Страница вне диапазона", func(t *testing.T) {
+		st, info, _ := statusDetails(t, ToStatus(module.ErrPagination))
+		assert.Equal(t, codes.OutOfRange, st.Code())
+		assert.Equal(t, ReasonPageOutOfRange, info.GetReason())
+	})
+
+	t.Run("Неизвестная ошибка не раскрывает детали", func(t *testing.T) {
+		st, info, _ := statusDetails(t, ToStatus(errors.New("connection refused")))
+		assert.Equal(t, codes.Internal, st.Code())
+		assert.Equal(t, internalMessage, st.Message())
+		assert.Equal(t, ReasonInternal, info.GetReason())
+	})
+
+	t.Run("Статус gRPC возвращается без изменений", func(t *testing.T) {
+		err := status.Error(codes.Unavailable, "unavailable")
+		assert.Equal(t, err, ToStatus(err))
+	})
+}
+
+func TestErrorsUnaryInterceptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	mockCache := mockcache.NewMockCacheInterface(ctrl)
+	orderService := &OrderService{Module: mockModule, Redis: mockCache}
+
+	t.Run("Некорректная дата срока хранения", func(t *testing.T) {
+		request := &orders_grpc.AddOrderRequest{
+			Order:          &orders_grpc.AddOrderRequest_OrderId{OrderId: 1},
+			CustomerId:     1,
+			ExpirationTime: "2024-10-10",
+			PackageType:    "box",
+			Weight:         1,
+			Cost:           1,
+		}
+
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return orderService.AddOrder(ctx, req.(*orders_grpc.AddOrderRequest))
+		}
+
+		_, err := ErrorsUnaryInterceptor(context.Background(), request, nil, handler)
+		st, info, badRequest := statusDetails(t, err)
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, ReasonInvalidDate, info.GetReason())
+		require.NotNil(t, badRequest)
+		assert.Equal(t, expirationTimeField, badRequest.GetFieldViolations()[0].GetField())
+	})
+
+	t.Run("Возврат недоступен", func(t *testing.T) {
+		request := &orders_grpc.ReturnOrderRequest{Order: &orders_grpc.ReturnOrderRequest_OrderId{OrderId: 1}}
+		mockModule.EXPECT().ReturnOrder(gomock.Any(), models.ID(1), models.Operator("")).Return(models.Order{}, module.ErrReturn)
+
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return orderService.ReturnOrder(ctx, req.(*orders_grpc.ReturnOrderRequest))
+		}
+
+		_, err := ErrorsUnaryInterceptor(context.Background(), request, nil, handler)
+		st, info, _ := statusDetails(t, err)
+		assert.Equal(t, codes.FailedPrecondition, st.Code())
+		assert.Equal(t, ReasonReturnNotAllowed, info.GetReason())
+		assert.Contains(t, st.Message(), module.ErrReturn.Error())
+	})
+}
+
+func TestValidateUnaryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/orders_grpc.OrdersService/AddOrder"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return req, nil
+	}
+
+	t.Run("Корректный запрос передается обработчику", func(t *testing.T) {
+		request := &orders_grpc.AddOrderRequest{Order: &orders_grpc.AddOrderRequest_OrderId{OrderId: 1}, CustomerId: 1, ExpirationTime: "10-10-2024", Weight: 1, Cost: 1}
+
+		resp, err := ValidateUnaryInterceptor(context.Background(), request, info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, request, resp)
+	})
+
+	t.Run("Все нарушенные поля попадают в BadRequest", func(t *testing.T) {
+		request := &orders_grpc.AddOrderRequest{Order: &orders_grpc.AddOrderRequest_OrderId{OrderId: 0}, CustomerId: -1, ExpirationTime: "2024-10-10", Weight: -1, Cost: 1}
+
+		_, err := ValidateUnaryInterceptor(context.Background(), request, info, handler)
+		require.Error(t, err)
+
+		st, errInfo, badRequest := statusDetails(t, ToStatus(err))
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		assert.Equal(t, ReasonInvalidArgument, errInfo.GetReason())
+		assert.Equal(t, "customer_id", errInfo.GetMetadata()[fieldMetadataKey])
+		require.NotNil(t, badRequest)
+
+		// Поля oneof проверяются после обычных полей сообщения.
+		var fields []string
+		for _, violation := range badRequest.GetFieldViolations() {
+			fields = append(fields, violation.GetField())
+		}
+		assert.Equal(t, []string{"customer_id", "expiration_time", "weight", "order_id"}, fields)
+	})
+
+	t.Run("Заказ не указан", func(t *testing.T) {
+		request := &orders_grpc.ReturnOrderRequest{}
+
+		_, err := ValidateUnaryInterceptor(context.Background(), request, info, handler)
+
+		st, _, badRequest := statusDetails(t, ToStatus(err))
+		assert.Equal(t, codes.InvalidArgument, st.Code())
+		require.NotNil(t, badRequest)
+		assert.Equal(t, "order", badRequest.GetFieldViolations()[0].GetField())
+	})
+
+	t.Run("Нарушение в элементе списка", func(t *testing.T) {
+		request := &orders_grpc.ReceiveOrdersRequest{OrderIds: []int64{1, 0}}
+
+		_, err := ValidateUnaryInterceptor(context.Background(), request, info, handler)
+
+		_, _, badRequest := statusDetails(t, ToStatus(err))
+		require.NotNil(t, badRequest)
+		assert.Equal(t, "order_ids[1]", badRequest.GetFieldViolations()[0].GetField())
+	})
+}
+
+func TestPointUnaryInterceptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tokens, err := operatortoken.New(config.OperatorTokenConfig{Secret: "secret", TTLHours: 1})
+	require.NoError(t, err)
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	interceptor := PointUnaryInterceptor(tokens, mockModule)
+	info := &grpc.UnaryServerInfo{FullMethod: "/orders_grpc.OrdersService/GetOrders"}
+
+	withToken := func(operator models.Operator, role operatortoken.Role) context.Context {
+		token, errIssue := tokens.Issue(operatortoken.Claims{Operator: operator, Role: role}, time.Now())
+		require.NoError(t, errIssue)
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(AuthorizationMetadataKey, "Bearer "+token))
+	}
+	mustNotCall := func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("handler must not be called")
+		return nil, nil
+	}
+
+	t.Run("Запрос выполняется в границах пункта сотрудника из токена", func(t *testing.T) {
+		mockModule.EXPECT().OperatorPoint(gomock.Any(), models.Operator("anna")).Return(models.PointID("msk-1"), nil)
+
+		var point models.PointID
+		var operator models.Operator
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			point = models.PointFromContext(ctx)
+			operator = operatorFromContext(ctx)
+			return req, nil
+		}
+
+		_, errIntercept := interceptor(withToken("anna", operatortoken.RoleOperator), &orders_grpc.GetOrdersRequest{CustomerId: 1}, info, handler)
+		require.NoError(t, errIntercept)
+		assert.Equal(t, models.PointID("msk-1"), point)
+		assert.Equal(t, models.Operator("anna"), operator)
+	})
+
+	t.Run("Сотрудник из заголовка без токена не принимается", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-operator", "anna"))
+
+		_, errIntercept := interceptor(ctx, &orders_grpc.GetOrdersRequest{CustomerId: 1}, info, mustNotCall)
+		assert.ErrorIs(t, errIntercept, operatortoken.ErrInvalidToken)
+	})
+
+	t.Run("Токен, подписанный другим секретом, не принимается", func(t *testing.T) {
+		other, errNew := operatortoken.New(config.OperatorTokenConfig{Secret: "other", TTLHours: 1})
+		require.NoError(t, errNew)
+		token, errIssue := other.Issue(operatortoken.Claims{Operator: "anna", Role: operatortoken.RoleOperator}, time.Now())
+		require.NoError(t, errIssue)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(AuthorizationMetadataKey, "Bearer "+token))
+
+		_, errIntercept := interceptor(ctx, &orders_grpc.GetOrdersRequest{CustomerId: 1}, info, mustNotCall)
+		assert.ErrorIs(t, errIntercept, operatortoken.ErrInvalidToken)
+	})
+
+	t.Run("Каталог упаковок меняет только администратор", func(t *testing.T) {
+		catalog := &grpc.UnaryServerInfo{FullMethod: orders_grpc.OrdersService_UpdatePackageType_FullMethodName}
+		request := &orders_grpc.UpdatePackageTypeRequest{PackageType: &orders_grpc.PackageType{Name: "box"}}
+
+		_, errIntercept := interceptor(withToken("anna", operatortoken.RoleOperator), request, catalog, mustNotCall)
+		assert.ErrorIs(t, errIntercept, ErrAdminRequired)
+
+		mockModule.EXPECT().OperatorPoint(gomock.Any(), models.Operator("root")).Return(models.PointID("msk-1"), nil)
+		called := false
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			called = true
+			return req, nil
+		}
+
+		_, errIntercept = interceptor(withToken("root", operatortoken.RoleAdmin), request, catalog, handler)
+		require.NoError(t, errIntercept)
+		assert.True(t, called)
+	})
+
+	t.Run("Запрос сотрудника без пункта не выполняется", func(t *testing.T) {
+		mockModule.EXPECT().OperatorPoint(gomock.Any(), models.Operator("boris")).
+			Return(models.PointID(""), fmt.Errorf("module.OperatorPoint error: %w", storage.ErrOperatorNotAssigned))
+
+		_, errIntercept := interceptor(withToken("boris", operatortoken.RoleOperator), &orders_grpc.GetOrdersRequest{CustomerId: 1}, info, mustNotCall)
+		assert.ErrorIs(t, errIntercept, storage.ErrOperatorNotAssigned)
+	})
+}
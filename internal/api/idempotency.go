@@ -0,0 +1,162 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"homework-1/internal/cache"
+	"homework-1/internal/models"
+	"homework-1/pkg/api/proto/orders_grpc/v1/orders_grpc/v1"
+	"log"
+)
+
+// IdempotencyKeyMetadataKey Ключ метаданных gRPC, в котором клиент передает ключ идемпотентности запроса.
+const IdempotencyKeyMetadataKey = "idempotency-key"
+
+// replayedMetadataKey Заголовок ответа, по которому клиент видит, что ответ взят из сохраненного результата.
+const replayedMetadataKey = "idempotent-replayed"
+
+const maxIdempotencyKeyLen = 128
+
+var (
+	ErrIdempotencyKeyReused  = errors.New("idempotency key was already used with a different request")
+	ErrRequestInProgress     = errors.New("request with this idempotency key is still in progress")
+	ErrInvalidIdempotencyKey = errors.New("idempotency key is too long")
+)
+
+// idempotentMethods Изменяющие методы, повтор которых после таймаута не должен выполняться второй раз.
+var idempotentMethods = map[string]bool{
+	orders_grpc.OrdersService_AddOrder_FullMethodName:          true,
+	orders_grpc.OrdersService_CreateRefund_FullMethodName:      true,
+	orders_grpc.OrdersService_DecideRefund_FullMethodName:      true,
+	orders_grpc.OrdersService_ReceiveOrders_FullMethodName:     true,
+	orders_grpc.OrdersService_CreatePackageType_FullMethodName: true,
+	orders_grpc.OrdersService_MoveOrder_FullMethodName:         true,
+}
+
+// IdempotencyUnaryInterceptor Повтор запроса с тем же ключом возвращает сохраненный ответ без повторного вызова обработчика.
+// Сохраняются только успешные ответы: после ошибки ключ освобождается и повтор выполняется заново.
+// Если хранилище недоступно, запрос выполняется без гарантии идемпотентности, чтобы сбой Redis не останавливал прием заказов.
+func IdempotencyUnaryInterceptor(store cache.IdempotencyInterface) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		key := idempotencyKeyFromContext(ctx)
+		if key == "" || !idempotentMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		if len(key) > maxIdempotencyKeyLen {
+			return nil, ErrInvalidIdempotencyKey
+		}
+
+		message, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		fingerprint, errFingerprint := requestFingerprint(message)
+		if errFingerprint != nil {
+			return nil, fmt.Errorf("api.IdempotencyUnaryInterceptor error: %w", errFingerprint)
+		}
+
+		// Ключ действует в пределах пункта и метода: один и тот же ключ в AddOrder и CreateRefund не конфликтует,
+		// а сотрудник другого пункта не получит чужой сохраненный ответ.
+		storeKey := string(models.PointFromContext(ctx)) + ":" + info.FullMethod + ":" + key
+		record, reserved, errReserve := store.Reserve(ctx, storeKey, fingerprint)
+		if errReserve != nil {
+			log.Printf("api: idempotency store is unavailable, %s is executed without it: %s\n", info.FullMethod, errReserve)
+			return handler(ctx, req)
+		}
+
+		if !reserved {
+			return replay(ctx, record, fingerprint)
+		}
+
+		resp, errHandler := handler(ctx, req)
+		if errHandler != nil {
+			if errRelease := store.Release(ctx, storeKey); errRelease != nil {
+				log.Printf("api: can not release idempotency key: %s\n", errRelease)
+			}
+			return nil, errHandler
+		}
+
+		if errComplete := complete(ctx, store, storeKey, fingerprint, resp); errComplete != nil {
+			log.Printf("api: can not save idempotent response: %s\n", errComplete)
+		}
+
+		return resp, nil
+	}
+}
+
+func replay(ctx context.Context, record cache.IdempotencyRecord, fingerprint string) (interface{}, error) {
+	if record.Fingerprint != fingerprint {
+		return nil, ErrIdempotencyKeyReused
+	}
+	if !record.Done {
+		return nil, ErrRequestInProgress
+	}
+
+	var saved anypb.Any
+	if errUnmarshal := proto.Unmarshal(record.Response, &saved); errUnmarshal != nil {
+		return nil, fmt.Errorf("api.replay error: %w", errUnmarshal)
+	}
+
+	resp, errResp := saved.UnmarshalNew()
+	if errResp != nil {
+		return nil, fmt.Errorf("api.replay error: %w", errResp)
+	}
+
+	if errHeader := grpc.SetHeader(ctx, metadata.Pairs(replayedMetadataKey, "true")); errHeader != nil {
+		log.Printf("api: can not set idempotent replay header: %s\n", errHeader)
+	}
+
+	return resp, nil
+}
+
+func complete(ctx context.Context, store cache.IdempotencyInterface, key string, fingerprint string, resp interface{}) error {
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return fmt.Errorf("api.complete error: response %T is not a proto message", resp)
+	}
+
+	saved, errAny := anypb.New(message)
+	if errAny != nil {
+		return fmt.Errorf("api.complete error: %w", errAny)
+	}
+
+	data, errMarshal := proto.Marshal(saved)
+	if errMarshal != nil {
+		return fmt.Errorf("api.complete error: %w", errMarshal)
+	}
+
+	return store.Complete(ctx, key, cache.IdempotencyRecord{Fingerprint: fingerprint, Response: data})
+}
+
+// requestFingerprint Детерминированная сериализация дает одинаковый отпечаток для одинаковых запросов.
+func requestFingerprint(req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func idempotencyKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(IdempotencyKeyMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
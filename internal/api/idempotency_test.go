@@ -0,0 +1,148 @@
+//go:build integration
+// +build integration
+
+package api
+
+import (
+	"context"
+	"errors"
+	"homework-1/internal/cache"
+	"homework-1/internal/models"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	mockcache "homework-1/internal/cache/mocks"
+	"homework-1/pkg/api/proto/orders_grpc/v1/orders_grpc/v1"
+)
+
+func TestIdempotencyUnaryInterceptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStore := mockcache.NewMockIdempotencyInterface(ctrl)
+	interceptor := IdempotencyUnaryInterceptor(mockStore)
+
+	info := &grpc.UnaryServerInfo{FullMethod: orders_grpc.OrdersService_AddOrder_FullMethodName}
+	storeKey := ":" + orders_grpc.OrdersService_AddOrder_FullMethodName + ":key-1"
+	request := &orders_grpc.AddOrderRequest{
+		Order:      &orders_grpc.AddOrderRequest_External{External: &orders_grpc.ExternalOrderRef{Source: "marketplace", Number: "1"}},
+		CustomerId: 1,
+	}
+	fingerprint, errFingerprint := requestFingerprint(request)
+	require.NoError(t, errFingerprint)
+
+	withKey := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyMetadataKey, "key-1"))
+
+	t.Run("Запрос без ключа выполняется без обращения к хранилищу", func(t *testing.T) {
+		calls := 0
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			calls++
+			return &orders_grpc.AddOrderResponse{OrderId: 1}, nil
+		}
+
+		_, err := interceptor(context.Background(), request, info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Первый запрос выполняется и сохраняет ответ", func(t *testing.T) {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &orders_grpc.AddOrderResponse{OrderId: 7}, nil
+		}
+
+		mockStore.EXPECT().Reserve(gomock.Any(), storeKey, fingerprint).Return(cache.IdempotencyRecord{}, true, nil)
+		mockStore.EXPECT().Complete(gomock.Any(), storeKey, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, key string, record cache.IdempotencyRecord) error {
+				assert.Equal(t, fingerprint, record.Fingerprint)
+				assert.NotEmpty(t, record.Response)
+				return nil
+			})
+
+		resp, err := interceptor(withKey, request, info, handler)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), resp.(*orders_grpc.AddOrderResponse).GetOrderId())
+	})
+
+	t.Run("Повтор возвращает сохраненный ответ без вызова обработчика", func(t *testing.T) {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			t.Fatal("handler must not be called on replay")
+			return nil, nil
+		}
+
+		var saved cache.IdempotencyRecord
+		mockStore.EXPECT().Reserve(gomock.Any(), storeKey, fingerprint).Return(cache.IdempotencyRecord{}, true, nil)
+		mockStore.EXPECT().Complete(gomock.Any(), storeKey, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, key string, record cache.IdempotencyRecord) error {
+				saved = record
+				saved.Done = true
+				return nil
+			})
+		_, err := interceptor(withKey, request, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &orders_grpc.AddOrderResponse{OrderId: 8}, nil
+		})
+		require.NoError(t, err)
+
+		mockStore.EXPECT().Reserve(gomock.Any(), storeKey, fingerprint).Return(saved, false, nil)
+
+		resp, err := interceptor(withKey, request, info, handler)
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(&orders_grpc.AddOrderResponse{OrderId: 8}, resp.(proto.Message)))
+	})
+
+	t.Run("Ключ с другим запросом отклоняется", func(t *testing.T) {
+		mockStore.EXPECT().Reserve(gomock.Any(), storeKey, fingerprint).
+			Return(cache.IdempotencyRecord{Fingerprint: "other", Done: true}, false, nil)
+
+		_, err := interceptor(withKey, request, info, nil)
+		assert.ErrorIs(t, err, ErrIdempotencyKeyReused)
+	})
+
+	t.Run("Повтор выполняемого запроса", func(t *testing.T) {
+		mockStore.EXPECT().Reserve(gomock.Any(), storeKey, fingerprint).
+			Return(cache.IdempotencyRecord{Fingerprint: fingerprint}, false, nil)
+
+		_, err := interceptor(withKey, request, info, nil)
+		assert.ErrorIs(t, err, ErrRequestInProgress)
+	})
+
+	t.Run("Ошибка обработчика освобождает ключ", func(t *testing.T) {
+		errHandler := errors.New("handler error")
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, errHandler
+		}
+
+		mockStore.EXPECT().Reserve(gomock.Any(), storeKey, fingerprint).Return(cache.IdempotencyRecord{}, true, nil)
+		mockStore.EXPECT().Release(gomock.Any(), storeKey).Return(nil)
+
+		_, err := interceptor(withKey, request, info, handler)
+		assert.ErrorIs(t, err, errHandler)
+	})
+
+	t.Run("Ключ действует в пределах пункта выдачи", func(t *testing.T) {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &orders_grpc.AddOrderResponse{OrderId: 8}, nil
+		}
+
+		pointKey := "msk-1:" + orders_grpc.OrdersService_AddOrder_FullMethodName + ":key-1"
+		mockStore.EXPECT().Reserve(gomock.Any(), pointKey, fingerprint).Return(cache.IdempotencyRecord{}, true, nil)
+		mockStore.EXPECT().Complete(gomock.Any(), pointKey, gomock.Any()).Return(nil)
+
+		_, err := interceptor(models.WithPoint(withKey, "msk-1"), request, info, handler)
+		require.NoError(t, err)
+	})
+
+	t.Run("Методы чтения не используют ключ идемпотентности", func(t *testing.T) {
+		readInfo := &grpc.UnaryServerInfo{FullMethod: orders_grpc.OrdersService_GetOrders_FullMethodName}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &orders_grpc.GetOrdersResponse{}, nil
+		}
+
+		_, err := interceptor(withKey, &orders_grpc.GetOrdersRequest{CustomerId: 1}, readInfo, handler)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"homework-1/internal/models"
+	"homework-1/internal/services/operatortoken"
+	"homework-1/pkg/api/proto/orders_grpc/v1/orders_grpc/v1"
+	"log"
+	"strings"
+	"time"
+)
+
+// warningMetadataKey Заголовок ответа с предупреждением в формате HTTP Warning (RFC 7234), шлюз передает его как Grpc-Metadata-Warning.
+const warningMetadataKey = "warning"
+
+// AuthorizationMetadataKey Ключ метаданных gRPC, в котором клиент передает токен сотрудника пункта выдачи
+// в виде "Bearer <токен>". Шлюз передает в него HTTP-заголовок Authorization.
+const AuthorizationMetadataKey = "authorization"
+
+const bearerPrefix = "Bearer "
+
+// ErrAdminRequired Метод меняет общий для всех пунктов каталог упаковок и доступен только администратору.
+var ErrAdminRequired = errors.New("operator is not an admin")
+
+// adminMethods Методы, доступные только сотрудникам с ролью администратора.
+var adminMethods = map[string]struct{}{
+	orders_grpc.OrdersService_CreatePackageType_FullMethodName: {},
+	orders_grpc.OrdersService_UpdatePackageType_FullMethodName: {},
+	orders_grpc.OrdersService_RetirePackageType_FullMethodName: {},
+}
+
+type claimsKey struct{}
+
+func claimsFromContext(ctx context.Context) operatortoken.Claims {
+	claims, _ := ctx.Value(claimsKey{}).(operatortoken.Claims)
+	return claims
+}
+
+// operatorFromContext Сотрудник из проверенного токена, его проставляет PointUnaryInterceptor.
+func operatorFromContext(ctx context.Context) models.Operator {
+	return claimsFromContext(ctx).Operator
+}
+
+func tokenFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(AuthorizationMetadataKey)
+	if len(values) == 0 || !strings.HasPrefix(values[0], bearerPrefix) {
+		return ""
+	}
+
+	return strings.TrimPrefix(values[0], bearerPrefix)
+}
+
+// warnDeprecated Сообщает клиенту об использовании устаревшего поля запроса, сам запрос при этом выполняется.
+func warnDeprecated(ctx context.Context, field, replacement string) {
+	log.Printf("api: deprecated field %s is used, use %s instead\n", field, replacement)
+
+	warning := fmt.Sprintf("299 - \"%s is deprecated, use %s\"", field, replacement)
+	if err := grpc.SetHeader(ctx, metadata.Pairs(warningMetadataKey, warning)); err != nil {
+		log.Printf("api: can not set deprecation warning header: %s\n", err)
+	}
+}
+
+// OperatorTokens Проверяет токен сотрудника и возвращает сотрудника, которому он выдан, с его ролью.
+type OperatorTokens interface {
+	Verify(token string, now time.Time) (operatortoken.Claims, error)
+}
+
+// OperatorPoints Определяет пункт выдачи, в котором работает сотрудник.
+type OperatorPoints interface {
+	OperatorPoint(ctx context.Context, operator models.Operator) (models.PointID, error)
+}
+
+// PointUnaryInterceptor Определяет сотрудника по подписанному токену, а пункт выдачи по сотруднику, и дальше запрос
+// выполняется только в границах этого пункта. Запрос без действующего токена или сотрудника, не закрепленного
+// ни за одним пунктом, не выполняется. Методы каталога упаковок дополнительно требуют роли администратора.
+// Ошибки переводятся в Unauthenticated и PermissionDenied перехватчиком ErrorsUnaryInterceptor,
+// поэтому он должен стоять в цепочке раньше.
+func PointUnaryInterceptor(tokens OperatorTokens, points OperatorPoints) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		claims, err := tokens.Verify(tokenFromContext(ctx), time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("%s error: %w", info.FullMethod, err)
+		}
+
+		if _, adminOnly := adminMethods[info.FullMethod]; adminOnly && !claims.IsAdmin() {
+			return nil, fmt.Errorf("%s error: %w: %s", info.FullMethod, ErrAdminRequired, claims.Operator)
+		}
+
+		point, err := points.OperatorPoint(ctx, claims.Operator)
+		if err != nil {
+			return nil, fmt.Errorf("%s error: %w", info.FullMethod, err)
+		}
+
+		ctx = context.WithValue(ctx, claimsKey{}, claims)
+		return handler(models.WithPoint(ctx, point), req)
+	}
+}
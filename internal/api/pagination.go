@@ -0,0 +1,31 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"homework-1/internal/module"
+)
+
+// encodePageToken Токен страницы непрозрачен для клиента: это курсор хранилища в JSON, закодированный base64url.
+func encodePageToken(cursor interface{}) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("api.encodePageToken error: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodePageToken(token string, cursor interface{}) error {
+	data, errDecode := base64.RawURLEncoding.DecodeString(token)
+	if errDecode != nil {
+		return fmt.Errorf("api.decodePageToken error: %w: %w", module.ErrPageToken, errDecode)
+	}
+
+	if errUnmarshal := json.Unmarshal(data, cursor); errUnmarshal != nil {
+		return fmt.Errorf("api.decodePageToken error: %w: %w", module.ErrPageToken, errUnmarshal)
+	}
+
+	return nil
+}
@@ -2,7 +2,6 @@ package api
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -11,67 +10,53 @@ import (
 	"homework-1/internal/metrics"
 	"homework-1/internal/models"
 	"homework-1/internal/module"
+	"homework-1/internal/services/pickuppoint"
 	"homework-1/pkg/api/proto/orders_grpc/v1/orders_grpc/v1"
 	"log"
 	"time"
 )
 
-const (
-	dateLayout = "02-01-2006"
-)
-
-var (
-	errIncorrectId    = errors.New("empty or non-positive order or customer id")
-	errNegativeWeight = errors.New("weight can not be negative")
-	errNegativeCost   = errors.New("cost can not be negative")
-)
-
+// OrderService Если не задан Points, даты разбираются по настройкам единственного пункта PickupPoint.
 type OrderService struct {
 	Module module.ModuleInterface
 	orders_grpc.UnimplementedOrdersServiceServer
-	Redis cache.CacheInterface
+	Redis       cache.CacheInterface
+	PickupPoint pickuppoint.Point
+	Points      pickuppoint.Provider
 }
 
 // AddOrder Инвалидация кеша происходит на этапе успешного добавления заказа.
 // В этом случае из кеша удаляется ключ, содержащий информацию о всех заказах пользователя, для которого был создан новый заказ.
 // Это сделано, потому что после добавления нового заказа информация о заказах пользователя в кеше устаревает (добавялется новый заказ).
-func (o *OrderService) AddOrder(ctx context.Context, request *orders_grpc.AddOrderRequest) (*emptypb.Empty, error) {
+func (o *OrderService) AddOrder(ctx context.Context, request *orders_grpc.AddOrderRequest) (*orders_grpc.AddOrderResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.AddOrder")
 	defer span.Finish()
 
-	expirationTime, errDate := time.Parse(dateLayout, request.GetExpirationTime())
+	expirationTime, errDate := o.expirationFromRequest(ctx, request)
 	if errDate != nil {
 		return nil, fmt.Errorf("OrderService.AddOrder error: %w", errDate)
 	}
 
-	orderId := models.ID(request.GetOrderId())
+	ref := externalFromRequest(ctx, request)
 	customerId := models.ID(request.GetCustomerId())
-	if orderId <= 0 || customerId <= 0 {
-		return nil, fmt.Errorf("OrderService.AddOrder error: %w", errIncorrectId)
-	}
-
 	weight := models.Kilo(request.GetWeight())
-	if weight < 0 {
-		return nil, fmt.Errorf("OrderService.AddOrder error: %w", errNegativeWeight)
-	}
-
-	cost := models.Rub(request.GetCost())
-	if cost < 0 {
-		return nil, fmt.Errorf("OrderService.AddOrder error: %w", errNegativeCost)
+	cost, errCost := costFromRequest(ctx, request)
+	if errCost != nil {
+		return nil, fmt.Errorf("OrderService.AddOrder error: %w", errCost)
 	}
 
-	packageType := models.PackageType(request.GetPackageType())
-	if errAdd := o.Module.AddOrder(orderId, customerId, expirationTime, packageType, weight, cost); errAdd != nil {
+	order, errAdd := o.Module.AddOrder(ctx, ref, customerId, expirationTime, packagingFromRequest(request), weight, dimensionsFromProto(request.GetDimensions()), cost, itemsFromProto(request.GetItems()), operatorFromContext(ctx))
+	if errAdd != nil {
 		return nil, fmt.Errorf("OrderService.AddOrder error: %w", errAdd)
 	}
 
 	metrics.IncAddedOrders(1)
 
-	if errCache := o.Redis.Delete(ctx, fmt.Sprintf("getOrders_%d", customerId)); errCache != nil {
+	if errCache := o.Redis.Delete(ctx, cache.OrdersKey(models.PointFromContext(ctx), customerId)); errCache != nil {
 		return nil, fmt.Errorf("OrderService.AddOrder error clearing cache: %w", errCache)
 	}
 
-	return &emptypb.Empty{}, nil
+	return &orders_grpc.AddOrderResponse{OrderId: int64(order.OrderID), Cell: string(order.Cell)}, nil
 }
 
 // ReturnOrder Инвалидация кеша происходит на этапе успешного возврата заказа курьеру (или его удаление из базы).
@@ -81,23 +66,45 @@ func (o *OrderService) ReturnOrder(ctx context.Context, request *orders_grpc.Ret
 	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.ReturnOrder")
 	defer span.Finish()
 
-	orderId := models.ID(request.GetOrderId())
-	if orderId <= 0 {
-		return nil, fmt.Errorf("OrderService.ReturnOrder error: %w", errIncorrectId)
+	orderId, errResolve := o.resolveOrderID(ctx, request.GetOrderId(), request.GetExternal())
+	if errResolve != nil {
+		return nil, fmt.Errorf("OrderService.ReturnOrder error: %w", errResolve)
 	}
 
-	order, errReturn := o.Module.ReturnOrder(orderId)
+	order, errReturn := o.Module.ReturnOrder(ctx, orderId, operatorFromContext(ctx))
 	if errReturn != nil {
 		return nil, fmt.Errorf("OrderService.ReturnOrder error: %w", errReturn)
 	}
 
-	if errCache := o.Redis.Delete(ctx, fmt.Sprintf("getOrders_%d", order.CustomerID)); errCache != nil {
+	if errCache := o.Redis.Delete(ctx, cache.OrdersKey(models.PointFromContext(ctx), order.CustomerID)); errCache != nil {
 		return nil, fmt.Errorf("OrderService.ReturnOrder error: %w", errCache)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// MoveOrder Инвалидация кеша происходит после того, как заказ переложен: в списке заказов клиента меняется ячейка.
+func (o *OrderService) MoveOrder(ctx context.Context, request *orders_grpc.MoveOrderRequest) (*orders_grpc.MoveOrderResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.MoveOrder")
+	defer span.Finish()
+
+	orderId, errResolve := o.resolveOrderID(ctx, request.GetOrderId(), request.GetExternal())
+	if errResolve != nil {
+		return nil, fmt.Errorf("OrderService.MoveOrder error: %w", errResolve)
+	}
+
+	order, errMove := o.Module.MoveOrder(ctx, orderId, models.CellCode(request.GetCell()), operatorFromContext(ctx))
+	if errMove != nil {
+		return nil, fmt.Errorf("OrderService.MoveOrder error: %w", errMove)
+	}
+
+	if errCache := o.Redis.Delete(ctx, cache.OrdersKey(models.PointFromContext(ctx), order.CustomerID)); errCache != nil {
+		return nil, fmt.Errorf("OrderService.MoveOrder error: %w", errCache)
+	}
+
+	return &orders_grpc.MoveOrderResponse{Order: orderToProto(order)}, nil
+}
+
 // ReceiveOrders Инвалидация кеша происходит на этапе успешного получения заказа.
 // В этом случае из кеша удаляется ключ, содержащий информацию о всех заказах пользователя, для которого был получен заказ.
 // Это сделано, потому что после получения заказа информация о заказах пользователя в кеше устаревает (изменяется поле Received).
@@ -105,37 +112,23 @@ func (o *OrderService) ReceiveOrders(ctx context.Context, request *orders_grpc.R
 	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.ReceiveOrders")
 	defer span.Finish()
 
-	ids := make([]models.ID, len(request.GetOrderIds()))
-	for i, id := range request.GetOrderIds() {
-		if id <= 0 {
-			return nil, fmt.Errorf("OrderService.ReceiveOrders error: %w", errIncorrectId)
-		}
-
-		ids[i] = models.ID(id)
+	ids, errResolve := o.resolveOrderIDs(ctx, request.GetOrderIds(), request.GetExternals())
+	if errResolve != nil {
+		return nil, fmt.Errorf("OrderService.ReceiveOrders error: %w", errResolve)
 	}
 
-	orders, err := o.Module.ReceiveOrders(ids)
+	orders, err := o.Module.ReceiveOrders(ctx, ids, request.GetPickupCode(), idsFromProto(request.GetDeclinedItemIds()), operatorFromContext(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("OrderService.ReceiveOrders error: %w", err)
 	}
 
-	if errCache := o.Redis.Delete(ctx, fmt.Sprintf("getOrders_%d", orders[0].CustomerID)); errCache != nil {
+	if errCache := o.Redis.Delete(ctx, cache.OrdersKey(models.PointFromContext(ctx), orders[0].CustomerID)); errCache != nil {
 		return nil, fmt.Errorf("OrderService.ReceiveOrders error: %w", errCache)
 	}
 
-	response := &orders_grpc.ReceiveOrdersResponse{}
+	response := &orders_grpc.ReceiveOrdersResponse{PickCells: pickCells(orders)}
 	for _, order := range orders {
-		response.Orders = append(response.Orders, &orders_grpc.Order{
-			OrderId:        int64(order.OrderID),
-			CustomerId:     int64(order.CustomerID),
-			ExpirationTime: timestamppb.New(order.ExpirationTime),
-			Received:       order.ReceivedByCustomer,
-			Refunded:       order.Refunded,
-			PackageType:    string(order.Package),
-			Weight:         float64(order.Weight),
-			Cost:           float64(order.Cost),
-			PackCost:       float64(order.PackageCost),
-		})
+		response.Orders = append(response.Orders, orderToProto(order))
 	}
 
 	metrics.IncReceivedOrders(len(orders))
@@ -143,19 +136,100 @@ func (o *OrderService) ReceiveOrders(ctx context.Context, request *orders_grpc.R
 	return response, nil
 }
 
+// StartTryOn Заказы на примерке по-прежнему числятся в пункте, но в списке заказов клиента у них появляется
+// время окончания примерки, поэтому кеш списка сбрасывается.
+func (o *OrderService) StartTryOn(ctx context.Context, request *orders_grpc.StartTryOnRequest) (*orders_grpc.StartTryOnResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.StartTryOn")
+	defer span.Finish()
+
+	ids, errResolve := o.resolveOrderIDs(ctx, request.GetOrderIds(), request.GetExternals())
+	if errResolve != nil {
+		return nil, fmt.Errorf("OrderService.StartTryOn error: %w", errResolve)
+	}
+
+	orders, err := o.Module.StartTryOn(ctx, ids, request.GetPickupCode(), operatorFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("OrderService.StartTryOn error: %w", err)
+	}
+
+	if errCache := o.Redis.Delete(ctx, cache.OrdersKey(models.PointFromContext(ctx), orders[0].CustomerID)); errCache != nil {
+		return nil, fmt.Errorf("OrderService.StartTryOn error: %w", errCache)
+	}
+
+	response := &orders_grpc.StartTryOnResponse{}
+	for _, order := range orders {
+		response.Orders = append(response.Orders, orderToProto(order))
+	}
+
+	return response, nil
+}
+
+// ConfirmTryOn Забранные после примерки заказы учитываются в метрике выданных так же, как в ReceiveOrders.
+func (o *OrderService) ConfirmTryOn(ctx context.Context, request *orders_grpc.ConfirmTryOnRequest) (*orders_grpc.ConfirmTryOnResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.ConfirmTryOn")
+	defer span.Finish()
+
+	decisions := make([]models.TryOnDecision, 0, len(request.GetDecisions()))
+	for _, decision := range request.GetDecisions() {
+		decisions = append(decisions, models.TryOnDecision{
+			OrderID: models.ID(decision.GetOrderId()),
+			Outcome: tryOnOutcomes[decision.GetOutcome()],
+		})
+	}
+
+	orders, err := o.Module.ConfirmTryOn(ctx, decisions, idsFromProto(request.GetDeclinedItemIds()), operatorFromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("OrderService.ConfirmTryOn error: %w", err)
+	}
+
+	if errCache := o.Redis.Delete(ctx, cache.OrdersKey(models.PointFromContext(ctx), orders[0].CustomerID)); errCache != nil {
+		return nil, fmt.Errorf("OrderService.ConfirmTryOn error: %w", errCache)
+	}
+
+	response := &orders_grpc.ConfirmTryOnResponse{}
+	issued := 0
+	for _, order := range orders {
+		response.Orders = append(response.Orders, orderToProto(order))
+		if order.Status == models.StatusIssued {
+			issued++
+		}
+	}
+
+	metrics.IncReceivedOrders(issued)
+
+	return response, nil
+}
+
+// GetOrders Кешируется только полный список заказов клиента без фильтров: изменяющие методы сбрасывают его по ключу cache.OrdersKey.
+// Страницы и выборки с фильтрами идут в базу, где их обслуживает индекс по клиенту.
 func (o *OrderService) GetOrders(ctx context.Context, request *orders_grpc.GetOrdersRequest) (resp *orders_grpc.GetOrdersResponse, err error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.GetOrders")
 	defer span.Finish()
 
-	cachedKey := fmt.Sprintf("getOrders_%d", request.GetCustomerId())
+	query, err := ordersQueryFromRequest(request)
+	if err != nil {
+		return nil, fmt.Errorf("OrderService.GetOrders error: %w", err)
+	}
+
+	if !isFullOrdersList(query) {
+		page, errGet := o.Module.GetOrders(ctx, query)
+		if errGet != nil {
+			return nil, fmt.Errorf("OrderService.GetOrders error: %w", errGet)
+		}
+
+		return ordersPageToProto(page)
+	}
+
+	cachedKey := cache.OrdersKey(models.PointFromContext(ctx), models.ID(request.GetCustomerId()))
 
 	orders, ok := o.Redis.Get(ctx, cachedKey)
 	if !ok {
 		log.Println("cache is empty for key", cachedKey)
-		orders, err = o.Module.GetOrders(models.ID(request.GetCustomerId()), int(request.GetN()))
-		if err != nil {
-			return nil, fmt.Errorf("service.OrderService error: %w", err)
+		page, errGet := o.Module.GetOrders(ctx, query)
+		if errGet != nil {
+			return nil, fmt.Errorf("service.OrderService error: %w", errGet)
 		}
+		orders = page.Orders
 
 		if len(orders) > 0 {
 			if err = o.Redis.Set(ctx, cachedKey, orders, time.Now()); err != nil {
@@ -168,85 +242,192 @@ func (o *OrderService) GetOrders(ctx context.Context, request *orders_grpc.GetOr
 		log.Println("cache is not empty for key", cachedKey)
 	}
 
-	resp = &orders_grpc.GetOrdersResponse{}
-	for _, order := range orders {
-		resp.Orders = append(resp.Orders, &orders_grpc.Order{
-			OrderId:        int64(order.OrderID),
-			CustomerId:     int64(order.CustomerID),
-			ExpirationTime: timestamppb.New(order.ExpirationTime),
-			Received:       order.ReceivedByCustomer,
-			Refunded:       order.Refunded,
-			PackageType:    string(order.Package),
-			Weight:         float64(order.Weight),
-			Cost:           float64(order.Cost),
-			PackCost:       float64(order.PackageCost),
-		})
-	}
-
-	return resp, nil
+	return ordersPageToProto(models.OrdersPage{Orders: orders})
 }
 
 // CreateRefund Инвалидация кеша происходит на этапе успешного создания заявки на возврат заказа.
 // В этом случае из кеша удаляется ключ, содержащий информацию о всех заказах пользователя, для которого был возвращен заказ.
-// Это сделано, потому что после возврата заказа информация о заказах пользователя в кеше устаревает (изменяется поле Refunded).
-func (o *OrderService) CreateRefund(ctx context.Context, request *orders_grpc.CreateRefundRequest) (*emptypb.Empty, error) {
+// Это сделано, потому что после заявки на возврат информация о заказах пользователя в кеше устаревает (изменяется статус заказа).
+func (o *OrderService) CreateRefund(ctx context.Context, request *orders_grpc.CreateRefundRequest) (*orders_grpc.CreateRefundResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.CreateRefund")
 	defer span.Finish()
 
-	orderId := models.ID(request.GetOrderId())
-	customerId := models.ID(request.GetCustomerId())
-	if orderId <= 0 || customerId <= 0 {
-		return nil, fmt.Errorf("OrderService.CreateRefund error: %w", errIncorrectId)
+	orderId, errResolve := o.resolveOrderID(ctx, request.GetOrderId(), request.GetExternal())
+	if errResolve != nil {
+		return nil, fmt.Errorf("OrderService.CreateRefund error: %w", errResolve)
 	}
+	customerId := models.ID(request.GetCustomerId())
 
-	if errRefund := o.Module.RefundOrder(customerId, orderId); errRefund != nil {
+	refund, errRefund := o.Module.RefundOrder(ctx, customerId, orderId, refundReasons[request.GetReason()], request.GetComment(), idsFromProto(request.GetItemIds()), operatorFromContext(ctx))
+	if errRefund != nil {
 		return nil, fmt.Errorf("OrderService.CreateRefund error: %w", errRefund)
 	}
 
-	if errCache := o.Redis.Delete(ctx, fmt.Sprintf("getOrders_%d", customerId)); errCache != nil {
+	if errCache := o.Redis.Delete(ctx, cache.OrdersKey(models.PointFromContext(ctx), customerId)); errCache != nil {
 		return nil, fmt.Errorf("OrderService.CreateRefund error: %w", errCache)
 	}
 
-	metrics.IncRefundedOrders(1)
+	return &orders_grpc.CreateRefundResponse{Refund: refundToProto(refund)}, nil
+}
 
-	return &emptypb.Empty{}, nil
+// DecideRefund Инвалидация кеша происходит на этапе успешного решения по возврату:
+// принятие и отклонение меняют статус заказа, поэтому список заказов клиента в кеше устаревает.
+func (o *OrderService) DecideRefund(ctx context.Context, request *orders_grpc.DecideRefundRequest) (*orders_grpc.DecideRefundResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.DecideRefund")
+	defer span.Finish()
+
+	refund, errDecide := o.Module.DecideRefund(ctx, models.ID(request.GetRefundId()), refundDecisions[request.GetDecision()],
+		request.GetComment(), operatorFromContext(ctx))
+	if errDecide != nil {
+		return nil, fmt.Errorf("OrderService.DecideRefund error: %w", errDecide)
+	}
+
+	if errCache := o.Redis.Delete(ctx, cache.OrdersKey(models.PointFromContext(ctx), refund.CustomerID)); errCache != nil {
+		return nil, fmt.Errorf("OrderService.DecideRefund error: %w", errCache)
+	}
+
+	if refund.State == models.RefundApproved {
+		metrics.IncRefundedOrders(1)
+	}
+
+	return &orders_grpc.DecideRefundResponse{Refund: refundToProto(refund)}, nil
 }
 
-func (o *OrderService) GetRefunds(ctx context.Context, request *orders_grpc.GetRefundsRequest) (resp *orders_grpc.GetRefundsResponse, err error) {
+// GetRefund Результат не кешируется: по истории решений разбираются споры, она должна быть актуальной.
+func (o *OrderService) GetRefund(ctx context.Context, request *orders_grpc.GetRefundRequest) (*orders_grpc.GetRefundResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.GetRefund")
+	defer span.Finish()
+
+	refund, history, errGet := o.Module.GetRefund(ctx, models.ID(request.GetRefundId()))
+	if errGet != nil {
+		return nil, fmt.Errorf("OrderService.GetRefund error: %w", errGet)
+	}
+
+	resp := &orders_grpc.GetRefundResponse{Refund: refundToProto(refund)}
+	for _, change := range history {
+		resp.Events = append(resp.Events, refundChangeToProto(change))
+	}
+
+	return resp, nil
+}
+
+// GetRefunds Результат не кешируется: новые возвраты меняют общее количество и последнюю страницу,
+// а выборка по диапазону дат и курсору обслуживается индексом по времени возврата.
+func (o *OrderService) GetRefunds(ctx context.Context, request *orders_grpc.GetRefundsRequest) (*orders_grpc.GetRefundsResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.GetRefunds")
 	defer span.Finish()
 
-	cachedKey := fmt.Sprintf("getRefunds_p%d_l%d", request.GetPage(), request.GetLimit())
+	query, errQuery := refundsQueryFromRequest(ctx, request)
+	if errQuery != nil {
+		return nil, fmt.Errorf("OrderService.GetRefunds error: %w", errQuery)
+	}
 
-	refunds, ok := o.Redis.Get(ctx, cachedKey)
-	if !ok {
-		log.Println("cache is empty for key", cachedKey)
-		refunds, err = o.Module.GetRefunds(int(request.GetPage()), int(request.GetLimit()))
-		if err != nil {
-			return nil, fmt.Errorf("OrderService.GetRefunds error: %w", err)
-		}
+	page, errGet := o.Module.GetRefunds(ctx, query)
+	if errGet != nil {
+		return nil, fmt.Errorf("OrderService.GetRefunds error: %w", errGet)
+	}
 
-		if len(refunds) > 0 {
-			if err = o.Redis.Set(ctx, cachedKey, refunds, time.Now()); err != nil {
-				return nil, fmt.Errorf("OrderService.GetRefunds error: %w", err)
-			}
-		}
+	return refundsPageToProto(page)
+}
+
+// GetOrderHistory Результат не кешируется: история меняется при каждом действии с заказом и запрашивается редко.
+func (o *OrderService) GetOrderHistory(ctx context.Context, request *orders_grpc.GetOrderHistoryRequest) (*orders_grpc.GetOrderHistoryResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.GetOrderHistory")
+	defer span.Finish()
+
+	orderId, errResolve := o.resolveOrderID(ctx, request.GetOrderId(), request.GetExternal())
+	if errResolve != nil {
+		return nil, fmt.Errorf("OrderService.GetOrderHistory error: %w", errResolve)
+	}
+
+	history, err := o.Module.GetOrderHistory(ctx, orderId)
+	if err != nil {
+		return nil, fmt.Errorf("OrderService.GetOrderHistory error: %w", err)
 	}
 
-	resp = &orders_grpc.GetRefundsResponse{}
-	for _, refund := range refunds {
-		resp.Refunds = append(resp.Refunds, &orders_grpc.Order{
-			OrderId:        int64(refund.OrderID),
-			CustomerId:     int64(refund.CustomerID),
-			ExpirationTime: timestamppb.New(refund.ExpirationTime),
-			Received:       refund.ReceivedByCustomer,
-			Refunded:       refund.Refunded,
-			PackageType:    string(refund.Package),
-			Weight:         float64(refund.Weight),
-			Cost:           float64(refund.Cost),
-			PackCost:       float64(refund.PackageCost),
+	resp := &orders_grpc.GetOrderHistoryResponse{}
+	for _, change := range history {
+		resp.Events = append(resp.Events, &orders_grpc.OrderEvent{
+			OrderId:    int64(change.OrderID),
+			StatusFrom: string(change.From),
+			StatusTo:   string(change.To),
+			Reason:     change.Reason,
+			Operator:   string(change.Operator),
+			Time:       timestamppb.New(change.ChangedAt),
 		})
 	}
 
 	return resp, nil
 }
+
+func (o *OrderService) CreatePackageType(ctx context.Context, request *orders_grpc.CreatePackageTypeRequest) (*orders_grpc.CreatePackageTypeResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.CreatePackageType")
+	defer span.Finish()
+
+	spec, errAdd := o.Module.AddPackage(ctx, packageFromProto(request.GetPackageType()))
+	if errAdd != nil {
+		return nil, fmt.Errorf("OrderService.CreatePackageType error: %w", errAdd)
+	}
+
+	return &orders_grpc.CreatePackageTypeResponse{PackageType: packageToProto(spec)}, nil
+}
+
+func (o *OrderService) UpdatePackageType(ctx context.Context, request *orders_grpc.UpdatePackageTypeRequest) (*orders_grpc.UpdatePackageTypeResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.UpdatePackageType")
+	defer span.Finish()
+
+	spec, errUpdate := o.Module.UpdatePackage(ctx, packageFromProto(request.GetPackageType()))
+	if errUpdate != nil {
+		return nil, fmt.Errorf("OrderService.UpdatePackageType error: %w", errUpdate)
+	}
+
+	return &orders_grpc.UpdatePackageTypeResponse{PackageType: packageToProto(spec)}, nil
+}
+
+func (o *OrderService) RetirePackageType(ctx context.Context, request *orders_grpc.RetirePackageTypeRequest) (*orders_grpc.RetirePackageTypeResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.RetirePackageType")
+	defer span.Finish()
+
+	spec, errRetire := o.Module.RetirePackage(ctx, models.PackageType(request.GetName()))
+	if errRetire != nil {
+		return nil, fmt.Errorf("OrderService.RetirePackageType error: %w", errRetire)
+	}
+
+	return &orders_grpc.RetirePackageTypeResponse{PackageType: packageToProto(spec)}, nil
+}
+
+// ListPackageTypes Результат не кешируется: каталог небольшой, а после изменения цены сотрудник должен сразу видеть новую.
+func (o *OrderService) ListPackageTypes(ctx context.Context, request *orders_grpc.ListPackageTypesRequest) (*orders_grpc.ListPackageTypesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.ListPackageTypes")
+	defer span.Finish()
+
+	specs, errGet := o.Module.GetPackages(ctx, request.GetIncludeRetired())
+	if errGet != nil {
+		return nil, fmt.Errorf("OrderService.ListPackageTypes error: %w", errGet)
+	}
+
+	resp := &orders_grpc.ListPackageTypesResponse{}
+	for _, spec := range specs {
+		resp.PackageTypes = append(resp.PackageTypes, packageToProto(spec))
+	}
+
+	return resp, nil
+}
+
+// GetQuote Не кешируется по той же причине, что и ListPackageTypes.
+func (o *OrderService) GetQuote(ctx context.Context, request *orders_grpc.GetQuoteRequest) (*orders_grpc.GetQuoteResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "api.OrderService.GetQuote")
+	defer span.Finish()
+
+	quotes, errQuote := o.Module.GetQuote(ctx, models.Kilo(request.GetWeight()), dimensionsFromProto(request.GetDimensions()), moneyFromProto(request.GetCostMoney()))
+	if errQuote != nil {
+		return nil, fmt.Errorf("OrderService.GetQuote error: %w", errQuote)
+	}
+
+	resp := &orders_grpc.GetQuoteResponse{}
+	for _, quote := range quotes {
+		resp.Options = append(resp.Options, quoteToProto(quote))
+	}
+
+	return resp, nil
+}
@@ -6,6 +6,7 @@ package api
 import (
 	"context"
 	"fmt"
+	"homework-1/internal/cache"
 	"homework-1/internal/module"
 	"homework-1/internal/storage"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	mockcache "homework-1/internal/cache/mocks"
 	"homework-1/internal/models"
 	mockmodule "homework-1/internal/module/mocks"
@@ -30,7 +32,7 @@ func TestOrderService_AddOrder(t *testing.T) {
 
 	t.Run("Успешное добавление заказа", func(t *testing.T) {
 		request := &orders_grpc.AddOrderRequest{
-			OrderId:        100,
+			Order:          &orders_grpc.AddOrderRequest_External{External: &orders_grpc.ExternalOrderRef{Source: "marketplace", Number: "A-100"}},
 			CustomerId:     100,
 			ExpirationTime: "10-10-2024",
 			PackageType:    "box",
@@ -38,10 +40,30 @@ func TestOrderService_AddOrder(t *testing.T) {
 			Cost:           1,
 		}
 
-		expirationDate, _ := time.Parse(dateLayout, request.ExpirationTime)
+		expirationDate, _ := orderService.PickupPoint.ParseDate(request.ExpirationTime)
 
-		mockModule.EXPECT().AddOrder(models.ID(100), models.ID(100), expirationDate, models.PackageType("box"), models.Kilo(1), models.Rub(1)).Return(nil)
-		mockCache.EXPECT().Delete(gomock.Any(), fmt.Sprintf("getOrders_%d", request.CustomerId)).Return(nil)
+		mockModule.EXPECT().AddOrder(gomock.Any(), models.ExternalRef{Source: "marketplace", Number: "A-100"}, models.ID(100), expirationDate, models.Packaging{"box"}, models.Kilo(1), models.Dimensions{}, models.Rubles(1), gomock.Nil(), models.Operator("")).Return(models.Order{OrderID: models.ID(7), Cell: "A-01-01"}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", models.ID(request.CustomerId))).Return(nil)
+
+		resp, err := orderService.AddOrder(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), resp.GetOrderId())
+		assert.Equal(t, "A-01-01", resp.GetCell())
+	})
+
+	t.Run("Слои упаковки важнее одиночного типа", func(t *testing.T) {
+		request := &orders_grpc.AddOrderRequest{
+			Order:         &orders_grpc.AddOrderRequest_External{External: &orders_grpc.ExternalOrderRef{Source: "marketplace", Number: "A-101"}},
+			CustomerId:    100,
+			Expiration:    timestamppb.New(time.Now().Add(time.Hour)),
+			PackageType:   "bag",
+			PackageLayers: []string{"box", "wrap"},
+			Weight:        1,
+			CostMoney:     &orders_grpc.Money{AmountMinor: 100},
+		}
+
+		mockModule.EXPECT().AddOrder(gomock.Any(), gomock.Any(), models.ID(100), gomock.Any(), models.Packaging{"box", "wrap"}, models.Kilo(1), models.Dimensions{}, gomock.Any(), gomock.Nil(), models.Operator("")).Return(models.Order{OrderID: models.ID(8)}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", models.ID(request.CustomerId))).Return(nil)
 
 		_, err := orderService.AddOrder(context.Background(), request)
 		require.NoError(t, err)
@@ -49,7 +71,7 @@ func TestOrderService_AddOrder(t *testing.T) {
 
 	t.Run("Попытка добавить заказ с существующим ID", func(t *testing.T) {
 		request := &orders_grpc.AddOrderRequest{
-			OrderId:        1,
+			Order:          &orders_grpc.AddOrderRequest_OrderId{OrderId: 1},
 			CustomerId:     1,
 			ExpirationTime: "10-10-2024",
 			PackageType:    "box",
@@ -57,13 +79,66 @@ func TestOrderService_AddOrder(t *testing.T) {
 			Cost:           1,
 		}
 
-		expirationDate, _ := time.Parse(dateLayout, request.ExpirationTime)
-		mockModule.EXPECT().AddOrder(models.ID(1), models.ID(1), expirationDate, models.PackageType("box"), models.Kilo(1), models.Rub(1)).Return(storage.ErrOrderExists)
+		expirationDate, _ := orderService.PickupPoint.ParseDate(request.ExpirationTime)
+		mockModule.EXPECT().AddOrder(gomock.Any(), models.ExternalRef{Source: models.LegacySource, Number: "1"}, models.ID(1), expirationDate, models.Packaging{"box"}, models.Kilo(1), models.Dimensions{}, models.Rubles(1), gomock.Nil(), models.Operator("")).Return(models.Order{}, storage.ErrOrderExists)
 
 		_, err := orderService.AddOrder(context.Background(), request)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), storage.ErrOrderExists.Error())
 	})
+	t.Run("Стоимость в копейках без потерь", func(t *testing.T) {
+		request := &orders_grpc.AddOrderRequest{
+			Order:          &orders_grpc.AddOrderRequest_OrderId{OrderId: 2},
+			CustomerId:     2,
+			ExpirationTime: "10-10-2024",
+			PackageType:    "box",
+			Weight:         1,
+			CostMoney:      &orders_grpc.Money{AmountMinor: 10001},
+		}
+
+		expirationDate, _ := orderService.PickupPoint.ParseDate(request.ExpirationTime)
+		mockModule.EXPECT().AddOrder(gomock.Any(), models.ExternalRef{Source: models.LegacySource, Number: "2"}, models.ID(2), expirationDate, models.Packaging{"box"}, models.Kilo(1), models.Dimensions{}, models.NewMoney(10001, models.CurrencyRUB), gomock.Nil(), models.Operator("")).Return(models.Order{OrderID: models.ID(2)}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", models.ID(request.CustomerId))).Return(nil)
+
+		_, err := orderService.AddOrder(context.Background(), request)
+		require.NoError(t, err)
+	})
+
+	t.Run("Срок хранения передан как Timestamp", func(t *testing.T) {
+		expiration := time.Date(2024, time.October, 10, 0, 0, 0, 0, time.UTC)
+		request := &orders_grpc.AddOrderRequest{
+			Order:       &orders_grpc.AddOrderRequest_OrderId{OrderId: 4},
+			CustomerId:  4,
+			Expiration:  timestamppb.New(expiration),
+			PackageType: "box",
+			Weight:      1,
+			CostMoney:   &orders_grpc.Money{AmountMinor: 100},
+		}
+
+		mockModule.EXPECT().AddOrder(gomock.Any(), models.ExternalRef{Source: models.LegacySource, Number: "4"}, models.ID(4), expiration, models.Packaging{"box"}, models.Kilo(1), models.Dimensions{}, models.Rubles(1), gomock.Nil(), models.Operator("")).Return(models.Order{OrderID: models.ID(4)}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", models.ID(request.CustomerId))).Return(nil)
+
+		_, err := orderService.AddOrder(context.Background(), request)
+		require.NoError(t, err)
+	})
+
+	t.Run("Устаревшее поле стоимости переводится в копейки точно", func(t *testing.T) {
+		request := &orders_grpc.AddOrderRequest{
+			Order:          &orders_grpc.AddOrderRequest_OrderId{OrderId: 3},
+			CustomerId:     3,
+			ExpirationTime: "10-10-2024",
+			PackageType:    "box",
+			Weight:         1,
+			Cost:           10.1,
+		}
+
+		expirationDate, _ := orderService.PickupPoint.ParseDate(request.ExpirationTime)
+		mockModule.EXPECT().AddOrder(gomock.Any(), models.ExternalRef{Source: models.LegacySource, Number: "3"}, models.ID(3), expirationDate, models.Packaging{"box"}, models.Kilo(1), models.Dimensions{}, models.NewMoney(1010, models.CurrencyRUB), gomock.Nil(), models.Operator("")).Return(models.Order{OrderID: models.ID(3)}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", models.ID(request.CustomerId))).Return(nil)
+
+		_, err := orderService.AddOrder(context.Background(), request)
+		require.NoError(t, err)
+	})
 }
 
 func TestOrderService_ReturnOrder(t *testing.T) {
@@ -76,13 +151,13 @@ func TestOrderService_ReturnOrder(t *testing.T) {
 
 	t.Run("Успешный возврат товара курьеру", func(t *testing.T) {
 		request := &orders_grpc.ReturnOrderRequest{
-			OrderId: 1,
+			Order: &orders_grpc.ReturnOrderRequest_OrderId{OrderId: 1},
 		}
 
 		order := models.Order{CustomerID: models.ID(1)}
 
-		mockModule.EXPECT().ReturnOrder(models.ID(1)).Return(order, nil)
-		mockCache.EXPECT().Delete(gomock.Any(), fmt.Sprintf("getOrders_%d", order.CustomerID)).Return(nil)
+		mockModule.EXPECT().ReturnOrder(gomock.Any(), models.ID(1), models.Operator("")).Return(order, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", order.CustomerID)).Return(nil)
 
 		_, err := orderService.ReturnOrder(context.Background(), request)
 		require.NoError(t, err)
@@ -90,15 +165,115 @@ func TestOrderService_ReturnOrder(t *testing.T) {
 
 	t.Run("Попытка вернуть курьеру ранее забранный заказ", func(t *testing.T) {
 		request := &orders_grpc.ReturnOrderRequest{
-			OrderId: 1,
+			Order: &orders_grpc.ReturnOrderRequest_OrderId{OrderId: 1},
 		}
 
-		mockModule.EXPECT().ReturnOrder(models.ID(1)).Return(models.Order{}, module.ErrReturn)
+		mockModule.EXPECT().ReturnOrder(gomock.Any(), models.ID(1), models.Operator("")).Return(models.Order{}, module.ErrReturn)
 
 		_, err := orderService.ReturnOrder(context.Background(), request)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), module.ErrReturn.Error())
 	})
+
+	t.Run("Возврат заказа по внешнему номеру продавца", func(t *testing.T) {
+		ref := models.ExternalRef{Source: "marketplace", Number: "A-5"}
+		request := &orders_grpc.ReturnOrderRequest{
+			Order: &orders_grpc.ReturnOrderRequest_External{External: &orders_grpc.ExternalOrderRef{Source: ref.Source, Number: ref.Number}},
+		}
+
+		order := models.Order{OrderID: models.ID(5), CustomerID: models.ID(5), External: ref}
+
+		mockModule.EXPECT().ResolveOrderID(gomock.Any(), ref).Return(models.ID(5), nil)
+		mockModule.EXPECT().ReturnOrder(gomock.Any(), models.ID(5), models.Operator("")).Return(order, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", order.CustomerID)).Return(nil)
+
+		_, err := orderService.ReturnOrder(context.Background(), request)
+		require.NoError(t, err)
+	})
+
+	t.Run("Попытка вернуть заказ по неизвестному внешнему номеру", func(t *testing.T) {
+		ref := models.ExternalRef{Source: "marketplace", Number: "A-6"}
+		request := &orders_grpc.ReturnOrderRequest{
+			Order: &orders_grpc.ReturnOrderRequest_External{External: &orders_grpc.ExternalOrderRef{Source: ref.Source, Number: ref.Number}},
+		}
+
+		mockModule.EXPECT().ResolveOrderID(gomock.Any(), ref).Return(models.ID(0), storage.ErrOrderNotFound)
+
+		_, err := orderService.ReturnOrder(context.Background(), request)
+		assert.ErrorIs(t, err, storage.ErrOrderNotFound)
+	})
+}
+
+func TestOrderService_LegacyOrderID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	mockCache := mockcache.NewMockCacheInterface(ctrl)
+	orderService := &OrderService{Module: mockModule, Redis: mockCache}
+
+	// Старый клиент добавил заказ со своим номером 42, сервер выдал заказу идентификатор 7.
+	legacy := models.ExternalRef{Source: models.LegacySource, Number: "42"}
+	order := models.Order{OrderID: models.ID(7), CustomerID: models.ID(1), External: legacy}
+
+	t.Run("order_id всегда идентификатор заказа, а не номер старого клиента", func(t *testing.T) {
+		other := models.Order{OrderID: models.ID(42), CustomerID: models.ID(2)}
+		mockModule.EXPECT().ReturnOrder(gomock.Any(), other.OrderID, models.Operator("")).Return(other, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", other.CustomerID)).Return(nil)
+
+		_, err := orderService.ReturnOrder(context.Background(), &orders_grpc.ReturnOrderRequest{
+			Order: &orders_grpc.ReturnOrderRequest_OrderId{OrderId: 42},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("Номер старого клиента передается как внешний номер legacy", func(t *testing.T) {
+		mockModule.EXPECT().ResolveOrderID(gomock.Any(), legacy).Return(order.OrderID, nil)
+		mockModule.EXPECT().ReturnOrder(gomock.Any(), order.OrderID, models.Operator("")).Return(order, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", order.CustomerID)).Return(nil)
+
+		_, err := orderService.ReturnOrder(context.Background(), &orders_grpc.ReturnOrderRequest{
+			Order: &orders_grpc.ReturnOrderRequest_External{External: &orders_grpc.ExternalOrderRef{Source: models.LegacySource, Number: "42"}},
+		})
+		require.NoError(t, err)
+	})
+}
+
+func TestOrderService_MoveOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	mockCache := mockcache.NewMockCacheInterface(ctrl)
+	orderService := &OrderService{Module: mockModule, Redis: mockCache}
+
+	t.Run("Заказ перекладывается в другую ячейку", func(t *testing.T) {
+		request := &orders_grpc.MoveOrderRequest{
+			Order: &orders_grpc.MoveOrderRequest_OrderId{OrderId: 1},
+			Cell:  "L-01-01",
+		}
+
+		order := models.Order{OrderID: models.ID(1), CustomerID: models.ID(1), Cell: "L-01-01"}
+
+		mockModule.EXPECT().MoveOrder(gomock.Any(), models.ID(1), models.CellCode("L-01-01"), models.Operator("")).Return(order, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", order.CustomerID)).Return(nil)
+
+		response, err := orderService.MoveOrder(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, "L-01-01", response.GetOrder().GetCell())
+	})
+
+	t.Run("Заказ нельзя переложить", func(t *testing.T) {
+		request := &orders_grpc.MoveOrderRequest{
+			Order: &orders_grpc.MoveOrderRequest_OrderId{OrderId: 2},
+			Cell:  "A-01-01",
+		}
+
+		mockModule.EXPECT().MoveOrder(gomock.Any(), models.ID(2), models.CellCode("A-01-01"), models.Operator("")).Return(models.Order{}, module.ErrMove)
+
+		_, err := orderService.MoveOrder(context.Background(), request)
+		assert.ErrorIs(t, err, module.ErrMove)
+	})
 }
 
 func TestOrderService_ReceiveOrders(t *testing.T) {
@@ -111,14 +286,15 @@ func TestOrderService_ReceiveOrders(t *testing.T) {
 
 	t.Run("Успешное получение заказа", func(t *testing.T) {
 		request := &orders_grpc.ReceiveOrdersRequest{
-			OrderIds: []int64{100},
+			OrderIds:   []int64{100},
+			PickupCode: "123456",
 		}
 
 		customerID := models.ID(100)
 		expirationDate := time.Now().Add(time.Hour)
-		packageType := models.PackageType("box")
+		packageType := models.Packaging{"box"}
 		weight := models.Kilo(10)
-		cost := models.Rub(1000)
+		cost := models.Rubles(1000)
 		order := models.Order{
 			OrderID:            models.ID(100),
 			CustomerID:         customerID,
@@ -128,16 +304,138 @@ func TestOrderService_ReceiveOrders(t *testing.T) {
 			Package:            packageType,
 			Weight:             weight,
 			Cost:               cost,
-			PackageCost:        100,
+			PackageCost:        models.Rubles(100),
 		}
 
-		mockModule.EXPECT().ReceiveOrders([]models.ID{models.ID(100)}).Return([]models.Order{order}, nil)
-		mockCache.EXPECT().Delete(gomock.Any(), fmt.Sprintf("getOrders_%d", order.CustomerID)).Return(nil)
+		mockModule.EXPECT().ReceiveOrders(gomock.Any(), []models.ID{models.ID(100)}, "123456", gomock.Nil(), models.Operator("")).Return([]models.Order{order}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", order.CustomerID)).Return(nil)
 
 		response, err := orderService.ReceiveOrders(context.Background(), request)
 		require.NoError(t, err)
 		assert.Len(t, response.Orders, 1)
 		assert.Equal(t, response.Orders[0].OrderId, int64(order.OrderID))
+		assert.Equal(t, "box", response.Orders[0].GetPackageType())
+		assert.Equal(t, []string{"box"}, response.Orders[0].GetPackageLayers())
+	})
+
+	t.Run("Ячейки для сборки заказов перечисляются по порядку без повторов", func(t *testing.T) {
+		request := &orders_grpc.ReceiveOrdersRequest{
+			OrderIds:   []int64{300, 301, 302, 303},
+			PickupCode: "123456",
+		}
+
+		orders := []models.Order{
+			{OrderID: 300, CustomerID: 300, PickedFrom: "L-01-01"},
+			{OrderID: 301, CustomerID: 300, PickedFrom: "A-01-02"},
+			{OrderID: 302, CustomerID: 300, PickedFrom: "L-01-01"},
+			{OrderID: 303, CustomerID: 300},
+		}
+
+		mockModule.EXPECT().ReceiveOrders(gomock.Any(), []models.ID{300, 301, 302, 303}, "123456", gomock.Nil(), models.Operator("")).Return(orders, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", models.ID(300))).Return(nil).AnyTimes()
+
+		response, err := orderService.ReceiveOrders(context.Background(), request)
+		require.NoError(t, err)
+		assert.Len(t, response.GetOrders(), 4)
+		assert.Equal(t, []string{"A-01-02", "L-01-01"}, response.GetPickCells())
+	})
+
+	t.Run("Отказ от части товаров", func(t *testing.T) {
+		request := &orders_grpc.ReceiveOrdersRequest{
+			OrderIds:        []int64{200},
+			PickupCode:      "123456",
+			DeclinedItemIds: []int64{11},
+		}
+
+		order := models.Order{
+			OrderID:    models.ID(200),
+			CustomerID: models.ID(200),
+			Status:     models.StatusIssued,
+			Package:    models.Packaging{"box"},
+			Cost:       models.Rubles(300),
+			Items: []models.OrderItem{
+				{ID: 10, OrderID: 200, Name: "Кружка", Price: models.Rubles(100), Status: models.ItemIssued},
+				{ID: 11, OrderID: 200, Name: "Чайник", Price: models.Rubles(200), Status: models.ItemDeclined},
+			},
+		}
+
+		mockModule.EXPECT().ReceiveOrders(gomock.Any(), []models.ID{200}, "123456", []models.ID{11}, models.Operator("")).Return([]models.Order{order}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", order.CustomerID)).Return(nil)
+
+		response, err := orderService.ReceiveOrders(context.Background(), request)
+		require.NoError(t, err)
+		require.Len(t, response.Orders[0].GetItems(), 2)
+		assert.Equal(t, int64(11), response.Orders[0].GetItems()[1].GetItemId())
+		assert.Equal(t, orders_grpc.ItemStatus_ITEM_STATUS_ISSUED, response.Orders[0].GetItems()[0].GetStatus())
+		assert.Equal(t, orders_grpc.ItemStatus_ITEM_STATUS_DECLINED, response.Orders[0].GetItems()[1].GetStatus())
+	})
+}
+
+func TestOrderService_TryOn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	mockCache := mockcache.NewMockCacheInterface(ctrl)
+	orderService := &OrderService{Module: mockModule, Redis: mockCache}
+
+	t.Run("Выдача заказов на примерку", func(t *testing.T) {
+		request := &orders_grpc.StartTryOnRequest{
+			OrderIds:   []int64{300},
+			PickupCode: "123456",
+		}
+
+		until := time.Now().Add(20 * time.Minute)
+		order := models.Order{OrderID: models.ID(300), CustomerID: models.ID(300), Status: models.StatusTryingOn, TryOnUntil: until}
+
+		mockModule.EXPECT().StartTryOn(gomock.Any(), []models.ID{300}, "123456", models.Operator("")).Return([]models.Order{order}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", order.CustomerID)).Return(nil)
+
+		response, err := orderService.StartTryOn(context.Background(), request)
+		require.NoError(t, err)
+		require.Len(t, response.GetOrders(), 1)
+		assert.False(t, response.Orders[0].GetReceived())
+		assert.Equal(t, until.Unix(), response.Orders[0].GetTryOnUntil().AsTime().Unix())
+	})
+
+	t.Run("Решения клиента после примерки", func(t *testing.T) {
+		request := &orders_grpc.ConfirmTryOnRequest{
+			Decisions: []*orders_grpc.TryOnDecision{
+				{OrderId: 300, Outcome: orders_grpc.TryOnOutcome_TRY_ON_OUTCOME_KEEP},
+				{OrderId: 301, Outcome: orders_grpc.TryOnOutcome_TRY_ON_OUTCOME_REJECT},
+			},
+		}
+
+		orders := []models.Order{
+			{OrderID: models.ID(300), CustomerID: models.ID(300), Status: models.StatusIssued, ReceivedByCustomer: true},
+			{OrderID: models.ID(301), CustomerID: models.ID(300), Status: models.StatusReadyForPickup},
+		}
+		decisions := []models.TryOnDecision{
+			{OrderID: models.ID(300), Outcome: models.TryOnKeep},
+			{OrderID: models.ID(301), Outcome: models.TryOnReject},
+		}
+
+		mockModule.EXPECT().ConfirmTryOn(gomock.Any(), decisions, gomock.Len(0), models.Operator("")).Return(orders, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", 300)).Return(nil)
+
+		response, err := orderService.ConfirmTryOn(context.Background(), request)
+		require.NoError(t, err)
+		require.Len(t, response.GetOrders(), 2)
+		assert.True(t, response.Orders[0].GetReceived())
+		assert.False(t, response.Orders[1].GetReceived())
+		assert.Nil(t, response.Orders[1].GetTryOnUntil())
+	})
+
+	t.Run("Время примерки вышло", func(t *testing.T) {
+		request := &orders_grpc.ConfirmTryOnRequest{
+			Decisions: []*orders_grpc.TryOnDecision{{OrderId: 302, Outcome: orders_grpc.TryOnOutcome_TRY_ON_OUTCOME_KEEP}},
+		}
+
+		mockModule.EXPECT().ConfirmTryOn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, fmt.Errorf("module.ConfirmTryOn error: %w", module.ErrTryOnTimeout))
+
+		_, err := orderService.ConfirmTryOn(context.Background(), request)
+		assert.ErrorIs(t, err, module.ErrTryOnTimeout)
 	})
 }
 
@@ -152,7 +450,6 @@ func TestOrderService_GetOrders(t *testing.T) {
 	t.Run("Успешное получение списка заказов", func(t *testing.T) {
 		request := &orders_grpc.GetOrdersRequest{
 			CustomerId: 1,
-			N:          2,
 		}
 
 		orders := []models.Order{
@@ -160,14 +457,55 @@ func TestOrderService_GetOrders(t *testing.T) {
 			{OrderID: models.ID(2)},
 		}
 
-		mockCache.EXPECT().Get(gomock.Any(), fmt.Sprintf("getOrders_%d", request.CustomerId)).Return(nil, false)
-		mockModule.EXPECT().GetOrders(models.ID(1), 2).Return(orders, nil)
-		mockCache.EXPECT().Set(gomock.Any(), fmt.Sprintf("getOrders_%d", request.CustomerId), orders, gomock.Any()).Return(nil)
+		mockCache.EXPECT().Get(gomock.Any(), cache.OrdersKey("", models.ID(request.CustomerId))).Return(nil, false)
+		mockModule.EXPECT().GetOrders(gomock.Any(), models.OrdersQuery{CustomerID: models.ID(1), Sort: models.SortByExpiration}).Return(models.OrdersPage{Orders: orders}, nil)
+		mockCache.EXPECT().Set(gomock.Any(), cache.OrdersKey("", models.ID(request.CustomerId)), orders, gomock.Any()).Return(nil)
 
 		response, err := orderService.GetOrders(context.Background(), request)
 		require.NoError(t, err)
 		assert.Len(t, response.Orders, 2)
 	})
+
+	t.Run("Страница с фильтром не кешируется и возвращает токен следующей страницы", func(t *testing.T) {
+		request := &orders_grpc.GetOrdersRequest{
+			CustomerId: 2,
+			N:          1,
+			Sort:       orders_grpc.OrderSort_ORDER_SORT_RECEIVED_TIME,
+			State:      orders_grpc.OrderState_ORDER_STATE_RECEIVED,
+		}
+
+		received := time.Date(2024, time.July, 1, 12, 0, 0, 0, time.UTC)
+		next := &models.OrdersCursor{Sort: models.SortByReceived, Value: received, OrderID: models.ID(3)}
+		query := models.OrdersQuery{CustomerID: models.ID(2), Limit: 1, Sort: models.SortByReceived, State: models.StateReceived}
+
+		mockModule.EXPECT().GetOrders(gomock.Any(), query).
+			Return(models.OrdersPage{Orders: []models.Order{{OrderID: models.ID(3)}}, Next: next}, nil)
+
+		response, err := orderService.GetOrders(context.Background(), request)
+		require.NoError(t, err)
+		require.NotEmpty(t, response.GetNextPageToken())
+
+		request.PageToken = response.GetNextPageToken()
+		query.After = next
+		mockModule.EXPECT().GetOrders(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, q models.OrdersQuery) (models.OrdersPage, error) {
+				require.NotNil(t, q.After)
+				assert.Equal(t, next.OrderID, q.After.OrderID)
+				assert.True(t, next.Value.Equal(q.After.Value))
+				return models.OrdersPage{}, nil
+			})
+
+		response, err = orderService.GetOrders(context.Background(), request)
+		require.NoError(t, err)
+		assert.Empty(t, response.GetNextPageToken())
+	})
+
+	t.Run("Поврежденный токен страницы", func(t *testing.T) {
+		request := &orders_grpc.GetOrdersRequest{CustomerId: 1, N: 1, PageToken: "not a token"}
+
+		_, err := orderService.GetOrders(context.Background(), request)
+		assert.ErrorIs(t, err, module.ErrPageToken)
+	})
 }
 
 func TestOrderService_CreateRefund(t *testing.T) {
@@ -180,12 +518,32 @@ func TestOrderService_CreateRefund(t *testing.T) {
 
 	t.Run("Успешный возврат товара", func(t *testing.T) {
 		request := &orders_grpc.CreateRefundRequest{
-			OrderId:    1,
+			Order:      &orders_grpc.CreateRefundRequest_OrderId{OrderId: 1},
+			CustomerId: 1,
+			Reason:     orders_grpc.RefundReason_REFUND_REASON_DAMAGED,
+			Comment:    "разбит экран",
+		}
+
+		refund := models.Refund{ID: models.ID(3), OrderID: models.ID(1), CustomerID: models.ID(1), Reason: models.RefundReasonDamaged,
+			State: models.RefundRequested, Amount: models.Rubles(100)}
+		mockModule.EXPECT().RefundOrder(gomock.Any(), models.ID(1), models.ID(1), models.RefundReasonDamaged, "разбит экран", gomock.Nil(), models.Operator("")).Return(refund, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", models.ID(request.CustomerId))).Return(nil)
+
+		response, err := orderService.CreateRefund(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), response.GetRefund().GetRefundId())
+		assert.Equal(t, orders_grpc.RefundState_REFUND_STATE_REQUESTED, response.GetRefund().GetState())
+		assert.Equal(t, int64(10000), response.GetRefund().GetAmount().GetAmountMinor())
+	})
+
+	t.Run("Причина не указана", func(t *testing.T) {
+		request := &orders_grpc.CreateRefundRequest{
+			Order:      &orders_grpc.CreateRefundRequest_OrderId{OrderId: 2},
 			CustomerId: 1,
 		}
 
-		mockModule.EXPECT().RefundOrder(models.ID(1), models.ID(1)).Return(nil)
-		mockCache.EXPECT().Delete(gomock.Any(), fmt.Sprintf("getOrders_%d", request.CustomerId)).Return(nil)
+		mockModule.EXPECT().RefundOrder(gomock.Any(), models.ID(1), models.ID(2), models.RefundReasonOther, "", gomock.Nil(), models.Operator("")).Return(models.Refund{}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", models.ID(request.CustomerId))).Return(nil)
 
 		_, err := orderService.CreateRefund(context.Background(), request)
 		require.NoError(t, err)
@@ -193,11 +551,11 @@ func TestOrderService_CreateRefund(t *testing.T) {
 
 	t.Run("Попытка вернуть ранее возвращенный товар", func(t *testing.T) {
 		request := &orders_grpc.CreateRefundRequest{
-			OrderId:    1,
+			Order:      &orders_grpc.CreateRefundRequest_OrderId{OrderId: 1},
 			CustomerId: 1,
 		}
 
-		mockModule.EXPECT().RefundOrder(models.ID(1), models.ID(1)).Return(module.ErrRefund)
+		mockModule.EXPECT().RefundOrder(gomock.Any(), models.ID(1), models.ID(1), models.RefundReasonOther, "", gomock.Nil(), models.Operator("")).Return(models.Refund{}, module.ErrRefund)
 
 		_, err := orderService.CreateRefund(context.Background(), request)
 		require.Error(t, err)
@@ -205,6 +563,67 @@ func TestOrderService_CreateRefund(t *testing.T) {
 	})
 }
 
+func TestOrderService_DecideRefund(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	mockCache := mockcache.NewMockCacheInterface(ctrl)
+	orderService := &OrderService{Module: mockModule, Redis: mockCache}
+
+	t.Run("Принятие возврата", func(t *testing.T) {
+		request := &orders_grpc.DecideRefundRequest{
+			RefundId: 3,
+			Decision: orders_grpc.RefundDecision_REFUND_DECISION_APPROVE,
+			Comment:  "следы удара на корпусе",
+		}
+
+		refund := models.Refund{ID: models.ID(3), CustomerID: models.ID(7), State: models.RefundApproved}
+		mockModule.EXPECT().DecideRefund(gomock.Any(), models.ID(3), models.DecisionApprove, "следы удара на корпусе", models.Operator("")).Return(refund, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("", 7)).Return(nil)
+
+		response, err := orderService.DecideRefund(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, orders_grpc.RefundState_REFUND_STATE_APPROVED, response.GetRefund().GetState())
+	})
+
+	t.Run("Решение недопустимо на текущем этапе", func(t *testing.T) {
+		request := &orders_grpc.DecideRefundRequest{
+			RefundId: 4,
+			Decision: orders_grpc.RefundDecision_REFUND_DECISION_INSPECT,
+		}
+
+		mockModule.EXPECT().DecideRefund(gomock.Any(), models.ID(4), models.DecisionInspect, "", models.Operator("")).Return(models.Refund{}, module.ErrRefundDecision)
+
+		_, err := orderService.DecideRefund(context.Background(), request)
+		assert.ErrorIs(t, err, module.ErrRefundDecision)
+	})
+}
+
+func TestOrderService_GetRefund(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	orderService := &OrderService{Module: mockModule}
+
+	t.Run("Возврат с историей решений", func(t *testing.T) {
+		refund := models.Refund{ID: models.ID(3), State: models.RefundRejected}
+		history := []models.RefundChange{
+			{RefundID: models.ID(3), To: models.RefundRequested},
+			{RefundID: models.ID(3), From: models.RefundRequested, To: models.RefundRejected, Comment: "заказ использовался", Operator: models.Operator("operator")},
+		}
+		mockModule.EXPECT().GetRefund(gomock.Any(), models.ID(3)).Return(refund, history, nil)
+
+		response, err := orderService.GetRefund(context.Background(), &orders_grpc.GetRefundRequest{RefundId: 3})
+		require.NoError(t, err)
+		assert.Equal(t, orders_grpc.RefundState_REFUND_STATE_REJECTED, response.GetRefund().GetState())
+		require.Len(t, response.GetEvents(), 2)
+		assert.Equal(t, "заказ использовался", response.GetEvents()[1].GetComment())
+		assert.Equal(t, "operator", response.GetEvents()[1].GetOperator())
+	})
+}
+
 func TestOrderService_GetRefunds(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -214,35 +633,184 @@ func TestOrderService_GetRefunds(t *testing.T) {
 	orderService := &OrderService{Module: mockModule, Redis: mockCache}
 
 	t.Run("Успешное получение списка возвратов", func(t *testing.T) {
+		refunded := time.Date(2024, time.August, 1, 12, 0, 0, 0, time.UTC)
 		request := &orders_grpc.GetRefundsRequest{
-			Page:  0,
 			Limit: 2,
+			From:  timestamppb.New(refunded),
 		}
 
-		refunds := []models.Order{
-			{OrderID: models.ID(1)},
-			{OrderID: models.ID(2)},
+		page := models.RefundsPage{
+			Refunds: []models.Order{
+				{OrderID: models.ID(1), Refunded: true, RefundedTime: refunded},
+				{OrderID: models.ID(2), Refunded: true, RefundedTime: refunded.Add(time.Hour)},
+			},
+			Total: 3,
+			Next:  &models.RefundsCursor{RefundedTime: refunded.Add(time.Hour), OrderID: models.ID(2)},
 		}
 
-		mockCache.EXPECT().Get(gomock.Any(), fmt.Sprintf("getRefunds_p%d_l%d", request.Page, request.Limit)).Return(nil, false)
-		mockModule.EXPECT().GetRefunds(int(request.Page), int(request.Limit)).Return(refunds, nil)
-		mockCache.EXPECT().Set(gomock.Any(), fmt.Sprintf("getRefunds_p%d_l%d", request.Page, request.Limit), refunds, gomock.Any()).Return(nil)
+		mockModule.EXPECT().GetRefunds(gomock.Any(), models.RefundsQuery{From: refunded, Limit: 2}).Return(page, nil)
 
 		response, err := orderService.GetRefunds(context.Background(), request)
 		require.NoError(t, err)
 		assert.Len(t, response.Refunds, 2)
+		assert.Equal(t, int64(3), response.GetTotalCount())
+		assert.Equal(t, refunded, response.Refunds[0].GetRefundedTime().AsTime())
+		require.NotEmpty(t, response.GetNextPageToken())
+
+		request.PageToken = response.GetNextPageToken()
+		mockModule.EXPECT().GetRefunds(gomock.Any(), models.RefundsQuery{From: refunded, Limit: 2, After: page.Next}).
+			Return(models.RefundsPage{Refunds: []models.Order{{OrderID: models.ID(3)}}, Total: 3}, nil)
+
+		response, err = orderService.GetRefunds(context.Background(), request)
+		require.NoError(t, err)
+		assert.Len(t, response.Refunds, 1)
+		assert.Empty(t, response.GetNextPageToken())
 	})
 
-	t.Run("Ошибка получения списка возвратов из кеша", func(t *testing.T) {
+	t.Run("Устаревший номер страницы переводится в смещение", func(t *testing.T) {
+		request := &orders_grpc.GetRefundsRequest{
+			Page:  2,
+			Limit: 5,
+		}
+
+		mockModule.EXPECT().GetRefunds(gomock.Any(), models.RefundsQuery{Limit: 5, Offset: 10}).Return(models.RefundsPage{}, nil)
+
+		_, err := orderService.GetRefunds(context.Background(), request)
+		require.NoError(t, err)
+	})
+
+	t.Run("Ошибка получения списка возвратов", func(t *testing.T) {
 		request := &orders_grpc.GetRefundsRequest{
 			Page:  0,
 			Limit: 2,
 		}
 
-		mockCache.EXPECT().Get(gomock.Any(), fmt.Sprintf("getRefunds_p%d_l%d", request.Page, request.Limit)).Return(nil, false)
-		mockModule.EXPECT().GetRefunds(int(request.Page), int(request.Limit)).Return(nil, fmt.Errorf("err"))
+		mockModule.EXPECT().GetRefunds(gomock.Any(), models.RefundsQuery{Limit: 2}).Return(models.RefundsPage{}, fmt.Errorf("err"))
 
 		_, err := orderService.GetRefunds(context.Background(), request)
 		require.Error(t, err)
 	})
+
+	t.Run("Поврежденный токен страницы", func(t *testing.T) {
+		request := &orders_grpc.GetRefundsRequest{Limit: 1, PageToken: "not a token"}
+
+		_, err := orderService.GetRefunds(context.Background(), request)
+		assert.ErrorIs(t, err, module.ErrPageToken)
+	})
+}
+
+func TestOrderService_GetOrderHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	mockCache := mockcache.NewMockCacheInterface(ctrl)
+	orderService := &OrderService{Module: mockModule, Redis: mockCache}
+
+	t.Run("Успешное получение истории заказа", func(t *testing.T) {
+		request := &orders_grpc.GetOrderHistoryRequest{
+			Order: &orders_grpc.GetOrderHistoryRequest_OrderId{OrderId: 1},
+		}
+
+		history := []models.StatusChange{
+			{OrderID: models.ID(1), To: models.StatusAccepted, Operator: "operator"},
+			{OrderID: models.ID(1), From: models.StatusAccepted, To: models.StatusIssued, Operator: "operator"},
+		}
+
+		mockModule.EXPECT().GetOrderHistory(gomock.Any(), models.ID(1)).Return(history, nil)
+
+		response, err := orderService.GetOrderHistory(context.Background(), request)
+		require.NoError(t, err)
+		assert.Len(t, response.Events, 2)
+		assert.Equal(t, string(models.StatusIssued), response.Events[1].StatusTo)
+	})
+}
+
+func TestOrderService_CreatePackageType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	orderService := &OrderService{Module: mockModule}
+
+	t.Run("Описание из запроса передается в каталог", func(t *testing.T) {
+		request := &orders_grpc.CreatePackageTypeRequest{PackageType: &orders_grpc.PackageType{
+			Name:       "crate",
+			Price:      &orders_grpc.Money{AmountMinor: 15000},
+			MinWeight:  5,
+			MaxWeight:  80,
+			Dimensions: &orders_grpc.Dimensions{LengthCm: 120, WidthCm: 80, HeightCm: 60},
+			AllowWrap:  true,
+		}}
+		mockModule.EXPECT().AddPackage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, spec models.PackageSpec) (models.PackageSpec, error) {
+				assert.Equal(t, models.PackageType("crate"), spec.Type)
+				assert.Equal(t, models.Rubles(150), spec.Price)
+				assert.Equal(t, models.Dimensions{Length: 120, Width: 80, Height: 60}, spec.Dimensions)
+				assert.True(t, spec.AllowWrap)
+				return spec, nil
+			})
+
+		response, err := orderService.CreatePackageType(context.Background(), request)
+		require.NoError(t, err)
+		assert.Equal(t, "crate", response.GetPackageType().GetName())
+		assert.Nil(t, response.GetPackageType().GetRetiredAt())
+	})
+
+	t.Run("Тип уже зарегистрирован", func(t *testing.T) {
+		mockModule.EXPECT().AddPackage(gomock.Any(), gomock.Any()).
+			Return(models.PackageSpec{}, fmt.Errorf("module.AddPackage error: %w", storage.ErrPackageExists))
+
+		_, err := orderService.CreatePackageType(context.Background(), &orders_grpc.CreatePackageTypeRequest{PackageType: &orders_grpc.PackageType{Name: "box"}})
+		assert.ErrorIs(t, err, storage.ErrPackageExists)
+	})
+}
+
+func TestOrderService_ListPackageTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	orderService := &OrderService{Module: mockModule}
+
+	t.Run("Выведенные типы отдаются со временем вывода", func(t *testing.T) {
+		specs := []models.PackageSpec{
+			{Type: "bag", Price: models.Rubles(5), MaxWeight: 10},
+			{Type: "envelope", Price: models.Rubles(2), RetiredAt: time.Now()},
+		}
+		mockModule.EXPECT().GetPackages(gomock.Any(), true).Return(specs, nil)
+
+		response, err := orderService.ListPackageTypes(context.Background(), &orders_grpc.ListPackageTypesRequest{IncludeRetired: true})
+		require.NoError(t, err)
+		require.Len(t, response.GetPackageTypes(), 2)
+		assert.Nil(t, response.GetPackageTypes()[0].GetRetiredAt())
+		assert.NotNil(t, response.GetPackageTypes()[1].GetRetiredAt())
+	})
+}
+
+func TestOrderService_GetQuote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	orderService := &OrderService{Module: mockModule}
+
+	t.Run("Варианты с итоговой стоимостью", func(t *testing.T) {
+		request := &orders_grpc.GetQuoteRequest{
+			Weight:     12.5,
+			Dimensions: &orders_grpc.Dimensions{LengthCm: 50, WidthCm: 30, HeightCm: 20},
+			CostMoney:  &orders_grpc.Money{AmountMinor: 150000},
+		}
+		quotes := []models.Quote{
+			{Package: models.Packaging{"box"}, PackageCost: models.Rubles(20), Total: models.Rubles(1520)},
+		}
+		size := models.Dimensions{Length: 50, Width: 30, Height: 20}
+		mockModule.EXPECT().GetQuote(gomock.Any(), models.Kilo(12.5), size, models.Rubles(1500)).Return(quotes, nil)
+
+		response, err := orderService.GetQuote(context.Background(), request)
+		require.NoError(t, err)
+		require.Len(t, response.GetOptions(), 1)
+		assert.Equal(t, "box", response.GetOptions()[0].GetPackageType())
+		assert.Equal(t, int64(152000), response.GetOptions()[0].GetTotalCost().GetAmountMinor())
+	})
 }
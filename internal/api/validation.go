@@ -0,0 +1,97 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"unicode"
+)
+
+// validator Реализуется запросами, для которых protoc-gen-validate сгенерировал проверки по правилам из orders.proto.
+type validator interface {
+	ValidateAll() error
+}
+
+// validationError Нарушение правила для одного поля, ошибки вида <Message>ValidationError.
+type validationError interface {
+	Field() string
+	Reason() string
+}
+
+// validationMultiError Все нарушения запроса, ошибки вида <Message>MultiError.
+type validationMultiError interface {
+	AllErrors() []error
+}
+
+// ValidateUnaryInterceptor Проверяет запрос по правилам из orders.proto до вызова обработчика.
+// Ошибка переводится в InvalidArgument перехватчиком ErrorsUnaryInterceptor, поэтому он должен стоять в цепочке раньше.
+func ValidateUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if v, ok := req.(validator); ok {
+		if err := v.ValidateAll(); err != nil {
+			return nil, fmt.Errorf("%s validation error: %w", info.FullMethod, err)
+		}
+	}
+
+	return handler(ctx, req)
+}
+
+func fieldViolations(err error) []*errdetails.BadRequest_FieldViolation {
+	var errs []error
+	var multi validationMultiError
+	if errors.As(err, &multi) {
+		errs = multi.AllErrors()
+	} else {
+		errs = []error{err}
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, e := range errs {
+		var single validationError
+		if !errors.As(e, &single) {
+			continue
+		}
+
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       protoFieldName(single.Field()),
+			Description: single.Reason(),
+		})
+	}
+
+	return violations
+}
+
+func validationStatus(err error, violations []*errdetails.BadRequest_FieldViolation) error {
+	info := &errdetails.ErrorInfo{
+		Reason:   ReasonInvalidArgument,
+		Domain:   ErrorDomain,
+		Metadata: map[string]string{fieldMetadataKey: violations[0].GetField()},
+	}
+
+	st, errDetails := status.New(codes.InvalidArgument, err.Error()).WithDetails(info, &errdetails.BadRequest{FieldViolations: violations})
+	if errDetails != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return st.Err()
+}
+
+// protoFieldName Переводит имя поля Go из protoc-gen-validate (OrderIds[0]) в имя поля proto (order_ids[0]).
+func protoFieldName(field string) string {
+	var b strings.Builder
+	for i, r := range field {
+		if unicode.IsUpper(r) {
+			if i > 0 && field[i-1] != '.' {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -3,12 +3,36 @@ package cache
 
 import (
 	"context"
+	"fmt"
 	"homework-1/internal/models"
 	"time"
 )
 
+// OrdersKey Ключ полного списка заказов клиента в пункте point. У клиента, который ждет заказы в нескольких
+// пунктах, в каждом из них свой список.
+func OrdersKey(point models.PointID, customerId models.ID) string {
+	return fmt.Sprintf("getOrders_%s_%d", point, customerId)
+}
+
 type CacheInterface interface {
 	Get(ctx context.Context, key string) ([]models.Order, bool)
 	Set(ctx context.Context, key string, orders []models.Order, now time.Time) error
 	Delete(ctx context.Context, key string) error
 }
+
+// IdempotencyRecord Состояние запроса с ключом идемпотентности. Fingerprint позволяет отличить повтор запроса
+// от другого запроса с тем же ключом, Response хранит ответ завершенного запроса.
+type IdempotencyRecord struct {
+	Fingerprint string `json:"fingerprint"`
+	Done        bool   `json:"done"`
+	Response    []byte `json:"response,omitempty"`
+}
+
+type IdempotencyInterface interface {
+	// Reserve Атомарно занимает ключ под выполнение запроса. Если ключ уже занят, возвращает его запись и false.
+	Reserve(ctx context.Context, key string, fingerprint string) (IdempotencyRecord, bool, error)
+	// Complete Сохраняет ответ выполненного запроса на время окна идемпотентности.
+	Complete(ctx context.Context, key string, record IdempotencyRecord) error
+	// Release Освобождает ключ, если запрос завершился ошибкой, чтобы повтор выполнился заново.
+	Release(ctx context.Context, key string) error
+}
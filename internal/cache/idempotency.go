@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+const idempotencyKeyPrefix = "idempotency:"
+
+// Idempotency Хранит ответы запросов с ключом идемпотентности в том же Redis, что и кеш заказов.
+// Запись выполняемого запроса живет pendingTTL, чтобы упавший посреди запроса сервер не занимал ключ на все окно.
+type Idempotency struct {
+	client     *redis.Client
+	window     time.Duration
+	pendingTTL time.Duration
+}
+
+func NewIdempotency(r *Redis, window time.Duration, pendingTTL time.Duration) *Idempotency {
+	return &Idempotency{
+		client:     r.client,
+		window:     window,
+		pendingTTL: pendingTTL,
+	}
+}
+
+func (i *Idempotency) Reserve(ctx context.Context, key string, fingerprint string) (IdempotencyRecord, bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "cache.Idempotency.Reserve")
+	defer span.Finish()
+
+	pending, errMarshal := json.Marshal(IdempotencyRecord{Fingerprint: fingerprint})
+	if errMarshal != nil {
+		return IdempotencyRecord{}, false, fmt.Errorf("cache.Idempotency.Reserve error: %w", errMarshal)
+	}
+
+	reserved, errSet := i.client.SetNX(ctx, idempotencyKeyPrefix+key, pending, i.pendingTTL).Result()
+	if errSet != nil {
+		return IdempotencyRecord{}, false, fmt.Errorf("cache.Idempotency.Reserve error: %w", errSet)
+	}
+	if reserved {
+		return IdempotencyRecord{}, true, nil
+	}
+
+	val, errGet := i.client.Get(ctx, idempotencyKeyPrefix+key).Bytes()
+	if errGet != nil {
+		// Ключ истек между SETNX и GET: считаем запрос выполняемым, клиент повторит его позже.
+		if errors.Is(errGet, redis.Nil) {
+			return IdempotencyRecord{Fingerprint: fingerprint}, false, nil
+		}
+		return IdempotencyRecord{}, false, fmt.Errorf("cache.Idempotency.Reserve error: %w", errGet)
+	}
+
+	var record IdempotencyRecord
+	if errUnmarshal := json.Unmarshal(val, &record); errUnmarshal != nil {
+		return IdempotencyRecord{}, false, fmt.Errorf("cache.Idempotency.Reserve error: %w", errUnmarshal)
+	}
+
+	return record, false, nil
+}
+
+func (i *Idempotency) Complete(ctx context.Context, key string, record IdempotencyRecord) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "cache.Idempotency.Complete")
+	defer span.Finish()
+
+	record.Done = true
+	data, errMarshal := json.Marshal(record)
+	if errMarshal != nil {
+		return fmt.Errorf("cache.Idempotency.Complete error: %w", errMarshal)
+	}
+
+	if errSet := i.client.Set(ctx, idempotencyKeyPrefix+key, data, i.window).Err(); errSet != nil {
+		return fmt.Errorf("cache.Idempotency.Complete error: %w", errSet)
+	}
+
+	return nil
+}
+
+func (i *Idempotency) Release(ctx context.Context, key string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "cache.Idempotency.Release")
+	defer span.Finish()
+
+	if errDel := i.client.Del(ctx, idempotencyKeyPrefix+key).Err(); errDel != nil {
+		return fmt.Errorf("cache.Idempotency.Release error: %w", errDel)
+	}
+
+	return nil
+}
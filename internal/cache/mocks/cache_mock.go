@@ -6,6 +6,7 @@ package cache_mock
 
 import (
 	context "context"
+	cache "homework-1/internal/cache"
 	models "homework-1/internal/models"
 	reflect "reflect"
 	time "time"
@@ -78,3 +79,70 @@ func (mr *MockCacheInterfaceMockRecorder) Set(ctx, key, orders, now interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCacheInterface)(nil).Set), ctx, key, orders, now)
 }
+
+// MockIdempotencyInterface is a mock of IdempotencyInterface interface.
+type MockIdempotencyInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyInterfaceMockRecorder
+}
+
+// MockIdempotencyInterfaceMockRecorder is the mock recorder for MockIdempotencyInterface.
+type MockIdempotencyInterfaceMockRecorder struct {
+	mock *MockIdempotencyInterface
+}
+
+// NewMockIdempotencyInterface creates a new mock instance.
+func NewMockIdempotencyInterface(ctrl *gomock.Controller) *MockIdempotencyInterface {
+	mock := &MockIdempotencyInterface{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyInterface) EXPECT() *MockIdempotencyInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyInterface) Complete(ctx context.Context, key string, record cache.IdempotencyRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, key, record)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyInterfaceMockRecorder) Complete(ctx, key, record interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyInterface)(nil).Complete), ctx, key, record)
+}
+
+// Release mocks base method.
+func (m *MockIdempotencyInterface) Release(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIdempotencyInterfaceMockRecorder) Release(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIdempotencyInterface)(nil).Release), ctx, key)
+}
+
+// Reserve mocks base method.
+func (m *MockIdempotencyInterface) Reserve(ctx context.Context, key, fingerprint string) (cache.IdempotencyRecord, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, key, fingerprint)
+	ret0, _ := ret[0].(cache.IdempotencyRecord)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockIdempotencyInterfaceMockRecorder) Reserve(ctx, key, fingerprint interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockIdempotencyInterface)(nil).Reserve), ctx, key, fingerprint)
+}
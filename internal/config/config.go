@@ -7,10 +7,19 @@ import (
 )
 
 type Config struct {
-	DatabaseConfig `yaml:"database"`
-	KafkaConfig    `yaml:"kafka"`
-	RedisConfig    `yaml:"redis"`
-	HttpConfig     `yaml:"http"`
+	DatabaseConfig      `yaml:"database"`
+	KafkaConfig         `yaml:"kafka"`
+	RedisConfig         `yaml:"redis"`
+	HttpConfig          `yaml:"http"`
+	OutboxConfig        `yaml:"outbox"`
+	PickupPoints        []PickupPointConfig `yaml:"pickup-points"`
+	IdempotencyConfig   `yaml:"idempotency"`
+	PolicyConfig        `yaml:"policy"`
+	PackagingConfig     `yaml:"packaging"`
+	PickupCodeConfig    `yaml:"pickup-code"`
+	OperatorTokenConfig `yaml:"operator-token"`
+	TryOnConfig         `yaml:"try-on"`
+	ExpiryConfig        `yaml:"expiry"`
 }
 
 type DatabaseConfig struct {
@@ -22,9 +31,12 @@ type DatabaseConfig struct {
 }
 
 type KafkaConfig struct {
-	Brokers         []string `yaml:"brokers" env-default:"localhost:9091"`
-	Topic           string   `yaml:"topic" env-default:"orders"`
-	ConsolePrinting bool     `yaml:"console-printing" env-default:"false"`
+	Brokers            []string `yaml:"brokers" env-default:"localhost:9091"`
+	Topic              string   `yaml:"topic" env-default:"orders"`
+	ConsolePrinting    bool     `yaml:"console-printing" env-default:"false"`
+	ManifestTopic      string   `yaml:"manifest-topic" env-default:"delivery-manifests"`
+	ManifestReplyTopic string   `yaml:"manifest-reply-topic" env-default:"delivery-manifest-results"`
+	PickupCodeTopic    string   `yaml:"pickup-code-topic" env-default:"pickup-code-notifications"`
 }
 
 type RedisConfig struct {
@@ -38,6 +50,115 @@ type HttpConfig struct {
 	Port int `yaml:"port" env-default:"8080"`
 }
 
+type OutboxConfig struct {
+	IntervalMs int `yaml:"interval-ms" env-default:"1000"`
+	BatchSize  int `yaml:"batch-size" env-default:"100"`
+}
+
+// PickupPointConfig TimeZone задается именем из базы IANA, ClosingTime в формате HH:MM.
+// Срок хранения заказа истекает в момент закрытия пункта в последний день хранения.
+// Operators Сотрудники пункта: по сотруднику из токена запроса сервер определяет пункт каждого запроса.
+// Cells Схема ячеек хранения по зонам, без нее заказы пункта принимаются без ячейки.
+type PickupPointConfig struct {
+	ID          string           `yaml:"id"`
+	Name        string           `yaml:"name"`
+	TimeZone    string           `yaml:"time-zone" env-default:"Europe/Moscow"`
+	ClosingTime string           `yaml:"closing-time" env-default:"21:00"`
+	Operators   []string         `yaml:"operators"`
+	Cells       []CellZoneConfig `yaml:"cells"`
+}
+
+// CellZoneConfig Зона Zone из Racks стеллажей по Shelves полок, каждая полка - ячейка на Capacity заказов.
+// Нулевые MaxWeightKg и размеры не ограничивают заказы, которые кладутся в ячейки зоны.
+type CellZoneConfig struct {
+	Zone        string  `yaml:"zone"`
+	Racks       int     `yaml:"racks"`
+	Shelves     int     `yaml:"shelves"`
+	Capacity    int     `yaml:"capacity"`
+	MaxWeightKg float64 `yaml:"max-weight-kg"`
+	LengthCm    int     `yaml:"length-cm"`
+	WidthCm     int     `yaml:"width-cm"`
+	HeightCm    int     `yaml:"height-cm"`
+}
+
+// IdempotencyConfig WindowSeconds Сколько хранится ответ на запрос с ключом идемпотентности,
+// PendingSeconds Сколько ключ остается занятым выполняемым запросом, если сервер не успел сохранить ответ.
+type IdempotencyConfig struct {
+	WindowSeconds  int `yaml:"window-seconds" env-default:"86400"`
+	PendingSeconds int `yaml:"pending-seconds" env-default:"30"`
+}
+
+// PickupCodeConfig Код выдачи из Length цифр хранится как HMAC с ключом Secret, ключ лучше передавать через
+// переменную окружения PICKUP_CODE_SECRET. После MaxAttempts неверных кодов подряд выдача заказов клиента
+// блокируется на LockoutMinutes.
+type PickupCodeConfig struct {
+	Secret         string `yaml:"secret" env:"PICKUP_CODE_SECRET"`
+	Length         int    `yaml:"length" env-default:"6"`
+	MaxAttempts    int    `yaml:"max-attempts" env-default:"5"`
+	LockoutMinutes int    `yaml:"lockout-minutes" env-default:"15"`
+}
+
+// OperatorTokenConfig Сотрудник пункта подтверждает себя токеном, подписанным HMAC с ключом Secret и действующим
+// TTLHours. Ключ лучше передавать через переменную окружения OPERATOR_TOKEN_SECRET.
+type OperatorTokenConfig struct {
+	Secret   string `yaml:"secret" env:"OPERATOR_TOKEN_SECRET"`
+	TTLHours int    `yaml:"ttl-hours" env-default:"12"`
+}
+
+// TryOnConfig Заказы отдаются на примерку на TimeoutMinutes. Раз в IntervalSeconds сервер возвращает на полку
+// заказы, время примерки которых вышло, пачками не больше BatchSize.
+type TryOnConfig struct {
+	TimeoutMinutes  int `yaml:"timeout-minutes" env-default:"20"`
+	IntervalSeconds int `yaml:"interval-seconds" env-default:"30"`
+	BatchSize       int `yaml:"batch-size" env-default:"100"`
+}
+
+// ExpiryConfig Раз в IntervalSeconds сервер записывает в историю просрочку заказов, срок хранения которых истек,
+// пачками не больше BatchSize.
+type ExpiryConfig struct {
+	IntervalSeconds int `yaml:"interval-seconds" env-default:"60"`
+	BatchSize       int `yaml:"batch-size" env-default:"100"`
+}
+
+// PolicyConfig Правила пункта выдачи. Файл перечитывается раз в ReloadSeconds, поэтому правила можно менять
+// без перезапуска сервера. Правило применяется к заказу, если совпадают указанные в нем пункт и тип упаковки;
+// из подходящих правил более частное переопределяет более общее, при равной точности побеждает записанное позже.
+type PolicyConfig struct {
+	ReloadSeconds int                `yaml:"reload-seconds" env-default:"10"`
+	Rules         []PolicyRuleConfig `yaml:"rules"`
+}
+
+// PolicyRuleConfig Пустые PickupPoint и Package подходят к любому пункту и упаковке.
+// Незаданные ограничения наследуются из более общих правил.
+type PolicyRuleConfig struct {
+	PickupPoint       string   `yaml:"pickup-point"`
+	Package           string   `yaml:"package"`
+	RefundWindowHours *int     `yaml:"refund-window-hours"`
+	MaxStorageDays    *int     `yaml:"max-storage-days"`
+	MaxWeightKg       *float64 `yaml:"max-weight-kg"`
+}
+
+// PackagingConfig Типы упаковок, которые добавляются в каталог при старте, если их там еще нет.
+// Дальше каталог меняется только через администрирование, изменения в файле на существующие типы не влияют.
+type PackagingConfig struct {
+	Types []PackageTypeConfig `yaml:"types"`
+}
+
+// PackageTypeConfig Цена задается в минимальных единицах валюты, нулевой MaxWeightKg снимает ограничение по весу.
+// Kind принимает значения container и wrapping, по умолчанию container.
+type PackageTypeConfig struct {
+	Name        string  `yaml:"name"`
+	Kind        string  `yaml:"kind"`
+	PriceMinor  int64   `yaml:"price-minor"`
+	Currency    string  `yaml:"currency"`
+	MinWeightKg float64 `yaml:"min-weight-kg"`
+	MaxWeightKg float64 `yaml:"max-weight-kg"`
+	LengthCm    int     `yaml:"length-cm"`
+	WidthCm     int     `yaml:"width-cm"`
+	HeightCm    int     `yaml:"height-cm"`
+	AllowWrap   bool    `yaml:"allow-wrap"`
+}
+
 func LoadConfig(path string) (*Config, error) {
 	if _, err := os.Stat(path); os.IsNotExist(err) {
 		return nil, fmt.Errorf("config.LoadConfig error: %w", err)
@@ -50,3 +171,15 @@ func LoadConfig(path string) (*Config, error) {
 
 	return &cfg, nil
 }
+
+// LoadPolicy Читает из файла конфигурации только правила пункта выдачи.
+func LoadPolicy(path string) (PolicyConfig, error) {
+	var cfg struct {
+		PolicyConfig `yaml:"policy"`
+	}
+	if err := cleanenv.ReadConfig(path, &cfg); err != nil {
+		return PolicyConfig{}, fmt.Errorf("config.LoadPolicy error: %w", err)
+	}
+
+	return cfg.PolicyConfig, nil
+}
@@ -0,0 +1,38 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	service "homework-1/internal/api"
+	"homework-1/pkg/api/proto/orders_grpc/v1/orders_grpc/v1"
+	"net/http"
+	"strings"
+)
+
+// idempotencyKeyHeader HTTP-заголовок с ключом идемпотентности изменяющего запроса.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// NewGateway Шлюз REST/JSON проксирует запросы в gRPC-сервер по адресу grpcEndpoint,
+// поэтому на HTTP-запросы действуют те же перехватчики и коды ошибок, что и на gRPC.
+// Заголовок Authorization с токеном сотрудника шлюз передает в метаданные authorization сам.
+func NewGateway(ctx context.Context, grpcEndpoint string) (http.Handler, error) {
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(matchHeader))
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := orders_grpc.RegisterOrdersServiceHandlerFromEndpoint(ctx, mux, grpcEndpoint, opts); err != nil {
+		return nil, fmt.Errorf("http.NewGateway error: %w", err)
+	}
+
+	return mux, nil
+}
+
+func matchHeader(key string) (string, bool) {
+	if strings.EqualFold(key, idempotencyKeyHeader) {
+		return service.IdempotencyKeyMetadataKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
@@ -3,18 +3,25 @@ package http
 import (
 	"context"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"homework-1/pkg/api/openapiv2"
 	"log"
 	"net/http"
 	"time"
 )
 
+const openapiPath = "/swagger/orders.swagger.json"
+
+// MustRun Сервер отдает метрики, OpenAPI-документ и REST/JSON шлюз к OrdersService под префиксом /v1/.
 func MustRun(
 	ctx context.Context,
 	shutdownDur time.Duration,
 	addr string,
+	gateway http.Handler,
 ) {
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc(openapiPath, serveOpenAPI)
+	mux.Handle("/v1/", gateway)
 
 	server := &http.Server{Addr: addr, Handler: mux}
 
@@ -33,3 +40,10 @@ func MustRun(
 		log.Printf("HTTP server ListenAndServe: %s\n", err)
 	}
 }
+
+func serveOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(openapiv2.OrdersSpec); err != nil {
+		log.Printf("HTTP serve OpenAPI: %s\n", err)
+	}
+}
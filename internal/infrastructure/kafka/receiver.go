@@ -55,6 +55,43 @@ func (r *KafkaReceiver) Subscribe() error {
 	return nil
 }
 
+// SubscribeHandler Читает все партиции топика и передает каждое сообщение в handler.
+// Сообщения одной партиции обрабатываются последовательно.
+func (r *KafkaReceiver) SubscribeHandler(handler func(value []byte)) error {
+	partitions, err := r.consumer.SingleConsumer.Partitions(r.topic)
+	if err != nil {
+		return fmt.Errorf("receiver.SubscribeHandler error: %w", err)
+	}
+
+	for _, partition := range partitions {
+		partitionConsumer, errConsume := r.consumer.SingleConsumer.ConsumePartition(r.topic, partition, sarama.OffsetNewest)
+		if errConsume != nil {
+			return fmt.Errorf("receiver.SubscribeHandler error: %w", errConsume)
+		}
+
+		go func(pc sarama.PartitionConsumer) {
+			defer pc.Close()
+
+			for {
+				select {
+				case <-r.stop:
+					fmt.Println("receiver.SubscribeHandler: stopping Kafka receiver")
+					return
+				case msg, ok := <-pc.Messages():
+					if !ok {
+						fmt.Printf("receiver.SubscribeHandler: channel closed")
+						return
+					}
+
+					handler(msg.Value)
+				}
+			}
+		}(partitionConsumer)
+	}
+
+	return nil
+}
+
 func (r *KafkaReceiver) Stop() {
 	close(r.stop)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/IBM/sarama"
 	"homework-1/internal/infrastructure/messaging"
 	"homework-1/internal/infrastructure/messaging/messages"
+	"strconv"
 )
 
 type KafkaSender struct {
@@ -21,7 +22,7 @@ func NewKafkaSender(producer *messaging.Producer, topic string) *KafkaSender {
 }
 
 func (s *KafkaSender) SendMessage(message *messages.CLIMessage) error {
-	kafkaMsg, err := s.buildMessage(*message)
+	kafkaMsg, err := s.buildMessage(*message, nil)
 	if err != nil {
 		return fmt.Errorf("sender.SendMessage error: %w", err)
 	}
@@ -34,7 +35,53 @@ func (s *KafkaSender) SendMessage(message *messages.CLIMessage) error {
 	return nil
 }
 
-func (s *KafkaSender) buildMessage(message messages.CLIMessage) (*sarama.ProducerMessage, error) {
+// SendOrderEvent Ключом сообщения служит ID заказа, поэтому события одного заказа попадают в одну партицию
+// и читаются потребителями в порядке отправки.
+func (s *KafkaSender) SendOrderEvent(event *messages.OrderEvent) error {
+	kafkaMsg, err := s.buildMessage(*event, sarama.StringEncoder(strconv.FormatInt(event.OrderID, 10)))
+	if err != nil {
+		return fmt.Errorf("sender.SendOrderEvent error: %w", err)
+	}
+
+	_, _, err = s.producer.ProduceMessage(kafkaMsg)
+	if err != nil {
+		return fmt.Errorf("sender.SendOrderEvent error: %w", err)
+	}
+
+	return nil
+}
+
+// SendPickupCode Ключом сообщения служит ID клиента, поэтому коды одного клиента читаются в порядке выдачи
+// и последний прочитанный код - действующий.
+func (s *KafkaSender) SendPickupCode(notification *messages.PickupCodeNotification) error {
+	kafkaMsg, err := s.buildMessage(*notification, sarama.StringEncoder(strconv.FormatInt(notification.CustomerID, 10)))
+	if err != nil {
+		return fmt.Errorf("sender.SendPickupCode error: %w", err)
+	}
+
+	_, _, err = s.producer.ProduceMessage(kafkaMsg)
+	if err != nil {
+		return fmt.Errorf("sender.SendPickupCode error: %w", err)
+	}
+
+	return nil
+}
+
+func (s *KafkaSender) SendManifestResult(result *messages.ManifestResult) error {
+	kafkaMsg, err := s.buildMessage(*result, sarama.StringEncoder(result.ManifestID))
+	if err != nil {
+		return fmt.Errorf("sender.SendManifestResult error: %w", err)
+	}
+
+	_, _, err = s.producer.ProduceMessage(kafkaMsg)
+	if err != nil {
+		return fmt.Errorf("sender.SendManifestResult error: %w", err)
+	}
+
+	return nil
+}
+
+func (s *KafkaSender) buildMessage(message interface{}, key sarama.Encoder) (*sarama.ProducerMessage, error) {
 	msg, err := json.Marshal(message)
 
 	if err != nil {
@@ -43,6 +90,7 @@ func (s *KafkaSender) buildMessage(message messages.CLIMessage) (*sarama.Produce
 
 	return &sarama.ProducerMessage{
 		Topic: s.topic,
+		Key:   key,
 		Value: sarama.ByteEncoder(msg),
 	}, nil
 }
@@ -0,0 +1,67 @@
+package messages
+
+import (
+	"fmt"
+	"time"
+)
+
+// DeliveryManifest Манифест поставки от курьера: список заказов, которые нужно принять на пункт выдачи PointID.
+// Манифесты без pointId принимаются на пункт default, как до появления нескольких пунктов.
+type DeliveryManifest struct {
+	ManifestID string         `json:"manifestId"`
+	CourierID  string         `json:"courierId"`
+	PointID    string         `json:"pointId,omitempty"`
+	Items      []ManifestItem `json:"items"`
+}
+
+// ManifestItem OrderID Номер заказа в системе продавца, Source Продавец или маркетплейс, выдавший номер.
+// Манифесты без source принимаются с источником legacy, как до появления внешних номеров.
+// PackageType Упаковка, составная передается слоями через +, например box+wrap.
+// CostMinor Стоимость в минимальных единицах валюты Currency (RUB, если не указана).
+// Cost Устаревшая стоимость в целых рублях, учитывается только в манифестах без costMinor.
+type ManifestItem struct {
+	OrderID        int64     `json:"orderId"`
+	Source         string    `json:"source,omitempty"`
+	CustomerID     int64     `json:"customerId"`
+	ExpirationTime time.Time `json:"expirationTime"`
+	PackageType    string    `json:"packageType"`
+	Weight         float64   `json:"weight"`
+	CostMinor      *int64    `json:"costMinor,omitempty"`
+	Currency       string    `json:"currency,omitempty"`
+	Cost           int64     `json:"cost"`
+}
+
+// ManifestResult Ответ на манифест с результатом приема каждой позиции.
+type ManifestResult struct {
+	ManifestID  string               `json:"manifestId"`
+	Items       []ManifestItemResult `json:"items"`
+	ProcessedAt time.Time            `json:"processedAt"`
+}
+
+// ManifestItemResult OrderID Номер из манифеста, AssignedOrderID Идентификатор, под которым заказ принят на пункт выдачи.
+// AssignedCell Ячейка, в которую нужно положить заказ. Пустая, если у пункта нет схемы ячеек.
+type ManifestItemResult struct {
+	OrderID         int64  `json:"orderId"`
+	Source          string `json:"source,omitempty"`
+	AssignedOrderID int64  `json:"assignedOrderId,omitempty"`
+	AssignedCell    string `json:"assignedCell,omitempty"`
+	Accepted        bool   `json:"accepted"`
+	Reason          string `json:"reason,omitempty"`
+	Error           string `json:"error,omitempty"`
+}
+
+func (m DeliveryManifest) String() string {
+	return fmt.Sprintf("ManifestID: %s; CourierID: %s; Items: %d", m.ManifestID, m.CourierID, len(m.Items))
+}
+
+func (m ManifestResult) String() string {
+	accepted := 0
+	for _, item := range m.Items {
+		if item.Accepted {
+			accepted++
+		}
+	}
+	return fmt.Sprintf(
+		"ManifestID: %s; Accepted: %d/%d; ProcessedAt: %s",
+		m.ManifestID, accepted, len(m.Items), m.ProcessedAt.Format(time.DateTime))
+}
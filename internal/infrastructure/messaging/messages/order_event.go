@@ -0,0 +1,45 @@
+package messages
+
+import (
+	"fmt"
+	"time"
+)
+
+// OrderEvent Формат доменного события заказа в Kafka. EventID уникален для события,
+// поэтому потребители могут отбрасывать повторы при повторной отправке из outbox.
+type OrderEvent struct {
+	EventID int64  `json:"eventId"`
+	Type    string `json:"type"`
+	OrderID int64  `json:"orderId"`
+	// ExternalSource и ExternalNumber Номер заказа в системе продавца. Пусто в событиях, записанных до появления внешних номеров.
+	ExternalSource string `json:"externalSource,omitempty"`
+	ExternalNumber string `json:"externalNumber,omitempty"`
+	CustomerID     int64  `json:"customerId"`
+	StatusFrom     string `json:"statusFrom"`
+	StatusTo       string `json:"statusTo"`
+	Reason         string `json:"reason"`
+	Operator       string `json:"operator"`
+	// Cost Устарело: полная стоимость в целых рублях без копеек, используйте CostMinor и Currency.
+	Cost       int64     `json:"cost"`
+	CostMinor  int64     `json:"costMinor"`
+	Currency   string    `json:"currency"`
+	OccurredAt time.Time `json:"occurredAt"`
+	// Items Товары заказа с состояниями после события. Пусто у заказов без товаров.
+	Items []OrderItem `json:"items,omitempty"`
+}
+
+// OrderItem Товар заказа. По состояниям товаров курьер узнает, какие из них забрать из пункта.
+type OrderItem struct {
+	ItemID     int64  `json:"itemId"`
+	SKU        string `json:"sku,omitempty"`
+	Name       string `json:"name"`
+	PriceMinor int64  `json:"priceMinor"`
+	Currency   string `json:"currency"`
+	Status     string `json:"status"`
+}
+
+func (m OrderEvent) String() string {
+	return fmt.Sprintf(
+		"EventID: %d; Type: %s; OrderID: %d; Status: %s -> %s; OccurredAt: %s",
+		m.EventID, m.Type, m.OrderID, m.StatusFrom, m.StatusTo, m.OccurredAt.Format(time.DateTime))
+}
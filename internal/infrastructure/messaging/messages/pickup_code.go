@@ -0,0 +1,25 @@
+package messages
+
+import (
+	"fmt"
+	"time"
+)
+
+// PickupCodeNotification Код выдачи в открытом виде для отправки клиенту. Публикуется только в отдельный топик
+// уведомлений, а не в общий топик событий заказов. EventID совпадает с событием, с которым выдан код,
+// поэтому сервис уведомлений может отбрасывать повторы.
+type PickupCodeNotification struct {
+	EventID    int64     `json:"eventId"`
+	OrderID    int64     `json:"orderId"`
+	CustomerID int64     `json:"customerId"`
+	PointID    string    `json:"pointId"`
+	Code       string    `json:"code"`
+	OccurredAt time.Time `json:"occurredAt"`
+}
+
+// String Не выводит сам код, чтобы он не попадал в логи.
+func (m PickupCodeNotification) String() string {
+	return fmt.Sprintf(
+		"EventID: %d; CustomerID: %d; PointID: %s; OccurredAt: %s",
+		m.EventID, m.CustomerID, m.PointID, m.OccurredAt.Format(time.DateTime))
+}
@@ -12,6 +12,7 @@ type Producer struct {
 func NewKafkaProducer(brokers []string) (*Producer, error) {
 	config := sarama.NewConfig()
 	config.Producer.Return.Successes = true
+	config.Producer.RequiredAcks = sarama.WaitForAll
 
 	producer, err := sarama.NewSyncProducer(brokers, config)
 	if err != nil {
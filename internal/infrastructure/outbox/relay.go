@@ -0,0 +1,133 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"homework-1/internal/infrastructure/messaging/messages"
+	"homework-1/internal/models"
+	"homework-1/internal/storage"
+	"log"
+	"time"
+)
+
+type Sender interface {
+	SendOrderEvent(event *messages.OrderEvent) error
+}
+
+// Notifier Доставляет клиенту код выдачи. Код не должен попадать в общий топик событий заказов,
+// который читают все сервисы, поэтому он отправляется отдельно.
+type Notifier interface {
+	SendPickupCode(notification *messages.PickupCodeNotification) error
+}
+
+type Deps struct {
+	Storage  storage.Storage
+	Sender   Sender
+	Notifier Notifier
+}
+
+// Relay Периодически переносит события из outbox в Kafka. Событие помечается опубликованным только после
+// успешной отправки, поэтому при недоступности Kafka события копятся в outbox и уходят после ее восстановления.
+// Гарантия доставки - "хотя бы один раз": потребители отбрасывают повторы по EventID.
+type Relay struct {
+	Deps
+	interval  time.Duration
+	batchSize int
+}
+
+func NewRelay(d Deps, interval time.Duration, batchSize int) *Relay {
+	return &Relay{
+		Deps:      d,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("outbox.Relay: stopping relay")
+			return
+		case <-ticker.C:
+			if _, err := r.PublishBatch(ctx); err != nil {
+				log.Printf("outbox.Relay error: %s\n", err)
+			}
+		}
+	}
+}
+
+// PublishBatch Отправляет одну пачку событий и возвращает количество опубликованных.
+func (r *Relay) PublishBatch(ctx context.Context) (int, error) {
+	published, err := r.Storage.PublishEvents(ctx, r.batchSize, func(event models.OrderEvent) error {
+		message, errMessage := toMessage(event)
+		if errMessage != nil {
+			// Событие с несовместимыми суммами не станет отправляемым при повторе.
+			return errors.Join(storage.ErrUndeliverable, errMessage)
+		}
+
+		if event.PickupCode != "" {
+			if errNotify := r.Notifier.SendPickupCode(toNotification(event)); errNotify != nil {
+				return errNotify
+			}
+		}
+
+		return r.Sender.SendOrderEvent(message)
+	})
+	if err != nil {
+		return published, fmt.Errorf("outbox.PublishBatch error: %w", err)
+	}
+
+	return published, nil
+}
+
+func toMessage(event models.OrderEvent) (*messages.OrderEvent, error) {
+	total, err := event.Order.GetTotalCost()
+	if err != nil {
+		return nil, fmt.Errorf("outbox.toMessage error: %w", err)
+	}
+
+	message := &messages.OrderEvent{
+		EventID:        event.ID,
+		Type:           string(event.Type),
+		OrderID:        int64(event.Order.OrderID),
+		ExternalSource: event.Order.External.Source,
+		ExternalNumber: event.Order.External.Number,
+		CustomerID:     int64(event.Order.CustomerID),
+		StatusFrom:     string(event.Change.From),
+		StatusTo:       string(event.Change.To),
+		Reason:         event.Change.Reason,
+		Operator:       string(event.Change.Operator),
+		Cost:           total.Amount / 100,
+		CostMinor:      total.Amount,
+		Currency:       string(total.Currency),
+		OccurredAt:     event.Change.ChangedAt,
+	}
+	for _, item := range event.Order.Items {
+		message.Items = append(message.Items, messages.OrderItem{
+			ItemID:     int64(item.ID),
+			SKU:        item.SKU,
+			Name:       item.Name,
+			PriceMinor: item.Price.Amount,
+			Currency:   string(item.Price.Currency),
+			Status:     string(item.Status),
+		})
+	}
+
+	return message, nil
+}
+
+func toNotification(event models.OrderEvent) *messages.PickupCodeNotification {
+	return &messages.PickupCodeNotification{
+		EventID:    event.ID,
+		OrderID:    int64(event.Order.OrderID),
+		CustomerID: int64(event.Order.CustomerID),
+		PointID:    string(event.Order.PointID),
+		Code:       event.PickupCode,
+		OccurredAt: event.Change.ChangedAt,
+	}
+}
@@ -0,0 +1,179 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"homework-1/internal/infrastructure/messaging/messages"
+	"homework-1/internal/models"
+	"homework-1/internal/storage"
+	mockstorage "homework-1/internal/storage/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type senderStub struct {
+	sent []*messages.OrderEvent
+	err  error
+}
+
+func (s *senderStub) SendOrderEvent(event *messages.OrderEvent) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sent = append(s.sent, event)
+	return nil
+}
+
+type notifierStub struct {
+	sent []*messages.PickupCodeNotification
+	err  error
+}
+
+func (n *notifierStub) SendPickupCode(notification *messages.PickupCodeNotification) error {
+	if n.err != nil {
+		return n.err
+	}
+	n.sent = append(n.sent, notification)
+	return nil
+}
+
+func TestRelay_PublishBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	events := []models.OrderEvent{
+		{
+			ID:     1,
+			Type:   models.EventOrderAdded,
+			Order:  models.Order{OrderID: 10, CustomerID: 20, Cost: models.NewMoney(10050, models.CurrencyRUB), PackageCost: models.Rubles(5)},
+			Change: models.StatusChange{OrderID: 10, To: models.StatusAccepted, ChangedAt: time.Now()},
+		},
+		{
+			ID:     2,
+			Type:   models.EventOrderReceived,
+			Order:  models.Order{OrderID: 10, CustomerID: 20},
+			Change: models.StatusChange{OrderID: 10, From: models.StatusAccepted, To: models.StatusIssued},
+		},
+	}
+
+	publishAll := func(ctx context.Context, limit int, publish func(event models.OrderEvent) error) (int, error) {
+		for i, event := range events {
+			if err := publish(event); err != nil {
+				return i, err
+			}
+		}
+		return len(events), nil
+	}
+
+	t.Run("Успешная публикация событий в Kafka", func(t *testing.T) {
+		mockStorage := mockstorage.NewMockStorage(ctrl)
+		sender := &senderStub{}
+		relay := NewRelay(Deps{Storage: mockStorage, Sender: sender, Notifier: &notifierStub{}}, time.Second, 10)
+
+		mockStorage.EXPECT().PublishEvents(gomock.Any(), 10, gomock.Any()).DoAndReturn(publishAll)
+
+		published, err := relay.PublishBatch(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, published)
+		require.Len(t, sender.sent, 2)
+		assert.Equal(t, int64(1), sender.sent[0].EventID)
+		assert.Equal(t, string(models.EventOrderAdded), sender.sent[0].Type)
+		assert.Equal(t, int64(10550), sender.sent[0].CostMinor)
+		assert.Equal(t, string(models.CurrencyRUB), sender.sent[0].Currency)
+		assert.Equal(t, int64(105), sender.sent[0].Cost)
+		assert.Equal(t, string(models.StatusIssued), sender.sent[1].StatusTo)
+	})
+
+	t.Run("События остаются в outbox, если Kafka недоступна", func(t *testing.T) {
+		mockStorage := mockstorage.NewMockStorage(ctrl)
+		sender := &senderStub{err: errors.New("kafka is down")}
+		relay := NewRelay(Deps{Storage: mockStorage, Sender: sender, Notifier: &notifierStub{}}, time.Second, 10)
+
+		mockStorage.EXPECT().PublishEvents(gomock.Any(), 10, gomock.Any()).DoAndReturn(publishAll)
+
+		published, err := relay.PublishBatch(context.Background())
+		require.Error(t, err)
+		assert.Equal(t, 0, published)
+		assert.Empty(t, sender.sent)
+		assert.NotErrorIs(t, err, storage.ErrUndeliverable)
+	})
+
+	t.Run("Событие, которое нельзя отправить, откладывается, а не останавливает outbox", func(t *testing.T) {
+		mockStorage := mockstorage.NewMockStorage(ctrl)
+		sender := &senderStub{}
+		relay := NewRelay(Deps{Storage: mockStorage, Sender: sender, Notifier: &notifierStub{}}, time.Second, 10)
+
+		broken := models.OrderEvent{
+			ID:    3,
+			Type:  models.EventOrderAdded,
+			Order: models.Order{OrderID: 11, Cost: models.Rubles(1), PackageCost: models.NewMoney(100, "USD")},
+		}
+		mockStorage.EXPECT().PublishEvents(gomock.Any(), 10, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, limit int, publish func(event models.OrderEvent) error) (int, error) {
+				assert.ErrorIs(t, publish(broken), storage.ErrUndeliverable)
+				require.NoError(t, publish(events[0]))
+				return 1, nil
+			})
+
+		published, err := relay.PublishBatch(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, published)
+		require.Len(t, sender.sent, 1)
+		assert.Equal(t, int64(1), sender.sent[0].EventID)
+	})
+
+	t.Run("Код выдачи уходит только в топик уведомлений", func(t *testing.T) {
+		mockStorage := mockstorage.NewMockStorage(ctrl)
+		sender := &senderStub{}
+		notifier := &notifierStub{}
+		relay := NewRelay(Deps{Storage: mockStorage, Sender: sender, Notifier: notifier}, time.Second, 10)
+
+		withCode := events[0]
+		withCode.Order.PointID = "msk-1"
+		withCode.PickupCode = "123456"
+		mockStorage.EXPECT().PublishEvents(gomock.Any(), 10, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, limit int, publish func(event models.OrderEvent) error) (int, error) {
+				require.NoError(t, publish(withCode))
+				require.NoError(t, publish(events[1]))
+				return 2, nil
+			})
+
+		published, err := relay.PublishBatch(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, published)
+		require.Len(t, notifier.sent, 1)
+		assert.Equal(t, int64(1), notifier.sent[0].EventID)
+		assert.Equal(t, int64(20), notifier.sent[0].CustomerID)
+		assert.Equal(t, "msk-1", notifier.sent[0].PointID)
+		assert.Equal(t, "123456", notifier.sent[0].Code)
+		require.Len(t, sender.sent, 2)
+		for _, message := range sender.sent {
+			payload, errMarshal := json.Marshal(message)
+			require.NoError(t, errMarshal)
+			assert.NotContains(t, string(payload), "123456")
+		}
+	})
+
+	t.Run("Событие с кодом остается в outbox, если уведомление не отправлено", func(t *testing.T) {
+		mockStorage := mockstorage.NewMockStorage(ctrl)
+		sender := &senderStub{}
+		relay := NewRelay(Deps{Storage: mockStorage, Sender: sender, Notifier: &notifierStub{err: errors.New("kafka is down")}}, time.Second, 10)
+
+		withCode := events[0]
+		withCode.PickupCode = "123456"
+		mockStorage.EXPECT().PublishEvents(gomock.Any(), 10, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, limit int, publish func(event models.OrderEvent) error) (int, error) {
+				return 0, publish(withCode)
+			})
+
+		published, err := relay.PublishBatch(context.Background())
+		require.Error(t, err)
+		assert.Equal(t, 0, published)
+		assert.Empty(t, sender.sent)
+	})
+}
@@ -0,0 +1,42 @@
+package models
+
+import "fmt"
+
+// CellCode Адрес ячейки хранения в пункте выдачи: зона, стеллаж и полка, например A-01-02.
+type CellCode string
+
+// Cell Ячейка хранения из схемы пункта выдачи. Capacity Сколько заказов помещается в ячейку,
+// MaxWeight и Dimensions Самый тяжелый и самый большой заказ, который в нее можно положить.
+// Нулевые MaxWeight и Dimensions не ограничивают заказы.
+type Cell struct {
+	Code       CellCode
+	Zone       string
+	Rack       int
+	Shelf      int
+	Capacity   int
+	MaxWeight  Kilo
+	Dimensions Dimensions
+}
+
+// NewCellCode Адрес полки shelf стеллажа rack в зоне zone.
+func NewCellCode(zone string, rack, shelf int) CellCode {
+	return CellCode(fmt.Sprintf("%s-%02d-%02d", zone, rack, shelf))
+}
+
+func (c Cell) String() string {
+	return fmt.Sprintf("Code: %s; Capacity: %d; MaxWeight: %g; Dimensions: %dx%dx%d;",
+		c.Code, c.Capacity, c.MaxWeight, c.Dimensions.Length, c.Dimensions.Width, c.Dimensions.Height)
+}
+
+// CellLoad Заполненность ячейки: Occupied Сколько заказов в ней лежит, HasCustomer Есть ли среди них заказы
+// клиента, для которого подбирается ячейка.
+type CellLoad struct {
+	Cell
+	Occupied    int
+	HasCustomer bool
+}
+
+// Free Сколько еще заказов помещается в ячейку.
+func (l CellLoad) Free() int {
+	return l.Capacity - l.Occupied
+}
@@ -0,0 +1,31 @@
+package models
+
+type EventType string
+
+const (
+	EventOrderAdded           EventType = "order_added"
+	EventOrderReceived        EventType = "order_received"
+	EventOrderRefundRequested EventType = "order_refund_requested"
+	EventOrderRefundRejected  EventType = "order_refund_rejected"
+	EventOrderRefunded        EventType = "order_refunded"
+	EventOrderReturned        EventType = "order_returned"
+	EventOrderDeclined        EventType = "order_declined"
+	EventItemsReturned        EventType = "order_items_returned"
+	EventOrderTryOnStarted    EventType = "order_try_on_started"
+	EventOrderTryOnRejected   EventType = "order_try_on_rejected"
+	EventOrderTryOnTimedOut   EventType = "order_try_on_timed_out"
+	EventOrderMoved           EventType = "order_moved"
+	EventOrderExpired         EventType = "order_expired"
+)
+
+// OrderEvent Доменное событие об изменении заказа. Сохраняется в outbox в одной транзакции с заказом,
+// а затем публикуется в Kafka. ID присваивается хранилищем.
+// PickupCode Код выдачи в открытом виде для уведомления клиента. Заполнен только в событии, с которым клиенту
+// выдан новый код, публикуется лишь в топик уведомлений и удаляется из outbox после публикации.
+type OrderEvent struct {
+	ID         int64
+	Type       EventType
+	Order      Order
+	Change     StatusChange
+	PickupCode string
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+type Currency string
+
+const (
+	CurrencyRUB Currency = "RUB"
+
+	// minorUnits Количество минимальных единиц в основной: все валюты, с которыми работает пункт выдачи, делятся на 100.
+	minorUnits    = 100
+	minorUnitsLen = 2
+)
+
+var (
+	ErrCurrencyMismatch = errors.New("money currencies do not match")
+	ErrMoneyOverflow    = errors.New("money amount overflow")
+	ErrInvalidMoney     = errors.New("invalid money amount")
+)
+
+// Money Сумма в минимальных единицах валюты (копейках для рубля). Арифметика только целочисленная,
+// поэтому суммы сходятся с финансовой отчетностью без ошибок округления.
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Rubles Сумма в целых рублях.
+func Rubles(rubles int64) Money {
+	return Money{Amount: rubles * minorUnits, Currency: CurrencyRUB}
+}
+
+// ParseMoney Разбирает десятичную запись суммы ("123", "123.4", "123.45") без перехода через float.
+func ParseMoney(s string, currency Currency) (Money, error) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	whole, fraction, hasFraction := strings.Cut(s, ".")
+	if whole == "" || (hasFraction && (fraction == "" || len(fraction) > minorUnitsLen)) {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+	}
+	fraction += strings.Repeat("0", minorUnitsLen-len(fraction))
+
+	units, errWhole := strconv.ParseUint(whole, 10, 63)
+	minor, errFraction := strconv.ParseUint(fraction, 10, 63)
+	if errWhole != nil || errFraction != nil {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidMoney, s)
+	}
+	if units > (math.MaxInt64-minor)/minorUnits {
+		return Money{}, fmt.Errorf("%w: %q", ErrMoneyOverflow, s)
+	}
+
+	amount := int64(units*minorUnits + minor)
+	if negative {
+		amount = -amount
+	}
+
+	return Money{Amount: amount, Currency: currency}, nil
+}
+
+// Add Складывает суммы одной валюты. Переполнение int64 возвращается ошибкой, а не заворачивается.
+func (m Money) Add(other Money) (Money, error) {
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.Currency, other.Currency)
+	}
+
+	if (other.Amount > 0 && m.Amount > math.MaxInt64-other.Amount) ||
+		(other.Amount < 0 && m.Amount < math.MinInt64-other.Amount) {
+		return Money{}, ErrMoneyOverflow
+	}
+
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}, nil
+}
+
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+func (m Money) String() string {
+	sign := ""
+	amount := uint64(m.Amount)
+	if m.Amount < 0 {
+		sign = "-"
+		amount = uint64(-m.Amount)
+	}
+
+	return fmt.Sprintf("%s%d.%02d %s", sign, amount/minorUnits, amount%minorUnits, m.Currency)
+}
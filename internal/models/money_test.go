@@ -0,0 +1,63 @@
+package models
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMoney(t *testing.T) {
+	t.Run("Разбор суммы без потери копеек", func(t *testing.T) {
+		cases := map[string]int64{
+			"0":      0,
+			"10":     1000,
+			"10.1":   1010,
+			"10.01":  1001,
+			"0.29":   29,
+			"-12.34": -1234,
+		}
+
+		for input, expected := range cases {
+			money, err := ParseMoney(input, CurrencyRUB)
+			require.NoError(t, err, input)
+			assert.Equal(t, NewMoney(expected, CurrencyRUB), money, input)
+		}
+	})
+
+	t.Run("Доли копеек и некорректная запись отклоняются", func(t *testing.T) {
+		for _, input := range []string{"", "10.", ".5", "10.001", "1e3", "abc", "10,5"} {
+			_, err := ParseMoney(input, CurrencyRUB)
+			assert.ErrorIs(t, err, ErrInvalidMoney, input)
+		}
+	})
+
+	t.Run("Слишком большая сумма", func(t *testing.T) {
+		_, err := ParseMoney("92233720368547758.08", CurrencyRUB)
+		assert.ErrorIs(t, err, ErrMoneyOverflow)
+	})
+}
+
+func TestMoney_Add(t *testing.T) {
+	t.Run("Сложение сумм одной валюты", func(t *testing.T) {
+		sum, err := NewMoney(10, CurrencyRUB).Add(NewMoney(20, CurrencyRUB))
+		require.NoError(t, err)
+		assert.Equal(t, NewMoney(30, CurrencyRUB), sum)
+	})
+
+	t.Run("Сложение сумм разных валют", func(t *testing.T) {
+		_, err := Rubles(1).Add(NewMoney(1, Currency("USD")))
+		assert.ErrorIs(t, err, ErrCurrencyMismatch)
+	})
+
+	t.Run("Переполнение", func(t *testing.T) {
+		_, err := NewMoney(math.MaxInt64, CurrencyRUB).Add(NewMoney(1, CurrencyRUB))
+		assert.ErrorIs(t, err, ErrMoneyOverflow)
+	})
+}
+
+func TestMoney_String(t *testing.T) {
+	assert.Equal(t, "10.05 RUB", NewMoney(1005, CurrencyRUB).String())
+	assert.Equal(t, "-0.50 RUB", NewMoney(-50, CurrencyRUB).String())
+}
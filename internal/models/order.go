@@ -6,30 +6,72 @@ import (
 )
 
 type ID int64
-type Rub int64
 type Kilo float32
 type PackageType string
 
+// Order OrderID назначается сервисом при приеме заказа, External хранит номер, под которым заказ известен продавцу.
+// Items перечисляет товары заказа, у заказов без товаров клиент забирает или возвращает заказ только целиком.
+// TryOnUntil Время, до которого заказ отдан клиенту на примерку. У заказов не на примерке нулевое.
+// PointID Пункт выдачи, в котором хранится заказ. Назначается хранилищем по пункту запроса.
+// Cell Ячейка, в которой лежит заказ. Пустая у выданных заказов и в пунктах без схемы ячеек.
+// PickedFrom Ячейка, из которой заказ взят при выдаче. Не хранится, заполняется только в ответе на выдачу.
 type Order struct {
 	OrderID            ID
+	External           ExternalRef
 	CustomerID         ID
 	ExpirationTime     time.Time
 	ReceivedTime       time.Time
 	ReceivedByCustomer bool
 	Refunded           bool
-	Package            PackageType
+	RefundedTime       time.Time
+	Status             Status
+	Package            Packaging
 	Weight             Kilo
-	Cost               Rub
-	PackageCost        Rub
+	Cost               Money
+	PackageCost        Money
+	Items              []OrderItem
+	TryOnUntil         time.Time
+	PointID            PointID
+	Cell               CellCode
+	PickedFrom         CellCode
 }
 
 func (o Order) String() string {
 	return fmt.Sprintf(
-		"OrderID: %d; CustomerID: %d; ExpirationTime: %s; ReceivedTime: %s; "+
-			"ReceivedByCustomer: %t; Refunded: %t; Package: %s; Weight: %f; Total cost: %d;",
-		o.OrderID, o.CustomerID, o.ExpirationTime, o.ReceivedTime, o.ReceivedByCustomer, o.Refunded, o.Package, o.Weight, o.GetTotalCost())
+		"OrderID: %d; External: %s; CustomerID: %d; ExpirationTime: %s; ReceivedTime: %s; "+
+			"ReceivedByCustomer: %t; Refunded: %t; RefundedTime: %s; Status: %s; Package: %s; Weight: %f; Cost: %s; Package cost: %s; Items: %d; Cell: %s;",
+		o.OrderID, o.External, o.CustomerID, o.ExpirationTime, o.ReceivedTime, o.ReceivedByCustomer, o.Refunded, o.RefundedTime, o.Status, o.Package, o.Weight, o.Cost, o.PackageCost, len(o.Items), o.Cell)
 }
 
-func (o Order) GetTotalCost() Rub {
-	return o.Cost + o.PackageCost
+// ItemsIn Товары заказа в одном из состояний statuses.
+func (o Order) ItemsIn(statuses ...ItemStatus) []OrderItem {
+	var items []OrderItem
+	for _, item := range o.Items {
+		for _, status := range statuses {
+			if item.Status == status {
+				items = append(items, item)
+				break
+			}
+		}
+	}
+	return items
+}
+
+// GetTotalCost Стоимость заказа вместе с упаковкой. Ошибка возможна только при разных валютах или переполнении.
+func (o Order) GetTotalCost() (Money, error) {
+	return o.Cost.Add(o.PackageCost)
+}
+
+// LegacySource Источник заказов, принятых до появления внешних номеров, и клиентов, которые передают только order_id.
+const LegacySource = "legacy"
+
+// ExternalRef Номер заказа в системе продавца или маркетплейса. Номера разных источников могут совпадать,
+// поэтому уникальна только пара (Source, Number) в пределах пункта выдачи.
+type ExternalRef struct {
+	Source string
+	Number string
+}
+
+func (r ExternalRef) String() string {
+	return r.Source + ":" + r.Number
 }
@@ -0,0 +1,55 @@
+package models
+
+import "fmt"
+
+// ItemStatus Состояние отдельного товара в заказе.
+type ItemStatus string
+
+const (
+	// ItemAtPoint Товар лежит в пункте выдачи вместе с заказом.
+	ItemAtPoint ItemStatus = "at_point"
+	// ItemIssued Клиент забрал товар.
+	ItemIssued ItemStatus = "issued"
+	// ItemDeclined Клиент отказался от товара при выдаче, товар ждет курьера.
+	ItemDeclined ItemStatus = "declined"
+	// ItemRefundRequested Товар входит в возврат, который еще рассматривается.
+	ItemRefundRequested ItemStatus = "refund_requested"
+	// ItemRefunded Возврат товара принят, товар ждет курьера.
+	ItemRefunded ItemStatus = "refunded"
+	// ItemReturnedToCourier Отказной или возвращенный товар передан курьеру.
+	ItemReturnedToCourier ItemStatus = "returned_to_courier"
+)
+
+// OrderItem Товар в заказе. Price - цена позиции целиком, из цен позиций складывается стоимость заказа.
+type OrderItem struct {
+	ID      ID
+	OrderID ID
+	SKU     string
+	Name    string
+	Price   Money
+	Status  ItemStatus
+}
+
+func (i OrderItem) String() string {
+	return fmt.Sprintf("ItemID: %d; SKU: %s; Name: %s; Price: %s; Status: %s;", i.ID, i.SKU, i.Name, i.Price, i.Status)
+}
+
+// AwaitsCourier Товар остался в пункте после отказа или возврата и должен уйти курьеру.
+func (i OrderItem) AwaitsCourier() bool {
+	return i.Status == ItemDeclined || i.Status == ItemRefunded
+}
+
+// ItemsCost Сумма цен товаров. Ошибка возможна только при разных валютах или переполнении.
+func ItemsCost(items []OrderItem) (Money, error) {
+	if len(items) == 0 {
+		return Money{}, nil
+	}
+	total := NewMoney(0, items[0].Price.Currency)
+	for _, item := range items {
+		var errAdd error
+		if total, errAdd = total.Add(item.Price); errAdd != nil {
+			return Money{}, errAdd
+		}
+	}
+	return total, nil
+}
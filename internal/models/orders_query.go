@@ -0,0 +1,80 @@
+package models
+
+import "time"
+
+// OrderSort Поле, по которому упорядочивается список заказов клиента. Заказы с одинаковым значением идут по OrderID.
+type OrderSort string
+
+const (
+	SortByExpiration OrderSort = "expiration"
+	SortByReceived   OrderSort = "received"
+)
+
+// OrderState Фильтр по тому, где сейчас заказ. Пустое значение не ограничивает выборку.
+type OrderState string
+
+const (
+	// StateAtPoint Заказ лежит в пункте выдачи и ждет клиента.
+	StateAtPoint OrderState = "at_point"
+	// StateReceived Клиент забрал заказ и не вернул его.
+	StateReceived OrderState = "received"
+	// StateRefunded Клиент вернул заказ в пункт выдачи.
+	StateRefunded OrderState = "refunded"
+)
+
+// OrdersCursor Позиция последнего заказа страницы. Следующая страница начинается строго после нее.
+type OrdersCursor struct {
+	Sort       OrderSort
+	Descending bool
+	Value      time.Time
+	OrderID    ID
+}
+
+// OrdersQuery Limit 0 означает все заказы клиента одной страницей.
+type OrdersQuery struct {
+	CustomerID ID
+	Limit      int
+	Sort       OrderSort
+	Descending bool
+	State      OrderState
+	Package    PackageType
+	After      *OrdersCursor
+}
+
+// OrdersPage Next пуст на последней странице.
+type OrdersPage struct {
+	Orders []Order
+	Next   *OrdersCursor
+}
+
+// SortValue Значение поля сортировки заказа, из которого строится курсор.
+func (o Order) SortValue(sort OrderSort) time.Time {
+	if sort == SortByReceived {
+		return o.ReceivedTime
+	}
+
+	return o.ExpirationTime
+}
+
+// RefundsCursor Позиция последнего возврата страницы. Возвраты упорядочены по времени возврата и OrderID.
+type RefundsCursor struct {
+	RefundedTime time.Time
+	OrderID      ID
+}
+
+// RefundsQuery From включается в выборку, To нет, нулевое время не ограничивает диапазон. Limit 0 означает все возвраты одной страницей.
+// Offset оставлен для клиентов, которые листают по номеру страницы, с курсором он не используется.
+type RefundsQuery struct {
+	From   time.Time
+	To     time.Time
+	Limit  int
+	Offset int
+	After  *RefundsCursor
+}
+
+// RefundsPage Total считается по всему диапазону дат без учета курсора и лимита. Next пуст на последней странице.
+type RefundsPage struct {
+	Refunds []Order
+	Total   int
+	Next    *RefundsCursor
+}
@@ -0,0 +1,139 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+type Centimeter int32
+
+// PackageKind Контейнер (пакет, коробка) вмещает заказ, обертка (пленка) надевается поверх другой упаковки.
+type PackageKind string
+
+const (
+	PackageKindContainer PackageKind = "container"
+	PackageKindWrapping  PackageKind = "wrapping"
+)
+
+// PackageAuto Вместо упаковки в заказе: сервис сам выбирает самую дешевую подходящую.
+const PackageAuto PackageType = "auto"
+
+// Packaging Слои упаковки заказа от внутреннего к внешнему, например коробка в пленке: box+wrap.
+type Packaging []PackageType
+
+// packagingSeparator Разделитель слоев в строковом виде упаковки.
+const packagingSeparator = "+"
+
+// ParsePackaging Разбирает упаковку вида "box+wrap". Пустая строка означает заказ без упаковки.
+func ParsePackaging(s string) Packaging {
+	if s == "" {
+		return nil
+	}
+	layers := strings.Split(s, packagingSeparator)
+	packaging := make(Packaging, 0, len(layers))
+	for _, layer := range layers {
+		packaging = append(packaging, PackageType(strings.TrimSpace(layer)))
+	}
+	return packaging
+}
+
+func (p Packaging) String() string {
+	layers := make([]string, 0, len(p))
+	for _, layer := range p {
+		layers = append(layers, string(layer))
+	}
+	return strings.Join(layers, packagingSeparator)
+}
+
+// UnmarshalJSON Принимает и список слоев, и строку: так упаковка записывалась в JSON до появления составной упаковки.
+func (p *Packaging) UnmarshalJSON(data []byte) error {
+	var layers []PackageType
+	if errLayers := json.Unmarshal(data, &layers); errLayers == nil {
+		*p = layers
+		return nil
+	}
+
+	var s string
+	if errString := json.Unmarshal(data, &s); errString != nil {
+		return fmt.Errorf("models.Packaging.UnmarshalJSON error: %w", errString)
+	}
+	*p = ParsePackaging(s)
+
+	return nil
+}
+
+// Auto Упаковку нужно подобрать автоматически.
+func (p Packaging) Auto() bool {
+	return len(p) == 1 && p[0] == PackageAuto
+}
+
+// Contains Проверяет, есть ли среди слоев упаковка pack.
+func (p Packaging) Contains(pack PackageType) bool {
+	for _, layer := range p {
+		if layer == pack {
+			return true
+		}
+	}
+	return false
+}
+
+// Dimensions Внутренние размеры упаковки. Нулевые размеры означают, что упаковка принимает заказ любого размера.
+type Dimensions struct {
+	Length Centimeter
+	Width  Centimeter
+	Height Centimeter
+}
+
+// Fits Проверяет, помещается ли заказ размера size в упаковку с учетом поворота.
+// Нулевой size означает, что размеры заказа неизвестны, и такой заказ подходит любой упаковке.
+func (d Dimensions) Fits(size Dimensions) bool {
+	if d == (Dimensions{}) || size == (Dimensions{}) {
+		return true
+	}
+
+	inner, outer := size.sorted(), d.sorted()
+	for i := range inner {
+		if inner[i] > outer[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// sorted Стороны по убыванию, чтобы сравнивать размеры независимо от того, как повернут заказ.
+func (d Dimensions) sorted() [3]Centimeter {
+	sides := [3]Centimeter{d.Length, d.Width, d.Height}
+	sort.Slice(sides[:], func(i, j int) bool { return sides[i] > sides[j] })
+	return sides
+}
+
+// PackageSpec Тип упаковки из каталога. Заказ принимается, если его вес не меньше MinWeight и меньше MaxWeight,
+// нулевой MaxWeight снимает ограничение сверху. AllowWrap разрешает оборачивать упаковку пленкой.
+// Kind определяет, каким слоем тип может стоять в составной упаковке.
+// Выведенный из оборота тип (RetiredAt не нулевой) остается в каталоге ради истории, но новые заказы в нем не принимаются.
+type PackageSpec struct {
+	Type       PackageType
+	Kind       PackageKind
+	Price      Money
+	MinWeight  Kilo
+	MaxWeight  Kilo
+	Dimensions Dimensions
+	AllowWrap  bool
+	RetiredAt  time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (p PackageSpec) Retired() bool {
+	return !p.RetiredAt.IsZero()
+}
+
+func (p PackageSpec) String() string {
+	return fmt.Sprintf(
+		"Type: %s; Kind: %s; Price: %s; MinWeight: %v; MaxWeight: %v; Dimensions: %dx%dx%d; AllowWrap: %t; Retired: %t;",
+		p.Type, p.Kind, p.Price, p.MinWeight, p.MaxWeight,
+		p.Dimensions.Length, p.Dimensions.Width, p.Dimensions.Height, p.AllowWrap, p.Retired())
+}
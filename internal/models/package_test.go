@@ -0,0 +1,64 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDimensions_Fits(t *testing.T) {
+	box := Dimensions{Length: 60, Width: 40, Height: 40}
+
+	t.Run("Заказ можно повернуть", func(t *testing.T) {
+		assert.True(t, box.Fits(Dimensions{Length: 40, Width: 60, Height: 10}))
+		assert.True(t, box.Fits(Dimensions{Length: 10, Width: 40, Height: 60}))
+	})
+
+	t.Run("Заказ больше упаковки", func(t *testing.T) {
+		assert.False(t, box.Fits(Dimensions{Length: 61, Width: 10, Height: 10}))
+		assert.False(t, box.Fits(Dimensions{Length: 50, Width: 50, Height: 50}))
+	})
+
+	t.Run("Неизвестные размеры не ограничивают", func(t *testing.T) {
+		assert.True(t, box.Fits(Dimensions{}))
+		assert.True(t, Dimensions{}.Fits(Dimensions{Length: 200, Width: 100, Height: 100}))
+	})
+}
+
+func TestParsePackaging(t *testing.T) {
+	t.Run("Слои через +", func(t *testing.T) {
+		packaging := ParsePackaging("box+wrap")
+		assert.Equal(t, Packaging{"box", "wrap"}, packaging)
+		assert.Equal(t, "box+wrap", packaging.String())
+		assert.False(t, packaging.Auto())
+	})
+
+	t.Run("Пустая строка и автоматический выбор", func(t *testing.T) {
+		assert.Empty(t, ParsePackaging(""))
+		assert.True(t, ParsePackaging("auto").Auto())
+	})
+}
+
+func TestPackaging_UnmarshalJSON(t *testing.T) {
+	t.Run("Список слоев", func(t *testing.T) {
+		var packaging Packaging
+		require.NoError(t, json.Unmarshal([]byte(`["box","wrap"]`), &packaging))
+		assert.Equal(t, Packaging{"box", "wrap"}, packaging)
+	})
+
+	t.Run("Одиночная упаковка строкой", func(t *testing.T) {
+		var packaging Packaging
+		require.NoError(t, json.Unmarshal([]byte(`"box"`), &packaging))
+		assert.Equal(t, Packaging{"box"}, packaging)
+
+		require.NoError(t, json.Unmarshal([]byte(`""`), &packaging))
+		assert.Empty(t, packaging)
+	})
+
+	t.Run("Некорректное значение", func(t *testing.T) {
+		var packaging Packaging
+		assert.Error(t, json.Unmarshal([]byte(`12`), &packaging))
+	})
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// PickupCode Код, по которому клиент забирает заказы. Сам код не хранится: в хранилище только его HMAC с солью.
+// Один код действует для всех заказов клиента, которые ждут его в пункте выдачи.
+type PickupCode struct {
+	CustomerID     ID
+	Hash           []byte
+	Salt           []byte
+	FailedAttempts int
+	LockedUntil    time.Time
+	CreatedAt      time.Time
+}
+
+// Locked Выдача заблокирована после слишком большого числа неверных кодов.
+func (c PickupCode) Locked(now time.Time) bool {
+	return now.Before(c.LockedUntil)
+}
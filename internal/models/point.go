@@ -0,0 +1,47 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// PointID Идентификатор пункта выдачи, например msk-1.
+type PointID string
+
+// DefaultPoint Пункт, к которому отнесены заказы, принятые до появления нескольких пунктов.
+const DefaultPoint PointID = "default"
+
+// PickupPoint Пункт выдачи из справочника. TimeZone задается именем из базы IANA, ClosingTime в формате HH:MM.
+// Operators Сотрудники пункта: их запросы выполняются только с заказами этого пункта.
+// Cells Схема ячеек хранения. В пункте без схемы заказы принимаются без ячейки.
+type PickupPoint struct {
+	ID          PointID
+	Name        string
+	TimeZone    string
+	ClosingTime string
+	Operators   []Operator
+	Cells       []Cell
+}
+
+func (p PickupPoint) String() string {
+	operators := make([]string, 0, len(p.Operators))
+	for _, operator := range p.Operators {
+		operators = append(operators, string(operator))
+	}
+	return fmt.Sprintf("ID: %s; Name: %s; TimeZone: %s; ClosingTime: %s; Operators: %s; Cells: %d;",
+		p.ID, p.Name, p.TimeZone, p.ClosingTime, strings.Join(operators, ","), len(p.Cells))
+}
+
+type pointKey struct{}
+
+// WithPoint Запрос выполняется в границах пункта point: хранилище видит и меняет только его заказы.
+func WithPoint(ctx context.Context, point PointID) context.Context {
+	return context.WithValue(ctx, pointKey{}, point)
+}
+
+// PointFromContext Пункт, в границах которого выполняется запрос. Пустой, если пункт не определен.
+func PointFromContext(ctx context.Context) PointID {
+	point, _ := ctx.Value(pointKey{}).(PointID)
+	return point
+}
@@ -0,0 +1,9 @@
+package models
+
+// Quote Вариант упаковки для будущего заказа. Total считается так же, как Order.GetTotalCost: стоимость заказа
+// вместе с упаковкой.
+type Quote struct {
+	Package     Packaging
+	PackageCost Money
+	Total       Money
+}
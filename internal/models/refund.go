@@ -0,0 +1,91 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// RefundReason Код причины возврата, который клиент называет при обращении в пункт выдачи.
+type RefundReason string
+
+const (
+	RefundReasonDamaged        RefundReason = "damaged"
+	RefundReasonWrongItem      RefundReason = "wrong_item"
+	RefundReasonNotAsDescribed RefundReason = "not_as_described"
+	RefundReasonDefective      RefundReason = "defective"
+	RefundReasonChangedMind    RefundReason = "changed_mind"
+	RefundReasonOther          RefundReason = "other"
+)
+
+// Valid Проверяет, что код причины входит в известный список.
+func (r RefundReason) Valid() bool {
+	switch r {
+	case RefundReasonDamaged, RefundReasonWrongItem, RefundReasonNotAsDescribed,
+		RefundReasonDefective, RefundReasonChangedMind, RefundReasonOther:
+		return true
+	}
+	return false
+}
+
+// RefundState Этап рассмотрения возврата.
+type RefundState string
+
+const (
+	// RefundRequested Клиент принес заказ и оформил возврат.
+	RefundRequested RefundState = "requested"
+	// RefundInspected Сотрудник осмотрел заказ, решение еще не принято.
+	RefundInspected RefundState = "inspected"
+	// RefundApproved Возврат принят, деньги возвращаются клиенту.
+	RefundApproved RefundState = "approved"
+	// RefundRejected Возврат отклонен, заказ остается у клиента.
+	RefundRejected RefundState = "rejected"
+	// RefundHandedToCourier Принятый возврат передан курьеру вместе с заказом.
+	RefundHandedToCourier RefundState = "handed_to_courier"
+)
+
+// RefundDecision Действие сотрудника над возвратом.
+type RefundDecision string
+
+const (
+	DecisionInspect RefundDecision = "inspect"
+	DecisionApprove RefundDecision = "approve"
+	DecisionReject  RefundDecision = "reject"
+)
+
+// Refund Amount равен стоимости заказа без упаковки: упаковка клиенту не возвращается.
+// Если возврат касается отдельных товаров, Items перечисляет их, а Amount равен сумме их цен.
+type Refund struct {
+	ID         ID
+	OrderID    ID
+	CustomerID ID
+	Reason     RefundReason
+	Comment    string
+	State      RefundState
+	Amount     Money
+	Items      []ID
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (r Refund) String() string {
+	return fmt.Sprintf(
+		"RefundID: %d; OrderID: %d; CustomerID: %d; Reason: %s; Comment: %s; State: %s; Amount: %s; Items: %v; CreatedAt: %s; UpdatedAt: %s;",
+		r.ID, r.OrderID, r.CustomerID, r.Reason, r.Comment, r.State, r.Amount, r.Items,
+		r.CreatedAt.Format(time.DateTime), r.UpdatedAt.Format(time.DateTime))
+}
+
+// RefundChange Переход возврата между этапами. Comment хранит обоснование решения сотрудника для споров с продавцом.
+type RefundChange struct {
+	RefundID  ID
+	From      RefundState
+	To        RefundState
+	Comment   string
+	Operator  Operator
+	ChangedAt time.Time
+}
+
+func (c RefundChange) String() string {
+	return fmt.Sprintf(
+		"RefundID: %d; From: %s; To: %s; Comment: %s; Operator: %s; ChangedAt: %s;",
+		c.RefundID, c.From, c.To, c.Comment, c.Operator, c.ChangedAt.Format(time.DateTime))
+}
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+type Status string
+type Operator string
+
+const (
+	StatusAccepted          Status = "accepted"
+	StatusReadyForPickup    Status = "ready_for_pickup"
+	StatusIssued            Status = "issued"
+	StatusRefundRequested   Status = "refund_requested"
+	StatusRefunded          Status = "refunded"
+	StatusReturnedToCourier Status = "returned_to_courier"
+	StatusExpired           Status = "expired"
+	StatusDeclined          Status = "declined"
+	StatusTryingOn          Status = "trying_on"
+)
+
+// StatusChange описывает один переход заказа между статусами. Из таких записей складывается история заказа.
+type StatusChange struct {
+	OrderID   ID
+	From      Status
+	To        Status
+	Reason    string
+	Operator  Operator
+	ChangedAt time.Time
+}
+
+func (c StatusChange) String() string {
+	return fmt.Sprintf(
+		"OrderID: %d; From: %s; To: %s; Reason: %s; Operator: %s; ChangedAt: %s;",
+		c.OrderID, c.From, c.To, c.Reason, c.Operator, c.ChangedAt.Format(time.DateTime))
+}
@@ -0,0 +1,34 @@
+package models
+
+import "fmt"
+
+// TryOnOutcome Решение клиента по заказу после примерки.
+type TryOnOutcome string
+
+const (
+	// TryOnKeep Клиент забирает заказ.
+	TryOnKeep TryOnOutcome = "keep"
+	// TryOnReject Клиент отказывается от заказа сейчас, заказ возвращается на полку и ждет клиента до конца срока хранения.
+	TryOnReject TryOnOutcome = "reject"
+	// TryOnReturn Клиент отказывается от заказа совсем, заказ ждет возврата курьеру.
+	TryOnReturn TryOnOutcome = "return"
+)
+
+// Valid Проверяет, что решение входит в известный список.
+func (o TryOnOutcome) Valid() bool {
+	switch o {
+	case TryOnKeep, TryOnReject, TryOnReturn:
+		return true
+	}
+	return false
+}
+
+// TryOnDecision Решение по одному заказу из примерки.
+type TryOnDecision struct {
+	OrderID ID
+	Outcome TryOnOutcome
+}
+
+func (d TryOnDecision) String() string {
+	return fmt.Sprintf("OrderID: %d; Outcome: %s;", d.OrderID, d.Outcome)
+}
@@ -0,0 +1,77 @@
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework-1/internal/models"
+	"homework-1/internal/services/shelving"
+	"time"
+)
+
+var ErrMove = errors.New("order can not be moved: it is not on the shelf or already in this cell")
+
+// reasonMoved Причина в истории заказа, который переложили в другую ячейку. Статус заказа при этом не меняется.
+const reasonMoved = "moved to cell"
+
+// shelfStatuses Статусы заказов, которые лежат на полке пункта выдачи и занимают ячейку.
+var shelfStatuses = map[models.Status]bool{
+	models.StatusAccepted:       true,
+	models.StatusReadyForPickup: true,
+	models.StatusExpired:        true,
+	models.StatusDeclined:       true,
+	models.StatusRefunded:       true,
+}
+
+// MoveOrder Перекладывает заказ в ячейку cell. Так же в ячейку кладутся заказы, принятые без ячейки, например
+// до появления схемы ячеек у пункта. Заказ должен лежать в пункте, а ячейка - вмещать его и не быть заполненной.
+func (m *Module) MoveOrder(ctx context.Context, id models.ID, cell models.CellCode, operator models.Operator) (models.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.MoveOrder")
+	defer span.Finish()
+
+	moved, errMove := m.Storage.MoveOrder(ctx, id, func(order models.Order, cells []models.CellLoad) (models.OrderEvent, error) {
+		now := time.Now()
+		status := currentStatus(order, now)
+		if !shelfStatuses[status] || order.Cell == cell {
+			return models.OrderEvent{}, fmt.Errorf("%w: order %d is %s in cell %q", ErrMove, order.OrderID, status, order.Cell)
+		}
+
+		size, errSize := m.packageSize(ctx, order.Package)
+		if errSize != nil {
+			return models.OrderEvent{}, errSize
+		}
+		if errCheck := shelving.CheckMove(cells, cell, shelving.Parcel{Weight: order.Weight, Size: size}); errCheck != nil {
+			return models.OrderEvent{}, errCheck
+		}
+
+		order.Cell = cell
+		return newEvent(models.EventOrderMoved, order, models.StatusChange{
+			OrderID:   order.OrderID,
+			From:      order.Status,
+			To:        order.Status,
+			Reason:    fmt.Sprintf("%s %s", reasonMoved, cell),
+			Operator:  operator,
+			ChangedAt: now,
+		}), nil
+	})
+	if errMove != nil {
+		return models.Order{}, fmt.Errorf("module.MoveOrder error: %w", errMove)
+	}
+
+	return moved, nil
+}
+
+// packageSize Размеры уже принятого заказа по внутреннему слою его упаковки. В отличие от getPackage годится
+// и для типов, выведенных из оборота после приема заказа. У заказа без упаковки размеры неизвестны.
+func (m *Module) packageSize(ctx context.Context, pack models.Packaging) (models.Dimensions, error) {
+	if len(pack) == 0 {
+		return models.Dimensions{}, nil
+	}
+
+	spec, errGet := m.Storage.GetPackage(ctx, pack[0])
+	if errGet != nil {
+		return models.Dimensions{}, errGet
+	}
+	return spec.Dimensions, nil
+}
@@ -0,0 +1,134 @@
+package module
+
+import (
+	"context"
+	"homework-1/internal/models"
+	"homework-1/internal/services/shelving"
+	mockstorage "homework-1/internal/storage/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCells Схема ячеек пункта: маленькая полка до 10 кг и большая ячейка без ограничений.
+var testCells = []models.CellLoad{
+	{Cell: models.Cell{Code: "A-01-01", Capacity: 2, MaxWeight: 10}},
+	{Cell: models.Cell{Code: "L-01-01", Capacity: 1}},
+}
+
+func TestModule_AddOrderCell(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	mockStorage.EXPECT().GetPackage(gomock.Any(), gomock.Any()).DoAndReturn(getTestPackage).AnyTimes()
+	module := NewModule(Deps{Storage: mockStorage})
+
+	addOrder := func(number string, weight models.Kilo, cells []models.CellLoad) (models.Order, error) {
+		mockStorage.EXPECT().AddOrder(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, order models.Order, event models.OrderEvent, code models.PickupCode, place func(cells []models.CellLoad) (models.CellCode, error)) (models.ID, error) {
+				if _, errPlace := place(cells); errPlace != nil {
+					return 0, errPlace
+				}
+				return models.ID(1), nil
+			})
+
+		ref := models.ExternalRef{Source: "marketplace", Number: number}
+		return module.AddOrder(context.Background(), ref, models.ID(1), time.Now().Add(time.Hour), models.Packaging{"box"}, weight, models.Dimensions{}, models.Rubles(100), nil, operator)
+	}
+
+	t.Run("Заказ получает ячейку по весу", func(t *testing.T) {
+		order, err := addOrder("cell-1", models.Kilo(5), testCells)
+		require.NoError(t, err)
+		assert.Equal(t, models.CellCode("A-01-01"), order.Cell)
+
+		order, err = addOrder("cell-2", models.Kilo(20), testCells)
+		require.NoError(t, err)
+		assert.Equal(t, models.CellCode("L-01-01"), order.Cell)
+	})
+
+	t.Run("Без свободной ячейки заказ не принимается", func(t *testing.T) {
+		full := []models.CellLoad{{Cell: testCells[0].Cell}, {Cell: testCells[1].Cell, Occupied: 1}}
+
+		_, err := addOrder("cell-3", models.Kilo(20), full)
+		assert.ErrorIs(t, err, shelving.ErrNoCell)
+	})
+}
+
+func TestModule_MoveOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	mockStorage.EXPECT().GetPackage(gomock.Any(), gomock.Any()).DoAndReturn(getTestPackage).AnyTimes()
+	module := NewModule(Deps{Storage: mockStorage})
+
+	moveOrder := func(order models.Order, cell models.CellCode) (models.OrderEvent, error) {
+		var event models.OrderEvent
+		mockStorage.EXPECT().MoveOrder(gomock.Any(), order.OrderID, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, id models.ID, move func(models.Order, []models.CellLoad) (models.OrderEvent, error)) (models.Order, error) {
+				moved, errMove := move(order, testCells)
+				if errMove != nil {
+					return models.Order{}, errMove
+				}
+				event = moved
+				return moved.Order, nil
+			})
+
+		_, err := module.MoveOrder(context.Background(), order.OrderID, cell, operator)
+		return event, err
+	}
+
+	order := models.Order{
+		OrderID:        models.ID(1),
+		Status:         models.StatusAccepted,
+		ExpirationTime: time.Now().Add(time.Hour),
+		Package:        models.Packaging{"box"},
+		Weight:         models.Kilo(5),
+		Cell:           "A-01-01",
+	}
+
+	t.Run("Заказ перекладывается в другую ячейку", func(t *testing.T) {
+		event, err := moveOrder(order, "L-01-01")
+		require.NoError(t, err)
+		assert.Equal(t, models.EventOrderMoved, event.Type)
+		assert.Equal(t, models.CellCode("L-01-01"), event.Order.Cell)
+		assert.Equal(t, models.StatusAccepted, event.Change.To)
+		assert.Equal(t, operator, event.Change.Operator)
+	})
+
+	t.Run("Заказ без ячейки кладется в ячейку", func(t *testing.T) {
+		legacy := order
+		legacy.Cell = ""
+		legacy.Package = nil
+
+		event, err := moveOrder(legacy, "A-01-01")
+		require.NoError(t, err)
+		assert.Equal(t, models.CellCode("A-01-01"), event.Order.Cell)
+	})
+
+	t.Run("Выданный заказ и заказ в той же ячейке не перекладываются", func(t *testing.T) {
+		_, err := moveOrder(order, "A-01-01")
+		assert.ErrorIs(t, err, ErrMove)
+
+		issued := order
+		issued.Status = models.StatusIssued
+		_, err = moveOrder(issued, "L-01-01")
+		assert.ErrorIs(t, err, ErrMove)
+	})
+
+	t.Run("Ячейка не подходит заказу", func(t *testing.T) {
+		heavy := order
+		heavy.Cell = "L-01-01"
+		heavy.Weight = models.Kilo(20)
+
+		_, err := moveOrder(heavy, "A-01-01")
+		assert.ErrorIs(t, err, shelving.ErrCellSize)
+
+		_, err = moveOrder(order, "Z-01-01")
+		assert.ErrorIs(t, err, shelving.ErrUnknownCell)
+	})
+}
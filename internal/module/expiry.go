@@ -0,0 +1,58 @@
+package module
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework-1/internal/models"
+	"time"
+)
+
+// ExpireOrders Записывает в историю просрочку не более limit заказов, срок хранения которых истек, и возвращает
+// эти заказы. До записи просрочка только вычисляется при чтении заказа, и без нее в истории не видно,
+// когда заказ стал просроченным.
+func (m *Module) ExpireOrders(ctx context.Context, limit int, operator models.Operator) ([]models.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.ExpireOrders")
+	defer span.Finish()
+
+	now := time.Now()
+	ordersId, errGet := m.Storage.GetExpiredOrders(ctx, now, limit)
+	if errGet != nil {
+		return nil, fmt.Errorf("module.ExpireOrders error: %w", errGet)
+	}
+	if len(ordersId) == 0 {
+		return nil, nil
+	}
+
+	expired, errExpire := m.expireOrders(ctx, ordersId, operator, now)
+	if errExpire != nil {
+		return nil, fmt.Errorf("module.ExpireOrders error: %w", errExpire)
+	}
+
+	return expired, nil
+}
+
+// expireOrders Записывает просрочку заказов ordersId под блокировкой. Заказ, который успели выдать или просрочку
+// которого уже записал параллельный запрос, не меняется.
+func (m *Module) expireOrders(ctx context.Context, ordersId []models.ID, operator models.Operator, now time.Time) ([]models.Order, error) {
+	var expired []models.Order
+	errChange := m.Storage.ChangeStatuses(ctx, ordersId, func(orders []models.Order) ([]models.OrderEvent, error) {
+		expired = make([]models.Order, 0, len(orders))
+		events := make([]models.OrderEvent, 0, len(orders))
+		for _, order := range orders {
+			overdue, change, ok := expire(order, operator, now)
+			if !ok {
+				continue
+			}
+			expired = append(expired, overdue)
+			events = append(events, newEvent(models.EventOrderExpired, overdue, change))
+		}
+
+		return events, nil
+	})
+	if errChange != nil {
+		return nil, errChange
+	}
+
+	return expired, nil
+}
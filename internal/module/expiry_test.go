@@ -0,0 +1,62 @@
+package module
+
+import (
+	"context"
+	"homework-1/internal/models"
+	mockstorage "homework-1/internal/storage/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModule_ExpireOrders(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage})
+
+	t.Run("Просрочка записывается в историю временем окончания срока хранения", func(t *testing.T) {
+		expiration := time.Now().Add(-time.Hour)
+		orders := []models.Order{
+			{OrderID: 1, CustomerID: 10, Status: models.StatusAccepted, ExpirationTime: expiration},
+			{OrderID: 2, CustomerID: 10, Status: models.StatusReadyForPickup, ExpirationTime: expiration},
+			// Заказ выдали, пока шла проверка.
+			{OrderID: 3, CustomerID: 20, Status: models.StatusIssued, ExpirationTime: expiration},
+		}
+
+		mockStorage.EXPECT().GetExpiredOrders(gomock.Any(), gomock.Any(), 10).Return([]models.ID{1, 2, 3}, nil)
+		mockStorage.EXPECT().ChangeStatuses(gomock.Any(), []models.ID{1, 2, 3}, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ids []models.ID, change func([]models.Order) ([]models.OrderEvent, error)) error {
+				events, err := change(orders)
+				require.NoError(t, err)
+				require.Len(t, events, 2)
+				for i, event := range events {
+					assert.Equal(t, models.EventOrderExpired, event.Type)
+					assert.Equal(t, orders[i].Status, event.Change.From)
+					assert.Equal(t, models.StatusExpired, event.Change.To)
+					assert.Equal(t, models.StatusExpired, event.Order.Status)
+					assert.Equal(t, operator, event.Change.Operator)
+					assert.True(t, expiration.Equal(event.Change.ChangedAt))
+				}
+				return nil
+			})
+
+		expired, err := module.ExpireOrders(context.Background(), 10, operator)
+		require.NoError(t, err)
+		require.Len(t, expired, 2)
+		assert.Equal(t, models.ID(1), expired[0].OrderID)
+		assert.Equal(t, models.ID(2), expired[1].OrderID)
+	})
+
+	t.Run("Нет просроченных заказов", func(t *testing.T) {
+		mockStorage.EXPECT().GetExpiredOrders(gomock.Any(), gomock.Any(), 10).Return(nil, nil)
+
+		expired, err := module.ExpireOrders(context.Background(), 10, operator)
+		require.NoError(t, err)
+		assert.Empty(t, expired)
+	})
+}
@@ -0,0 +1,163 @@
+package module
+
+import (
+	"errors"
+	"fmt"
+	"homework-1/internal/models"
+	"strings"
+)
+
+var ErrItems = errors.New("invalid order items")
+
+// newItems Проверяет товары нового заказа и возвращает стоимость заказа. Если стоимость cost не задана,
+// она складывается из цен товаров, а если задана, то должна с ними совпадать.
+func newItems(items []models.OrderItem, cost models.Money) ([]models.OrderItem, models.Money, error) {
+	if len(items) == 0 {
+		return nil, cost, nil
+	}
+
+	accepted := make([]models.OrderItem, 0, len(items))
+	for i, item := range items {
+		if strings.TrimSpace(item.Name) == "" || item.Price.Amount <= 0 {
+			return nil, models.Money{}, fmt.Errorf("%w: item %d must have a name and a positive price", ErrItems, i)
+		}
+		item.ID, item.OrderID, item.Status = 0, 0, models.ItemAtPoint
+		accepted = append(accepted, item)
+	}
+
+	total, errCost := models.ItemsCost(accepted)
+	if errCost != nil {
+		return nil, models.Money{}, fmt.Errorf("%w: %w", ErrItems, errCost)
+	}
+	if cost.Amount != 0 && cost != total {
+		return nil, models.Money{}, fmt.Errorf("%w: order cost %s does not match items total %s", ErrItems, cost, total)
+	}
+
+	return accepted, total, nil
+}
+
+// receiveItems Выдает товары заказа, кроме тех, от которых клиент отказался. Найденные отказы удаляются из declined,
+// чтобы после обработки всей пачки в нем остались только товары, которых в пачке нет.
+// Возвращает заказ с новыми состояниями товаров и признак того, что клиент отказался от всех товаров.
+func receiveItems(order models.Order, declined map[models.ID]struct{}) (models.Order, bool) {
+	if len(order.Items) == 0 {
+		return order, false
+	}
+
+	items := make([]models.OrderItem, 0, len(order.Items))
+	allDeclined := true
+	for _, item := range order.Items {
+		if _, ok := declined[item.ID]; ok {
+			delete(declined, item.ID)
+			item.Status = models.ItemDeclined
+		} else {
+			item.Status = models.ItemIssued
+			allDeclined = false
+		}
+		items = append(items, item)
+	}
+	order.Items = items
+
+	return order, allDeclined
+}
+
+// refundItems Товары, которые клиент возвращает. Без явного списка возвращаются все выданные товары заказа.
+// Вернуть можно только выданный товар своего заказа.
+func refundItems(order models.Order, itemsId []models.ID) ([]models.OrderItem, error) {
+	if len(order.Items) == 0 {
+		if len(itemsId) > 0 {
+			return nil, fmt.Errorf("%w: order has no items", ErrItems)
+		}
+		return nil, nil
+	}
+
+	if len(itemsId) == 0 {
+		issued := order.ItemsIn(models.ItemIssued)
+		if len(issued) == 0 {
+			return nil, fmt.Errorf("%w: no issued items to refund", ErrItems)
+		}
+		return issued, nil
+	}
+
+	byId := make(map[models.ID]models.OrderItem, len(order.Items))
+	for _, item := range order.Items {
+		byId[item.ID] = item
+	}
+
+	items := make([]models.OrderItem, 0, len(itemsId))
+	seen := make(map[models.ID]struct{}, len(itemsId))
+	for _, itemId := range itemsId {
+		item, ok := byId[itemId]
+		if !ok || item.Status != models.ItemIssued {
+			return nil, fmt.Errorf("%w: item %d is not issued in order %d", ErrItems, itemId, order.OrderID)
+		}
+		if _, dup := seen[itemId]; dup {
+			continue
+		}
+		seen[itemId] = struct{}{}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// setItemsStatus Переводит товары itemsId, находящиеся в состоянии from, в состояние to.
+func setItemsStatus(order models.Order, itemsId []models.ID, from models.ItemStatus, to models.ItemStatus) models.Order {
+	selected := idSet(itemsId)
+
+	items := make([]models.OrderItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		if _, ok := selected[item.ID]; ok && item.Status == from {
+			item.Status = to
+		}
+		items = append(items, item)
+	}
+	order.Items = items
+
+	return order
+}
+
+// returnItems Отмечает переданными курьеру все товары, которые остались в пункте: отказные, возвращенные
+// и не выданные вовсе.
+func returnItems(order models.Order) models.Order {
+	items := make([]models.OrderItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		if item.AwaitsCourier() || item.Status == models.ItemAtPoint {
+			item.Status = models.ItemReturnedToCourier
+		}
+		items = append(items, item)
+	}
+	order.Items = items
+
+	return order
+}
+
+// declineItems Отмечает отказными все товары, которые ждали клиента: клиент отказался от заказа целиком.
+func declineItems(order models.Order) models.Order {
+	items := make([]models.OrderItem, 0, len(order.Items))
+	for _, item := range order.Items {
+		if item.Status == models.ItemAtPoint {
+			item.Status = models.ItemDeclined
+		}
+		items = append(items, item)
+	}
+	order.Items = items
+
+	return order
+}
+
+func idSet(ids []models.ID) map[models.ID]struct{} {
+	set := make(map[models.ID]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
+
+func itemIds(items []models.OrderItem) []models.ID {
+	ids := make([]models.ID, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	return ids
+}
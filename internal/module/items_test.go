@@ -0,0 +1,237 @@
+package module
+
+import (
+	"context"
+	"homework-1/internal/models"
+	mockstorage "homework-1/internal/storage/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// itemsOrder Выданный клиенту заказ из двух товаров.
+func itemsOrder(orderID models.ID, status models.Status, first models.ItemStatus, second models.ItemStatus) models.Order {
+	return models.Order{
+		OrderID:            orderID,
+		CustomerID:         models.ID(1),
+		ReceivedByCustomer: status != models.StatusAccepted,
+		ReceivedTime:       time.Now().Add(-time.Hour),
+		ExpirationTime:     time.Now().Add(time.Hour),
+		Status:             status,
+		Package:            models.Packaging{"box"},
+		Cost:               models.Rubles(300),
+		Items: []models.OrderItem{
+			{ID: 10, OrderID: orderID, Name: "Кружка", Price: models.Rubles(100), Status: first},
+			{ID: 11, OrderID: orderID, Name: "Чайник", Price: models.Rubles(200), Status: second},
+		},
+	}
+}
+
+func TestModule_AddOrderItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	mockStorage.EXPECT().GetPackage(gomock.Any(), gomock.Any()).DoAndReturn(getTestPackage).AnyTimes()
+	module := NewModule(Deps{Storage: mockStorage})
+
+	items := []models.OrderItem{
+		{SKU: "sku-1", Name: "Кружка", Price: models.Rubles(100), Status: models.ItemRefunded},
+		{SKU: "sku-2", Name: "Чайник", Price: models.Rubles(200)},
+	}
+
+	t.Run("Стоимость заказа складывается из цен товаров", func(t *testing.T) {
+		ref := models.ExternalRef{Source: "marketplace", Number: "items-1"}
+
+		mockStorage.EXPECT().AddOrder(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, order models.Order, event models.OrderEvent, code models.PickupCode, place func(cells []models.CellLoad) (models.CellCode, error)) (models.ID, error) {
+				assert.Equal(t, models.Rubles(300), order.Cost)
+				require.Len(t, order.Items, 2)
+				assert.Equal(t, models.ItemAtPoint, order.Items[0].Status)
+				assert.Equal(t, models.ItemAtPoint, order.Items[1].Status)
+				return models.ID(1), nil
+			})
+
+		_, err := module.AddOrder(context.Background(), ref, models.ID(1), time.Now().Add(time.Hour),
+			models.Packaging{"box"}, models.Kilo(1), models.Dimensions{}, models.Money{Currency: models.CurrencyRUB}, items, operator)
+		require.NoError(t, err)
+	})
+
+	t.Run("Стоимость заказа не совпадает с ценами товаров", func(t *testing.T) {
+		ref := models.ExternalRef{Source: "marketplace", Number: "items-2"}
+
+		_, err := module.AddOrder(context.Background(), ref, models.ID(1), time.Now().Add(time.Hour),
+			models.Packaging{"box"}, models.Kilo(1), models.Dimensions{}, models.Rubles(250), items, operator)
+		assert.ErrorIs(t, err, ErrItems)
+	})
+
+	t.Run("Товар без цены", func(t *testing.T) {
+		ref := models.ExternalRef{Source: "marketplace", Number: "items-3"}
+
+		_, err := module.AddOrder(context.Background(), ref, models.ID(1), time.Now().Add(time.Hour),
+			models.Packaging{"box"}, models.Kilo(1), models.Dimensions{}, models.Money{}, []models.OrderItem{{Name: "Кружка"}}, operator)
+		assert.ErrorIs(t, err, ErrItems)
+	})
+}
+
+func TestModule_ReceiveOrderItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage})
+
+	// receive Подставляет заказ в ChangeStatuses и возвращает события, которые сформировал модуль.
+	receive := func(order models.Order, events *[]models.OrderEvent) {
+		mockStorage.EXPECT().ChangeStatuses(gomock.Any(), []models.ID{order.OrderID}, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ids []models.ID, change func([]models.Order) ([]models.OrderEvent, error)) error {
+				changed, err := change([]models.Order{order})
+				*events = changed
+				return err
+			})
+	}
+
+	t.Run("Отказ от части товаров", func(t *testing.T) {
+		order := itemsOrder(models.ID(1), models.StatusAccepted, models.ItemAtPoint, models.ItemAtPoint)
+
+		var events []models.OrderEvent
+		receive(order, &events)
+
+		received, err := module.ReceiveOrders(context.Background(), []models.ID{order.OrderID}, "", []models.ID{11}, operator)
+		require.NoError(t, err)
+		require.Len(t, received, 1)
+		assert.Equal(t, models.StatusIssued, received[0].Status)
+		assert.Equal(t, models.ItemIssued, received[0].Items[0].Status)
+		assert.Equal(t, models.ItemDeclined, received[0].Items[1].Status)
+		require.Len(t, events, 1)
+		assert.Equal(t, models.EventOrderReceived, events[0].Type)
+	})
+
+	t.Run("Отказ от всех товаров", func(t *testing.T) {
+		order := itemsOrder(models.ID(2), models.StatusAccepted, models.ItemAtPoint, models.ItemAtPoint)
+
+		var events []models.OrderEvent
+		receive(order, &events)
+
+		received, err := module.ReceiveOrders(context.Background(), []models.ID{order.OrderID}, "", []models.ID{10, 11}, operator)
+		require.NoError(t, err)
+		assert.Equal(t, models.StatusDeclined, received[0].Status)
+		require.Len(t, events, 1)
+		assert.Equal(t, models.EventOrderDeclined, events[0].Type)
+	})
+
+	t.Run("Отказ от товара не из выдаваемых заказов", func(t *testing.T) {
+		order := itemsOrder(models.ID(3), models.StatusAccepted, models.ItemAtPoint, models.ItemAtPoint)
+
+		var events []models.OrderEvent
+		receive(order, &events)
+
+		_, err := module.ReceiveOrders(context.Background(), []models.ID{order.OrderID}, "", []models.ID{99}, operator)
+		assert.ErrorIs(t, err, ErrItems)
+		assert.Empty(t, events)
+	})
+}
+
+func TestModule_RefundOrderItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage})
+
+	t.Run("Возврат части товаров", func(t *testing.T) {
+		order := itemsOrder(models.ID(1), models.StatusIssued, models.ItemIssued, models.ItemIssued)
+
+		mockStorage.EXPECT().GetOrder(gomock.Any(), order.OrderID).Return(order, nil)
+		mockStorage.EXPECT().CreateRefund(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, refund models.Refund, change models.RefundChange, event models.OrderEvent) (models.Refund, error) {
+				assert.Equal(t, models.Rubles(200), refund.Amount)
+				assert.Equal(t, []models.ID{11}, refund.Items)
+				assert.Equal(t, models.ItemIssued, event.Order.Items[0].Status)
+				assert.Equal(t, models.ItemRefundRequested, event.Order.Items[1].Status)
+				return refund, nil
+			})
+
+		_, err := module.RefundOrder(context.Background(), order.CustomerID, order.OrderID, models.RefundReasonDamaged, "", []models.ID{11}, operator)
+		require.NoError(t, err)
+	})
+
+	t.Run("Возврат товара, от которого клиент отказался при выдаче", func(t *testing.T) {
+		order := itemsOrder(models.ID(2), models.StatusIssued, models.ItemIssued, models.ItemDeclined)
+
+		mockStorage.EXPECT().GetOrder(gomock.Any(), order.OrderID).Return(order, nil)
+
+		_, err := module.RefundOrder(context.Background(), order.CustomerID, order.OrderID, models.RefundReasonDamaged, "", []models.ID{11}, operator)
+		assert.ErrorIs(t, err, ErrItems)
+	})
+
+	t.Run("Принятый возврат части товаров оставляет заказ выданным", func(t *testing.T) {
+		order := itemsOrder(models.ID(3), models.StatusRefundRequested, models.ItemIssued, models.ItemRefundRequested)
+		refund := models.Refund{ID: models.ID(3), OrderID: order.OrderID, State: models.RefundInspected, Items: []models.ID{11}}
+
+		var events []models.OrderEvent
+		mockStorage.EXPECT().ChangeRefund(gomock.Any(), refund.ID, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, refundId models.ID, f func(models.Refund, models.Order) (models.Refund, models.RefundChange, []models.OrderEvent, error)) (models.Refund, error) {
+				changed, _, changedEvents, err := f(refund, order)
+				events = changedEvents
+				return changed, err
+			})
+
+		_, err := module.DecideRefund(context.Background(), refund.ID, models.DecisionApprove, "брак", operator)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, models.StatusIssued, events[0].Order.Status)
+		assert.Equal(t, models.ItemIssued, events[0].Order.Items[0].Status)
+		assert.Equal(t, models.ItemRefunded, events[0].Order.Items[1].Status)
+	})
+}
+
+func TestModule_ReturnOrderItems(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage})
+
+	t.Run("Курьер забирает отказные и возвращенные товары выданного заказа", func(t *testing.T) {
+		order := itemsOrder(models.ID(1), models.StatusIssued, models.ItemDeclined, models.ItemRefunded)
+		order.Items = append(order.Items, models.OrderItem{ID: 12, OrderID: order.OrderID, Name: "Ложка", Price: models.Rubles(10), Status: models.ItemIssued})
+		refund := models.Refund{ID: models.ID(7), OrderID: order.OrderID, State: models.RefundApproved, Items: []models.ID{11}}
+
+		mockStorage.EXPECT().GetOrder(gomock.Any(), order.OrderID).Return(order, nil)
+		mockStorage.EXPECT().GetOrderRefunds(gomock.Any(), order.OrderID, models.RefundApproved).Return([]models.Refund{refund}, nil)
+		mockStorage.EXPECT().ReturnItems(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, event models.OrderEvent, handed []models.RefundChange) error {
+				assert.Equal(t, models.EventItemsReturned, event.Type)
+				assert.Equal(t, models.StatusIssued, event.Change.To)
+				require.Len(t, handed, 1)
+				assert.Equal(t, models.RefundHandedToCourier, handed[0].To)
+				return nil
+			})
+
+		returned, err := module.ReturnOrder(context.Background(), order.OrderID, operator)
+		require.NoError(t, err)
+		assert.Equal(t, models.StatusIssued, returned.Status)
+		assert.Equal(t, models.ItemReturnedToCourier, returned.Items[0].Status)
+		assert.Equal(t, models.ItemReturnedToCourier, returned.Items[1].Status)
+		assert.Equal(t, models.ItemIssued, returned.Items[2].Status)
+	})
+
+	t.Run("Заказ, от которого клиент отказался целиком, возвращается курьеру", func(t *testing.T) {
+		order := itemsOrder(models.ID(2), models.StatusDeclined, models.ItemDeclined, models.ItemDeclined)
+
+		mockStorage.EXPECT().GetOrder(gomock.Any(), order.OrderID).Return(order, nil)
+		mockStorage.EXPECT().ReturnOrder(gomock.Any(), gomock.Any(), gomock.Nil()).DoAndReturn(
+			func(ctx context.Context, event models.OrderEvent, handed []models.RefundChange) (models.Order, error) {
+				assert.Equal(t, models.StatusReturnedToCourier, event.Change.To)
+				assert.Equal(t, models.ItemReturnedToCourier, event.Order.Items[0].Status)
+				return event.Order, nil
+			})
+
+		_, err := module.ReturnOrder(context.Background(), order.OrderID, operator)
+		require.NoError(t, err)
+	})
+}
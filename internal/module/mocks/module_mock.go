@@ -5,6 +5,7 @@
 package module_mock
 
 import (
+	context "context"
 	models "homework-1/internal/models"
 	reflect "reflect"
 	time "time"
@@ -36,89 +37,362 @@ func (m *MockModuleInterface) EXPECT() *MockModuleInterfaceMockRecorder {
 }
 
 // AddOrder mocks base method.
-func (m *MockModuleInterface) AddOrder(orderId, customerId models.ID, expirationTime time.Time, pack models.PackageType, weight models.Kilo, cost models.Rub) error {
+func (m *MockModuleInterface) AddOrder(ctx context.Context, ref models.ExternalRef, customerId models.ID, expirationTime time.Time, pack models.Packaging, weight models.Kilo, size models.Dimensions, cost models.Money, items []models.OrderItem, operator models.Operator) (models.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddOrder", orderId, customerId, expirationTime, pack, weight, cost)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "AddOrder", ctx, ref, customerId, expirationTime, pack, weight, size, cost, items, operator)
+	ret0, _ := ret[0].(models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AddOrder indicates an expected call of AddOrder.
-func (mr *MockModuleInterfaceMockRecorder) AddOrder(orderId, customerId, expirationTime, pack, weight, cost interface{}) *gomock.Call {
+func (mr *MockModuleInterfaceMockRecorder) AddOrder(ctx, ref, customerId, expirationTime, pack, weight, size, cost, items, operator interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrder", reflect.TypeOf((*MockModuleInterface)(nil).AddOrder), orderId, customerId, expirationTime, pack, weight, cost)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrder", reflect.TypeOf((*MockModuleInterface)(nil).AddOrder), ctx, ref, customerId, expirationTime, pack, weight, size, cost, items, operator)
 }
 
-// GetOrders mocks base method.
-func (m *MockModuleInterface) GetOrders(customerId models.ID, n int) ([]models.Order, error) {
+// AddPackage mocks base method.
+func (m *MockModuleInterface) AddPackage(ctx context.Context, spec models.PackageSpec) (models.PackageSpec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPackage", ctx, spec)
+	ret0, _ := ret[0].(models.PackageSpec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPackage indicates an expected call of AddPackage.
+func (mr *MockModuleInterfaceMockRecorder) AddPackage(ctx, spec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPackage", reflect.TypeOf((*MockModuleInterface)(nil).AddPackage), ctx, spec)
+}
+
+// ConfirmTryOn mocks base method.
+func (m *MockModuleInterface) ConfirmTryOn(ctx context.Context, decisions []models.TryOnDecision, declined []models.ID, operator models.Operator) ([]models.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrders", customerId, n)
+	ret := m.ctrl.Call(m, "ConfirmTryOn", ctx, decisions, declined, operator)
 	ret0, _ := ret[0].([]models.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// ConfirmTryOn indicates an expected call of ConfirmTryOn.
+func (mr *MockModuleInterfaceMockRecorder) ConfirmTryOn(ctx, decisions, declined, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTryOn", reflect.TypeOf((*MockModuleInterface)(nil).ConfirmTryOn), ctx, decisions, declined, operator)
+}
+
+// DecideRefund mocks base method.
+func (m *MockModuleInterface) DecideRefund(ctx context.Context, refundId models.ID, decision models.RefundDecision, comment string, operator models.Operator) (models.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DecideRefund", ctx, refundId, decision, comment, operator)
+	ret0, _ := ret[0].(models.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DecideRefund indicates an expected call of DecideRefund.
+func (mr *MockModuleInterfaceMockRecorder) DecideRefund(ctx, refundId, decision, comment, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecideRefund", reflect.TypeOf((*MockModuleInterface)(nil).DecideRefund), ctx, refundId, decision, comment, operator)
+}
+
+// ExpireOrders mocks base method.
+func (m *MockModuleInterface) ExpireOrders(ctx context.Context, limit int, operator models.Operator) ([]models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireOrders", ctx, limit, operator)
+	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireOrders indicates an expected call of ExpireOrders.
+func (mr *MockModuleInterfaceMockRecorder) ExpireOrders(ctx, limit, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireOrders", reflect.TypeOf((*MockModuleInterface)(nil).ExpireOrders), ctx, limit, operator)
+}
+
+// GetOrderHistory mocks base method.
+func (m *MockModuleInterface) GetOrderHistory(ctx context.Context, orderId models.ID) ([]models.StatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderHistory", ctx, orderId)
+	ret0, _ := ret[0].([]models.StatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderHistory indicates an expected call of GetOrderHistory.
+func (mr *MockModuleInterfaceMockRecorder) GetOrderHistory(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderHistory", reflect.TypeOf((*MockModuleInterface)(nil).GetOrderHistory), ctx, orderId)
+}
+
+// GetOrders mocks base method.
+func (m *MockModuleInterface) GetOrders(ctx context.Context, query models.OrdersQuery) (models.OrdersPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrders", ctx, query)
+	ret0, _ := ret[0].(models.OrdersPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // GetOrders indicates an expected call of GetOrders.
-func (mr *MockModuleInterfaceMockRecorder) GetOrders(customerId, n interface{}) *gomock.Call {
+func (mr *MockModuleInterfaceMockRecorder) GetOrders(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockModuleInterface)(nil).GetOrders), ctx, query)
+}
+
+// GetPackages mocks base method.
+func (m *MockModuleInterface) GetPackages(ctx context.Context, includeRetired bool) ([]models.PackageSpec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackages", ctx, includeRetired)
+	ret0, _ := ret[0].([]models.PackageSpec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackages indicates an expected call of GetPackages.
+func (mr *MockModuleInterfaceMockRecorder) GetPackages(ctx, includeRetired interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackages", reflect.TypeOf((*MockModuleInterface)(nil).GetPackages), ctx, includeRetired)
+}
+
+// GetQuote mocks base method.
+func (m *MockModuleInterface) GetQuote(ctx context.Context, weight models.Kilo, size models.Dimensions, cost models.Money) ([]models.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuote", ctx, weight, size, cost)
+	ret0, _ := ret[0].([]models.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuote indicates an expected call of GetQuote.
+func (mr *MockModuleInterfaceMockRecorder) GetQuote(ctx, weight, size, cost interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuote", reflect.TypeOf((*MockModuleInterface)(nil).GetQuote), ctx, weight, size, cost)
+}
+
+// GetRefund mocks base method.
+func (m *MockModuleInterface) GetRefund(ctx context.Context, refundId models.ID) (models.Refund, []models.RefundChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefund", ctx, refundId)
+	ret0, _ := ret[0].(models.Refund)
+	ret1, _ := ret[1].([]models.RefundChange)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRefund indicates an expected call of GetRefund.
+func (mr *MockModuleInterfaceMockRecorder) GetRefund(ctx, refundId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockModuleInterface)(nil).GetOrders), customerId, n)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefund", reflect.TypeOf((*MockModuleInterface)(nil).GetRefund), ctx, refundId)
 }
 
 // GetRefunds mocks base method.
-func (m *MockModuleInterface) GetRefunds(page, limit int) ([]models.Order, error) {
+func (m *MockModuleInterface) GetRefunds(ctx context.Context, query models.RefundsQuery) (models.RefundsPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRefunds", page, limit)
-	ret0, _ := ret[0].([]models.Order)
+	ret := m.ctrl.Call(m, "GetRefunds", ctx, query)
+	ret0, _ := ret[0].(models.RefundsPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetRefunds indicates an expected call of GetRefunds.
-func (mr *MockModuleInterfaceMockRecorder) GetRefunds(page, limit interface{}) *gomock.Call {
+func (mr *MockModuleInterfaceMockRecorder) GetRefunds(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefunds", reflect.TypeOf((*MockModuleInterface)(nil).GetRefunds), ctx, query)
+}
+
+// MoveOrder mocks base method.
+func (m *MockModuleInterface) MoveOrder(ctx context.Context, id models.ID, cell models.CellCode, operator models.Operator) (models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveOrder", ctx, id, cell, operator)
+	ret0, _ := ret[0].(models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveOrder indicates an expected call of MoveOrder.
+func (mr *MockModuleInterfaceMockRecorder) MoveOrder(ctx, id, cell, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveOrder", reflect.TypeOf((*MockModuleInterface)(nil).MoveOrder), ctx, id, cell, operator)
+}
+
+// OperatorPoint mocks base method.
+func (m *MockModuleInterface) OperatorPoint(ctx context.Context, operator models.Operator) (models.PointID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OperatorPoint", ctx, operator)
+	ret0, _ := ret[0].(models.PointID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OperatorPoint indicates an expected call of OperatorPoint.
+func (mr *MockModuleInterfaceMockRecorder) OperatorPoint(ctx, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OperatorPoint", reflect.TypeOf((*MockModuleInterface)(nil).OperatorPoint), ctx, operator)
+}
+
+// PickupPoints mocks base method.
+func (m *MockModuleInterface) PickupPoints(ctx context.Context) ([]models.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PickupPoints", ctx)
+	ret0, _ := ret[0].([]models.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PickupPoints indicates an expected call of PickupPoints.
+func (mr *MockModuleInterfaceMockRecorder) PickupPoints(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefunds", reflect.TypeOf((*MockModuleInterface)(nil).GetRefunds), page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PickupPoints", reflect.TypeOf((*MockModuleInterface)(nil).PickupPoints), ctx)
 }
 
 // ReceiveOrders mocks base method.
-func (m *MockModuleInterface) ReceiveOrders(ordersId []models.ID) ([]models.Order, error) {
+func (m *MockModuleInterface) ReceiveOrders(ctx context.Context, ordersId []models.ID, code string, declined []models.ID, operator models.Operator) ([]models.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReceiveOrders", ordersId)
+	ret := m.ctrl.Call(m, "ReceiveOrders", ctx, ordersId, code, declined, operator)
 	ret0, _ := ret[0].([]models.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReceiveOrders indicates an expected call of ReceiveOrders.
-func (mr *MockModuleInterfaceMockRecorder) ReceiveOrders(ordersId interface{}) *gomock.Call {
+func (mr *MockModuleInterfaceMockRecorder) ReceiveOrders(ctx, ordersId, code, declined, operator interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveOrders", reflect.TypeOf((*MockModuleInterface)(nil).ReceiveOrders), ordersId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveOrders", reflect.TypeOf((*MockModuleInterface)(nil).ReceiveOrders), ctx, ordersId, code, declined, operator)
 }
 
 // RefundOrder mocks base method.
-func (m *MockModuleInterface) RefundOrder(customerId, orderId models.ID) error {
+func (m *MockModuleInterface) RefundOrder(ctx context.Context, customerId, orderId models.ID, reason models.RefundReason, comment string, itemsId []models.ID, operator models.Operator) (models.Refund, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefundOrder", customerId, orderId)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "RefundOrder", ctx, customerId, orderId, reason, comment, itemsId, operator)
+	ret0, _ := ret[0].(models.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // RefundOrder indicates an expected call of RefundOrder.
-func (mr *MockModuleInterfaceMockRecorder) RefundOrder(customerId, orderId interface{}) *gomock.Call {
+func (mr *MockModuleInterfaceMockRecorder) RefundOrder(ctx, customerId, orderId, reason, comment, itemsId, operator interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundOrder", reflect.TypeOf((*MockModuleInterface)(nil).RefundOrder), customerId, orderId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundOrder", reflect.TypeOf((*MockModuleInterface)(nil).RefundOrder), ctx, customerId, orderId, reason, comment, itemsId, operator)
+}
+
+// ReleaseTryOns mocks base method.
+func (m *MockModuleInterface) ReleaseTryOns(ctx context.Context, limit int, operator models.Operator) ([]models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseTryOns", ctx, limit, operator)
+	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseTryOns indicates an expected call of ReleaseTryOns.
+func (mr *MockModuleInterfaceMockRecorder) ReleaseTryOns(ctx, limit, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseTryOns", reflect.TypeOf((*MockModuleInterface)(nil).ReleaseTryOns), ctx, limit, operator)
+}
+
+// ResolveOrderID mocks base method.
+func (m *MockModuleInterface) ResolveOrderID(ctx context.Context, ref models.ExternalRef) (models.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveOrderID", ctx, ref)
+	ret0, _ := ret[0].(models.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveOrderID indicates an expected call of ResolveOrderID.
+func (mr *MockModuleInterfaceMockRecorder) ResolveOrderID(ctx, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveOrderID", reflect.TypeOf((*MockModuleInterface)(nil).ResolveOrderID), ctx, ref)
+}
+
+// RetirePackage mocks base method.
+func (m *MockModuleInterface) RetirePackage(ctx context.Context, pack models.PackageType) (models.PackageSpec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetirePackage", ctx, pack)
+	ret0, _ := ret[0].(models.PackageSpec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetirePackage indicates an expected call of RetirePackage.
+func (mr *MockModuleInterfaceMockRecorder) RetirePackage(ctx, pack interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetirePackage", reflect.TypeOf((*MockModuleInterface)(nil).RetirePackage), ctx, pack)
 }
 
 // ReturnOrder mocks base method.
-func (m *MockModuleInterface) ReturnOrder(id models.ID) (models.Order, error) {
+func (m *MockModuleInterface) ReturnOrder(ctx context.Context, id models.ID, operator models.Operator) (models.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReturnOrder", id)
+	ret := m.ctrl.Call(m, "ReturnOrder", ctx, id, operator)
 	ret0, _ := ret[0].(models.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReturnOrder indicates an expected call of ReturnOrder.
-func (mr *MockModuleInterfaceMockRecorder) ReturnOrder(id interface{}) *gomock.Call {
+func (mr *MockModuleInterfaceMockRecorder) ReturnOrder(ctx, id, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrder", reflect.TypeOf((*MockModuleInterface)(nil).ReturnOrder), ctx, id, operator)
+}
+
+// SeedPackages mocks base method.
+func (m *MockModuleInterface) SeedPackages(ctx context.Context, specs []models.PackageSpec) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeedPackages", ctx, specs)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeedPackages indicates an expected call of SeedPackages.
+func (mr *MockModuleInterfaceMockRecorder) SeedPackages(ctx, specs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeedPackages", reflect.TypeOf((*MockModuleInterface)(nil).SeedPackages), ctx, specs)
+}
+
+// SeedPickupPoints mocks base method.
+func (m *MockModuleInterface) SeedPickupPoints(ctx context.Context, points []models.PickupPoint) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeedPickupPoints", ctx, points)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeedPickupPoints indicates an expected call of SeedPickupPoints.
+func (mr *MockModuleInterfaceMockRecorder) SeedPickupPoints(ctx, points interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeedPickupPoints", reflect.TypeOf((*MockModuleInterface)(nil).SeedPickupPoints), ctx, points)
+}
+
+// StartTryOn mocks base method.
+func (m *MockModuleInterface) StartTryOn(ctx context.Context, ordersId []models.ID, code string, operator models.Operator) ([]models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTryOn", ctx, ordersId, code, operator)
+	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTryOn indicates an expected call of StartTryOn.
+func (mr *MockModuleInterfaceMockRecorder) StartTryOn(ctx, ordersId, code, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTryOn", reflect.TypeOf((*MockModuleInterface)(nil).StartTryOn), ctx, ordersId, code, operator)
+}
+
+// UpdatePackage mocks base method.
+func (m *MockModuleInterface) UpdatePackage(ctx context.Context, spec models.PackageSpec) (models.PackageSpec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePackage", ctx, spec)
+	ret0, _ := ret[0].(models.PackageSpec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePackage indicates an expected call of UpdatePackage.
+func (mr *MockModuleInterfaceMockRecorder) UpdatePackage(ctx, spec interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrder", reflect.TypeOf((*MockModuleInterface)(nil).ReturnOrder), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePackage", reflect.TypeOf((*MockModuleInterface)(nil).UpdatePackage), ctx, spec)
 }
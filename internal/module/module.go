@@ -1,24 +1,45 @@
 package module
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"github.com/opentracing/opentracing-go"
 	"homework-1/internal/models"
-	"homework-1/internal/services/packaging"
+	"homework-1/internal/services/pickupcode"
+	"homework-1/internal/services/pickuppoint"
+	"homework-1/internal/services/policy"
+	"homework-1/internal/services/shelving"
 	"homework-1/internal/storage"
 	"time"
 )
 
 var (
 	ErrWrongExpiration = errors.New("wrong expiration date")
+	ErrNoPackage       = errors.New("no package in the catalog fits the order")
+	ErrStoragePeriod   = errors.New("expiration date is beyond the storage period allowed at this pickup point")
 	ErrReturn          = errors.New("can not delete this order. this order might be already received or expiration date is not passed")
-	ErrRefund          = errors.New("can not refund this order. make sure it is yours, you received it and refund time (2 days) has not passed")
+	ErrRefund          = errors.New("can not refund this order. make sure it is yours, you received it and refund window has not passed")
 	ErrPagination      = errors.New("page is out of range")
-	errReceive         = errors.New("can not receive other orders. one of them probably has not belong to customer or already received or expiration time has passed")
+	ErrReceive         = errors.New("can not receive other orders. one of them probably has not belong to customer or already received or expiration time has passed")
+	ErrHistoryNotFound = errors.New("no history found for this order")
+	ErrExternalRef     = errors.New("external order reference must have both source and number")
+	ErrPageToken       = errors.New("page token does not match the query")
+	ErrPickupCode      = errors.New("wrong pickup code")
+	ErrPickupLocked    = errors.New("too many wrong pickup codes, pickup is temporarily locked")
 )
 
+// Deps Если Policy не задан, действуют встроенные правила policy.Default.
+// Если не заданы PickupCodes, коды выдачи не выпускаются и не проверяются.
+// Если не задан TryOnTimeout, примерка длится defaultTryOnTimeout.
+// Если не задан Points, сервер работает как один пункт PickupPoint, иначе настройки берутся у пункта запроса.
 type Deps struct {
-	Storage storage.Storage
+	Storage      storage.Storage
+	PickupPoint  pickuppoint.Point
+	Points       pickuppoint.Provider
+	Policy       policy.Provider
+	PickupCodes  *pickupcode.Codes
+	TryOnTimeout time.Duration
 }
 
 type Module struct {
@@ -29,134 +50,510 @@ func NewModule(d Deps) *Module {
 	return &Module{Deps: d}
 }
 
-func (m *Module) AddOrder(orderId models.ID, customerId models.ID, expirationTime time.Time, pack models.PackageType, weight models.Kilo, cost models.Rub) error {
-	if expirationTime.Before(time.Now()) {
-		return ErrWrongExpiration
+// AddOrder Принимает заказ под внешним номером продавца и возвращает назначенный сервисом идентификатор.
+// Упаковка pack перечисляет слои от внутреннего к внешнему, например коробку и пленку поверх нее.
+// Для упаковки auto выбирается самая дешевая подходящая по весу и размеру size, как ее предложил бы GetQuote.
+// Если переданы товары items, стоимость заказа складывается из их цен.
+// Повторный прием того же внешнего номера отклоняется хранилищем с ErrOrderExists.
+// Если у пункта есть ячейки хранения, заказ раскладывается в ячейку по правилам shelving.Choose.
+// Возвращается принятый заказ с назначенными идентификатором и ячейкой.
+func (m *Module) AddOrder(ctx context.Context, ref models.ExternalRef, customerId models.ID, expirationTime time.Time, pack models.Packaging, weight models.Kilo, size models.Dimensions, cost models.Money, items []models.OrderItem, operator models.Operator) (models.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.AddOrder")
+	defer span.Finish()
+
+	if ref.Source == "" || ref.Number == "" {
+		return models.Order{}, fmt.Errorf("module.AddOrder error: %w", ErrExternalRef)
 	}
 
-	p, errParse := packaging.ParsePackage(pack)
-	if errParse != nil {
-		return fmt.Errorf("module.AddOrder error: %w", errParse)
+	point, errPoint := pickuppoint.Current(ctx, m.Points, m.PickupPoint)
+	if errPoint != nil {
+		return models.Order{}, fmt.Errorf("module.AddOrder error: %w", errPoint)
 	}
 
-	if errWeight := p.ValidateWeight(weight); errWeight != nil {
-		return fmt.Errorf("module.AddOrder error: %w", errWeight)
+	// Заказ хранится до конца рабочего дня пункта в указанную дату, а не до произвольного момента.
+	expirationTime = point.EndOfBusinessDay(expirationTime)
+
+	now := time.Now()
+	if expirationTime.Before(now) {
+		return models.Order{}, ErrWrongExpiration
+	}
+
+	items, cost, errItems := newItems(items, cost)
+	if errItems != nil {
+		return models.Order{}, fmt.Errorf("module.AddOrder error: %w", errItems)
 	}
 
-	fromDb, errGetOrder := m.Storage.GetOrder(orderId)
-	if errGetOrder != nil {
-		return fmt.Errorf("module.AddOrder error: %w", errGetOrder)
+	if pack.Auto() {
+		cheapest, errChoose := m.cheapestPackage(ctx, weight, size, cost)
+		if errChoose != nil {
+			return models.Order{}, fmt.Errorf("module.AddOrder error: %w", errChoose)
+		}
+		pack = cheapest
+	}
+
+	p, errPackage := m.getPackage(ctx, pack)
+	if errPackage != nil {
+		return models.Order{}, fmt.Errorf("module.AddOrder error: %w", errPackage)
 	}
-	if fromDb.OrderID == orderId {
-		return fmt.Errorf("module.AddOrder error: %w", storage.ErrOrderExists)
+
+	rules := m.rules(ctx, pack)
+	if rules.MaxStorage > 0 && expirationTime.After(point.EndOfBusinessDay(now.Add(rules.MaxStorage))) {
+		return models.Order{}, fmt.Errorf("module.AddOrder error: %w: at most %s", ErrStoragePeriod, rules.MaxStorage)
+	}
+
+	if errWeight := p.ValidateWeight(weight); errWeight != nil {
+		return models.Order{}, fmt.Errorf("module.AddOrder error: %w", errWeight)
+	}
+	if errWeight := rules.ValidateWeight(weight); errWeight != nil {
+		return models.Order{}, fmt.Errorf("module.AddOrder error: %w", errWeight)
+	}
+	if errSize := p.ValidateSize(size); errSize != nil {
+		return models.Order{}, fmt.Errorf("module.AddOrder error: %w", errSize)
+	}
+
+	if _, errCost := cost.Add(p.GetCost()); errCost != nil {
+		return models.Order{}, fmt.Errorf("module.AddOrder error: %w", errCost)
 	}
 
 	order := models.Order{
-		OrderID:            orderId,
+		External:           ref,
 		CustomerID:         customerId,
 		ExpirationTime:     expirationTime,
 		ReceivedTime:       time.Time{},
 		ReceivedByCustomer: false,
 		Refunded:           false,
+		Status:             models.StatusAccepted,
 		Package:            pack,
 		Weight:             weight,
 		Cost:               cost,
 		PackageCost:        p.GetCost(),
+		Items:              items,
 	}
 
-	return m.Storage.AddOrder(order)
+	event := newEvent(models.EventOrderAdded, order, models.StatusChange{
+		To:        models.StatusAccepted,
+		Reason:    reasonAccepted,
+		Operator:  operator,
+		ChangedAt: now,
+	})
+
+	// Код выпускается для каждого заказа, но хранилище сохранит его, только если у клиента еще нет действующего.
+	var code models.PickupCode
+	if m.PickupCodes != nil {
+		plain, issued, errCode := m.PickupCodes.Generate(customerId, now)
+		if errCode != nil {
+			return models.Order{}, fmt.Errorf("module.AddOrder error: %w", errCode)
+		}
+		event.PickupCode, code = plain, issued
+	}
+
+	var cell models.CellCode
+	place := func(cells []models.CellLoad) (models.CellCode, error) {
+		chosen, errChoose := shelving.Choose(cells, shelving.Parcel{Weight: weight, Size: p.Dimensions()})
+		cell = chosen
+		return chosen, errChoose
+	}
+
+	// Идентификатор заказа в событии и истории проставит хранилище.
+	orderId, errAdd := m.Storage.AddOrder(ctx, order, event, code, place)
+	if errAdd != nil {
+		return models.Order{}, fmt.Errorf("module.AddOrder error: %w", errAdd)
+	}
+
+	order.OrderID, order.Cell = orderId, cell
+	return order, nil
 }
 
-func (m *Module) ReturnOrder(id models.ID) (models.Order, error) {
-	order, errGet := m.Storage.GetOrder(id)
+// rules Правила пункта запроса для заказа в упаковке pack. Правила запрашиваются заново при каждой операции,
+// чтобы перезагрузка конфигурации применялась без перезапуска.
+func (m *Module) rules(ctx context.Context, pack models.Packaging) policy.Rules {
+	point := m.PickupPoint.ID
+	if m.Points != nil {
+		point = string(models.PointFromContext(ctx))
+	}
+
+	if m.Policy == nil {
+		return policy.ForPackaging(policy.Default(), point, pack)
+	}
+	return policy.ForPackaging(m.Policy, point, pack)
+}
+
+// ResolveOrderID Возвращает идентификатор заказа по внешнему номеру продавца.
+func (m *Module) ResolveOrderID(ctx context.Context, ref models.ExternalRef) (models.ID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.ResolveOrderID")
+	defer span.Finish()
+
+	if ref.Source == "" || ref.Number == "" {
+		return 0, fmt.Errorf("module.ResolveOrderID error: %w", ErrExternalRef)
+	}
+
+	orderId, errResolve := m.Storage.ResolveOrderID(ctx, ref)
+	if errResolve != nil {
+		return 0, fmt.Errorf("module.ResolveOrderID error: %w", errResolve)
+	}
+
+	return orderId, nil
+}
+
+// ReturnOrder Отдает заказ курьеру. Если заказ вернул клиент, его принятые возвраты переходят в этап передачи курьеру.
+// Если заказ остается у клиента, курьеру отдаются только отказные и возвращенные товары заказа.
+func (m *Module) ReturnOrder(ctx context.Context, id models.ID, operator models.Operator) (models.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.ReturnOrder")
+	defer span.Finish()
+
+	order, errGet := m.Storage.GetOrder(ctx, id)
 	if errGet != nil {
 		return models.Order{}, fmt.Errorf("storage.ReturnOrder error: %w", errGet)
 	}
 
-	if order.ReceivedByCustomer && order.ExpirationTime.Before(time.Now()) {
-		return m.Storage.ReturnOrder(id)
+	if order.OrderID != id {
+		return models.Order{}, fmt.Errorf("storage.ReturnOrder error: %w", storage.ErrOrderNotFound)
+	}
+
+	now := time.Now()
+	// Просрочка, которую еще не записала фоновая проверка, записывается перед возвратом, иначе в истории
+	// возврат начнется из статуса, в который заказ никогда не переходил.
+	if _, _, overdue := expire(order, operator, now); overdue {
+		if _, errExpire := m.expireOrders(ctx, []models.ID{id}, operator, now); errExpire != nil {
+			return models.Order{}, fmt.Errorf("storage.ReturnOrder error: %w", errExpire)
+		}
+		order.Status = models.StatusExpired
+	}
+
+	returned, change, errTransit := transit(returnItems(order), models.StatusReturnedToCourier, reasonReturned, operator, now)
+	if errTransit != nil {
+		if len(order.ItemsIn(models.ItemDeclined, models.ItemRefunded)) == 0 {
+			return models.Order{}, fmt.Errorf("storage.ReturnOrder error: %w: %w", ErrReturn, errTransit)
+		}
+		return m.returnItems(ctx, order, operator, now)
+	}
+
+	handed, errHanded := m.handRefunds(ctx, order, operator, now)
+	if errHanded != nil {
+		return models.Order{}, fmt.Errorf("storage.ReturnOrder error: %w", errHanded)
+	}
+
+	returnedOrder, errReturn := m.Storage.ReturnOrder(ctx, newEvent(models.EventOrderReturned, returned, change), handed)
+	if errReturn != nil {
+		return models.Order{}, errReturn
 	}
 
-	return models.Order{}, fmt.Errorf("storage.ReturnOrder error: %w", ErrReturn)
+	return returnedOrder, nil
 }
 
-func (m *Module) ReceiveOrders(ordersId []models.ID) ([]models.Order, error) {
-	order, errGetOrder := m.Storage.GetOrder(ordersId[0])
-	if errGetOrder != nil {
-		return nil, fmt.Errorf("storage.ReceiveOrders error: %w", errGetOrder)
+// returnItems Отдает курьеру отказные и возвращенные товары заказа, который остается у клиента.
+// Статус заказа не меняется, но передача попадает в историю заказа.
+func (m *Module) returnItems(ctx context.Context, order models.Order, operator models.Operator, now time.Time) (models.Order, error) {
+	handed, errHanded := m.handRefunds(ctx, order, operator, now)
+	if errHanded != nil {
+		return models.Order{}, fmt.Errorf("module.returnItems error: %w", errHanded)
+	}
+
+	returned := returnItems(order)
+	change := models.StatusChange{
+		OrderID:   order.OrderID,
+		From:      order.Status,
+		To:        order.Status,
+		Reason:    reasonItemsReturned,
+		Operator:  operator,
+		ChangedAt: now,
+	}
+
+	if errReturn := m.Storage.ReturnItems(ctx, newEvent(models.EventItemsReturned, returned, change), handed); errReturn != nil {
+		return models.Order{}, fmt.Errorf("module.returnItems error: %w", errReturn)
+	}
+
+	return returned, nil
+}
+
+// handRefunds Переходы принятых возвратов заказа в этап передачи курьеру. Принятые возвраты есть только
+// у возвращенного заказа или у заказа с возвращенными товарами.
+func (m *Module) handRefunds(ctx context.Context, order models.Order, operator models.Operator, now time.Time) ([]models.RefundChange, error) {
+	if order.Status != models.StatusRefunded && len(order.ItemsIn(models.ItemRefunded)) == 0 {
+		return nil, nil
+	}
+
+	refunds, errRefunds := m.Storage.GetOrderRefunds(ctx, order.OrderID, models.RefundApproved)
+	if errRefunds != nil {
+		return nil, errRefunds
+	}
+	if len(refunds) == 0 {
+		return nil, storage.ErrRefundNotFound
+	}
+
+	handed := make([]models.RefundChange, 0, len(refunds))
+	for _, refund := range refunds {
+		_, refundChange, errRefundTransit := refundTransit(refund, models.RefundHandedToCourier, reasonReturned, operator, now)
+		if errRefundTransit != nil {
+			return nil, fmt.Errorf("%w: %w", ErrReturn, errRefundTransit)
+		}
+		handed = append(handed, refundChange)
+	}
+
+	return handed, nil
+}
+
+// ReceiveOrders Выдает покупателю пачку заказов в одной транзакции: либо выдаются все заказы, либо ни один.
+// Заказы блокируются на время проверки, поэтому один и тот же заказ нельзя выдать одновременно с двух касс.
+// Покупатель подтверждает выдачу кодом, который получил при приеме заказов. Код одноразовый: он гасится в транзакции
+// выдачи, а на оставшиеся в пункте заказы клиенту приходит новый код.
+// Товары из declined клиент не забирает, остальные товары заказов выдаются. Заказ, от всех товаров которого
+// клиент отказался, не считается выданным и ждет возврата курьеру в своей ячейке.
+// У выданных заказов ячейка освобождается, а в PickedFrom возвращается ячейка, из которой заказ нужно взять.
+func (m *Module) ReceiveOrders(ctx context.Context, ordersId []models.ID, code string, declined []models.ID, operator models.Operator) ([]models.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.ReceiveOrders")
+	defer span.Finish()
+
+	used, errVerify := m.verifyReceiver(ctx, ordersId, code)
+	if errVerify != nil {
+		return nil, fmt.Errorf("module.ReceiveOrders error: %w", errVerify)
 	}
 
-	customerId := order.CustomerID
 	var received []models.Order
-	for _, orderId := range ordersId {
-		if toReceive, errGet := m.Storage.GetOrder(orderId); errGet != nil || toReceive.ExpirationTime.Before(time.Now()) ||
-			toReceive.ReceivedByCustomer || toReceive.CustomerID != customerId {
-			return nil, fmt.Errorf("storage.ReceiveOrders error: %w", errReceive)
+	errChange := m.handOver(ctx, ordersId, used, func(orders []models.Order) ([]models.OrderEvent, error) {
+		batch, errBatch := m.receiverBatch(orders, ordersId, used.CustomerID)
+		if errBatch != nil {
+			return nil, errBatch
+		}
+
+		now := time.Now()
+		declinedItems := idSet(declined)
+
+		received = make([]models.Order, 0, len(batch))
+		events := make([]models.OrderEvent, 0, len(batch))
+		for _, order := range batch {
+			to, reason, eventType := models.StatusIssued, reasonIssued, models.EventOrderReceived
+			toReceive, allDeclined := receiveItems(order, declinedItems)
+			if allDeclined {
+				to, reason, eventType = models.StatusDeclined, reasonDeclined, models.EventOrderDeclined
+			}
+
+			receivedOrder, change, errTransit := transit(toReceive, to, reason, operator, now)
+			if errTransit != nil {
+				return nil, fmt.Errorf("%w: %w", ErrReceive, errTransit)
+			}
+			receivedOrder.PickedFrom = order.Cell
+
+			received = append(received, receivedOrder)
+			events = append(events, newEvent(eventType, receivedOrder, change))
 		}
 
-		receivedOrder, errRec := m.Storage.ReceiveOrder(orderId)
-		if errRec != nil {
-			return nil, fmt.Errorf("storage.ReceiveOrders error: %w", errRec)
+		if len(declinedItems) > 0 {
+			return nil, fmt.Errorf("%w: declined items are not in the received orders", ErrItems)
 		}
 
-		received = append(received, receivedOrder)
+		return events, nil
+	})
+	if errChange != nil {
+		return nil, fmt.Errorf("module.ReceiveOrders error: %w", errChange)
 	}
 
 	return received, nil
 }
 
-func (m *Module) GetOrders(customerId models.ID, n int) ([]models.Order, error) {
-	orders, errGet := m.Storage.GetCustomersOrders(customerId)
+// handOver Меняет статусы выдаваемых клиенту заказов. С кодами выдачи код used гасится в той же транзакции,
+// а если клиенту еще есть что забирать, ему выпускается новый код: он уходит в уведомление с последним событием пачки.
+func (m *Module) handOver(ctx context.Context, ordersId []models.ID, used models.PickupCode, change func(orders []models.Order) ([]models.OrderEvent, error)) error {
+	if m.PickupCodes == nil {
+		return m.Storage.ChangeStatuses(ctx, ordersId, change)
+	}
+
+	plain, next, errGenerate := m.PickupCodes.Generate(used.CustomerID, time.Now())
+	if errGenerate != nil {
+		return errGenerate
+	}
+
+	errHandOver := m.Storage.HandOverOrders(ctx, ordersId, used, next, func(orders []models.Order) ([]models.OrderEvent, error) {
+		events, errChange := change(orders)
+		if errChange != nil || len(events) == 0 {
+			return events, errChange
+		}
+		events[len(events)-1].PickupCode = plain
+		return events, nil
+	})
+	if errors.Is(errHandOver, storage.ErrPickupCodeUsed) {
+		return fmt.Errorf("%w: %w", ErrPickupCode, errHandOver)
+	}
+
+	return errHandOver
+}
+
+// verifyReceiver Проверяет код выдачи клиента, которому принадлежит первый заказ пачки, и возвращает проверенный код.
+// Без PickupCodes код не проверяется.
+func (m *Module) verifyReceiver(ctx context.Context, ordersId []models.ID, code string) (models.PickupCode, error) {
+	if len(ordersId) == 0 {
+		return models.PickupCode{}, ErrReceive
+	}
+	if m.PickupCodes == nil {
+		return models.PickupCode{}, nil
+	}
+
+	first, errGet := m.Storage.GetOrder(ctx, ordersId[0])
 	if errGet != nil {
-		return nil, fmt.Errorf("storage.GetOrders error: %w", errGet)
+		return models.PickupCode{}, errGet
+	}
+	// Хранилище возвращает для несуществующего заказа пустой заказ без ошибки, и код проверялся бы
+	// для клиента 0: клиент получил бы неверную причину отказа.
+	if first.OrderID != ordersId[0] {
+		return models.PickupCode{}, ErrReceive
+	}
+
+	return m.verifyPickupCode(ctx, first.CustomerID, code, time.Now())
+}
+
+// receiverBatch Заблокированные заказы пачки в порядке ordersId. Пачка выдается, только если найдены все заказы
+// и все они принадлежат одному клиенту, а при проверке кодов - клиенту, который подтвердил код.
+func (m *Module) receiverBatch(orders []models.Order, ordersId []models.ID, verifiedCustomer models.ID) ([]models.Order, error) {
+	batch, errBatch := customerBatch(orders, ordersId)
+	if errBatch != nil {
+		return nil, ErrReceive
+	}
+	if m.PickupCodes != nil && batch[0].CustomerID != verifiedCustomer {
+		return nil, ErrReceive
+	}
+	return batch, nil
+}
+
+// customerBatch Раскладывает заказы в порядке ordersId и проверяет, что найдены все заказы и все они одного клиента.
+func customerBatch(orders []models.Order, ordersId []models.ID) ([]models.Order, error) {
+	if len(ordersId) == 0 || len(orders) != len(ordersId) {
+		return nil, storage.ErrOrderNotFound
+	}
+
+	byId := make(map[models.ID]models.Order, len(orders))
+	for _, order := range orders {
+		byId[order.OrderID] = order
 	}
 
-	if n <= 0 {
-		return orders, nil
+	customerId := byId[ordersId[0]].CustomerID
+	batch := make([]models.Order, 0, len(ordersId))
+	for _, orderId := range ordersId {
+		order, ok := byId[orderId]
+		if !ok {
+			return nil, storage.ErrOrderNotFound
+		}
+		if order.CustomerID != customerId {
+			return nil, fmt.Errorf("order %d belongs to another customer", orderId)
+		}
+		batch = append(batch, order)
 	}
 
-	if n > len(orders) {
-		n = len(orders)
+	return batch, nil
+}
+
+// verifyPickupCode Проверяет код выдачи клиента. Неверный код засчитывается, и после нескольких ошибок подряд
+// выдача блокируется даже с верным кодом, чтобы код нельзя было подобрать перебором.
+func (m *Module) verifyPickupCode(ctx context.Context, customerId models.ID, code string, now time.Time) (models.PickupCode, error) {
+	stored, errGet := m.Storage.GetPickupCode(ctx, customerId)
+	if errGet != nil {
+		if errors.Is(errGet, storage.ErrPickupCodeNotFound) {
+			return models.PickupCode{}, ErrPickupCode
+		}
+		return models.PickupCode{}, errGet
+	}
+	if stored.Locked(now) {
+		return models.PickupCode{}, fmt.Errorf("%w until %s", ErrPickupLocked, stored.LockedUntil.Format(time.DateTime))
 	}
 
-	return orders[:n], nil
+	if m.PickupCodes.Verify(stored, code) {
+		return stored, nil
+	}
 
+	failed, errRecord := m.Storage.RecordPickupFailure(ctx, customerId, m.PickupCodes.MaxAttempts(), m.PickupCodes.LockedUntil(now))
+	if errRecord != nil {
+		return models.PickupCode{}, errRecord
+	}
+	if failed.Locked(now) {
+		return models.PickupCode{}, fmt.Errorf("%w until %s", ErrPickupLocked, failed.LockedUntil.Format(time.DateTime))
+	}
+	return models.PickupCode{}, fmt.Errorf("%w: %d attempts left", ErrPickupCode, m.PickupCodes.MaxAttempts()-failed.FailedAttempts)
 }
 
-func (m *Module) RefundOrder(customerId models.ID, orderId models.ID) error {
-	order, errGet := m.Storage.GetOrder(orderId)
+// GetOrders Запрашивает у хранилища на один заказ больше страницы: по лишнему заказу видно, что есть следующая страница.
+// Курсор действует только с той же сортировкой, с которой получен.
+func (m *Module) GetOrders(ctx context.Context, query models.OrdersQuery) (models.OrdersPage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.GetOrders")
+	defer span.Finish()
+
+	if query.Sort == "" {
+		query.Sort = models.SortByExpiration
+	}
+	if query.After != nil && (query.After.Sort != query.Sort || query.After.Descending != query.Descending) {
+		return models.OrdersPage{}, fmt.Errorf("module.GetOrders error: %w", ErrPageToken)
+	}
+
+	limit := query.Limit
+	if limit > 0 {
+		query.Limit = limit + 1
+	}
+
+	orders, errGet := m.Storage.GetCustomersOrders(ctx, query)
 	if errGet != nil {
-		return fmt.Errorf("storage.ReturnOrder error: %w", errGet)
+		return models.OrdersPage{}, fmt.Errorf("module.GetOrders error: %w", errGet)
 	}
 
-	if order.CustomerID != customerId || !order.ReceivedByCustomer || order.Refunded || order.ReceivedTime.Add(time.Hour*24*2).Before(time.Now()) {
-		return fmt.Errorf("storage.CreateRefund error: %w", ErrRefund)
+	page := models.OrdersPage{Orders: orders}
+	if limit > 0 && len(orders) > limit {
+		page.Orders = orders[:limit]
+		last := page.Orders[limit-1]
+		page.Next = &models.OrdersCursor{
+			Sort:       query.Sort,
+			Descending: query.Descending,
+			Value:      last.SortValue(query.Sort),
+			OrderID:    last.OrderID,
+		}
 	}
 
-	order.Refunded = true
-	return m.Storage.ChangeOrder(order)
+	return page, nil
 }
 
-func (m *Module) GetRefunds(page int, limit int) ([]models.Order, error) {
-	refunds, errGet := m.Storage.GetRefunds()
+// GetRefunds Листает возвраты курсором. Offset учитывается только без курсора и выходит за диапазон, если больше числа возвратов.
+func (m *Module) GetRefunds(ctx context.Context, query models.RefundsQuery) (models.RefundsPage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.GetRefunds")
+	defer span.Finish()
+
+	if query.After != nil {
+		query.Offset = 0
+	}
+
+	limit := query.Limit
+	if limit > 0 {
+		query.Limit = limit + 1
+	}
+
+	refunds, total, errGet := m.Storage.GetRefunds(ctx, query)
 	if errGet != nil {
-		return nil, fmt.Errorf("storage.GetRefunds error: %w", errGet)
+		return models.RefundsPage{}, fmt.Errorf("module.GetRefunds error: %w", errGet)
 	}
 
-	if limit <= 0 {
-		return refunds, nil
+	if query.Offset > total {
+		return models.RefundsPage{}, fmt.Errorf("module.GetRefunds error: %w", ErrPagination)
 	}
 
-	start := page * limit
-	end := start + limit
+	page := models.RefundsPage{Refunds: refunds, Total: total}
+	if limit > 0 && len(refunds) > limit {
+		page.Refunds = refunds[:limit]
+		last := page.Refunds[limit-1]
+		page.Next = &models.RefundsCursor{RefundedTime: last.RefundedTime, OrderID: last.OrderID}
+	}
 
-	if start > len(refunds) {
-		return nil, fmt.Errorf("storage.GetRefunds error: %w", ErrPagination)
+	return page, nil
+}
+
+// GetOrderHistory Возвращает все переходы статусов заказа. История хранится отдельно от заказа,
+// поэтому доступна и после возврата заказа курьеру.
+func (m *Module) GetOrderHistory(ctx context.Context, orderId models.ID) ([]models.StatusChange, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.GetOrderHistory")
+	defer span.Finish()
+
+	history, errGet := m.Storage.GetStatusHistory(ctx, orderId)
+	if errGet != nil {
+		return nil, fmt.Errorf("module.GetOrderHistory error: %w", errGet)
 	}
 
-	if end > len(refunds) {
-		end = len(refunds)
+	if len(history) == 0 {
+		return nil, fmt.Errorf("module.GetOrderHistory error: %w", ErrHistoryNotFound)
 	}
 
-	return refunds[start:end], nil
+	return history, nil
 }
@@ -3,15 +3,34 @@
 package module
 
 import (
+	"context"
 	"homework-1/internal/models"
 	"time"
 )
 
 type ModuleInterface interface {
-	AddOrder(orderId models.ID, customerId models.ID, expirationTime time.Time, pack models.PackageType, weight models.Kilo, cost models.Rub) error
-	ReturnOrder(id models.ID) (models.Order, error)
-	ReceiveOrders(ordersId []models.ID) ([]models.Order, error)
-	GetOrders(customerId models.ID, n int) ([]models.Order, error)
-	RefundOrder(customerId models.ID, orderId models.ID) error
-	GetRefunds(page int, limit int) ([]models.Order, error)
+	AddOrder(ctx context.Context, ref models.ExternalRef, customerId models.ID, expirationTime time.Time, pack models.Packaging, weight models.Kilo, size models.Dimensions, cost models.Money, items []models.OrderItem, operator models.Operator) (models.Order, error)
+	ResolveOrderID(ctx context.Context, ref models.ExternalRef) (models.ID, error)
+	ReturnOrder(ctx context.Context, id models.ID, operator models.Operator) (models.Order, error)
+	ReceiveOrders(ctx context.Context, ordersId []models.ID, code string, declined []models.ID, operator models.Operator) ([]models.Order, error)
+	StartTryOn(ctx context.Context, ordersId []models.ID, code string, operator models.Operator) ([]models.Order, error)
+	ConfirmTryOn(ctx context.Context, decisions []models.TryOnDecision, declined []models.ID, operator models.Operator) ([]models.Order, error)
+	ReleaseTryOns(ctx context.Context, limit int, operator models.Operator) ([]models.Order, error)
+	ExpireOrders(ctx context.Context, limit int, operator models.Operator) ([]models.Order, error)
+	GetOrders(ctx context.Context, query models.OrdersQuery) (models.OrdersPage, error)
+	RefundOrder(ctx context.Context, customerId models.ID, orderId models.ID, reason models.RefundReason, comment string, itemsId []models.ID, operator models.Operator) (models.Refund, error)
+	DecideRefund(ctx context.Context, refundId models.ID, decision models.RefundDecision, comment string, operator models.Operator) (models.Refund, error)
+	GetRefund(ctx context.Context, refundId models.ID) (models.Refund, []models.RefundChange, error)
+	GetRefunds(ctx context.Context, query models.RefundsQuery) (models.RefundsPage, error)
+	GetOrderHistory(ctx context.Context, orderId models.ID) ([]models.StatusChange, error)
+	AddPackage(ctx context.Context, spec models.PackageSpec) (models.PackageSpec, error)
+	UpdatePackage(ctx context.Context, spec models.PackageSpec) (models.PackageSpec, error)
+	RetirePackage(ctx context.Context, pack models.PackageType) (models.PackageSpec, error)
+	GetPackages(ctx context.Context, includeRetired bool) ([]models.PackageSpec, error)
+	GetQuote(ctx context.Context, weight models.Kilo, size models.Dimensions, cost models.Money) ([]models.Quote, error)
+	SeedPackages(ctx context.Context, specs []models.PackageSpec) (int, error)
+	SeedPickupPoints(ctx context.Context, points []models.PickupPoint) (int, error)
+	OperatorPoint(ctx context.Context, operator models.Operator) (models.PointID, error)
+	PickupPoints(ctx context.Context) ([]models.PickupPoint, error)
+	MoveOrder(ctx context.Context, id models.ID, cell models.CellCode, operator models.Operator) (models.Order, error)
 }
@@ -1,7 +1,13 @@
 package module
 
 import (
+	"context"
+	"fmt"
+	"homework-1/internal/config"
 	"homework-1/internal/services/packaging"
+	"homework-1/internal/services/pickupcode"
+	"homework-1/internal/services/pickuppoint"
+	"homework-1/internal/services/policy"
 	"homework-1/internal/storage"
 	mockstorage "homework-1/internal/storage/mocks"
 	"testing"
@@ -14,50 +20,177 @@ import (
 	"homework-1/internal/models"
 )
 
+const operator = models.Operator("operator")
+
+// fixedPolicy Одни и те же правила для любого пункта и упаковки.
+type fixedPolicy policy.Rules
+
+func (p fixedPolicy) Rules(string, models.PackageType) policy.Rules {
+	return policy.Rules(p)
+}
+
+// testCatalog Каталог упаковок, который создает миграция.
+var testCatalog = map[models.PackageType]models.PackageSpec{
+	"bag":      {Type: "bag", Kind: models.PackageKindContainer, Price: models.Rubles(5), MaxWeight: 10, AllowWrap: true},
+	"box":      {Type: "box", Kind: models.PackageKindContainer, Price: models.Rubles(20), MaxWeight: 30, AllowWrap: true},
+	"wrap":     {Type: "wrap", Kind: models.PackageKindWrapping, Price: models.Rubles(1)},
+	"pallet":   {Type: "pallet", Kind: models.PackageKindContainer, Price: models.Rubles(300), MinWeight: 50},
+	"envelope": {Type: "envelope", Kind: models.PackageKindContainer, Price: models.Rubles(2), RetiredAt: time.Now().Add(-time.Hour)},
+	"film":     {Type: "film", Kind: models.PackageKindWrapping, Price: models.Rubles(3), MaxWeight: 8},
+}
+
+func getTestPackage(_ context.Context, pack models.PackageType) (models.PackageSpec, error) {
+	spec, ok := testCatalog[pack]
+	if !ok {
+		return models.PackageSpec{}, storage.ErrPackageNotFound
+	}
+	return spec, nil
+}
+
 func TestModule_AddOrder(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStorage := mockstorage.NewMockStorage(ctrl)
+	mockStorage.EXPECT().GetPackage(gomock.Any(), gomock.Any()).DoAndReturn(getTestPackage).AnyTimes()
 	module := NewModule(Deps{Storage: mockStorage})
 
 	t.Run("Успешное добавление заказа", func(t *testing.T) {
 		t.Parallel()
 
-		orderID := models.ID(100)
+		ref := models.ExternalRef{Source: "marketplace", Number: "100"}
 		customerID := models.ID(100)
 		expirationTime := time.Now().Add(time.Hour)
-		pack := models.PackageType("box")
+		pack := models.Packaging{"box"}
 		weight := models.Kilo(10)
-		cost := models.Rub(100)
+		cost := models.Rubles(100)
 
-		mockStorage.EXPECT().GetOrder(orderID).Return(models.Order{}, nil)
-		mockStorage.EXPECT().AddOrder(gomock.Any()).Return(nil)
+		mockStorage.EXPECT().AddOrder(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, order models.Order, event models.OrderEvent, code models.PickupCode, place func(cells []models.CellLoad) (models.CellCode, error)) (models.ID, error) {
+				assert.Equal(t, ref, order.External)
+				assert.Zero(t, order.OrderID)
+				assert.Equal(t, models.Rubles(20), order.PackageCost)
+				return models.ID(7), nil
+			})
 
-		err := module.AddOrder(orderID, customerID, expirationTime, pack, weight, cost)
+		order, err := module.AddOrder(context.Background(), ref, customerID, expirationTime, pack, weight, models.Dimensions{}, cost, nil, operator)
 		require.NoError(t, err)
+		assert.Equal(t, models.ID(7), order.OrderID)
 	})
 
-	t.Run("Попытка добавить заказ с сущетсвующим ID", func(t *testing.T) {
+	t.Run("Попытка добавить заказ с сущетсвующим внешним номером", func(t *testing.T) {
 		t.Parallel()
 
-		orderID := models.ID(1)
-		customerID := models.ID(1)
+		ref := models.ExternalRef{Source: "marketplace", Number: "1"}
 		expirationTime := time.Now().Add(time.Hour)
-		pack := models.PackageType("box")
-		weight := models.Kilo(10)
-		cost := models.Rub(100)
 
-		existingOrder := models.Order{
-			OrderID:    orderID,
-			CustomerID: customerID,
+		mockStorage.EXPECT().AddOrder(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.ID(0), storage.ErrOrderExists)
+
+		_, err := module.AddOrder(context.Background(), ref, models.ID(1), expirationTime, models.Packaging{"box"}, models.Kilo(10), models.Dimensions{}, models.Rubles(100), nil, operator)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, storage.ErrOrderExists)
+	})
+
+	t.Run("Попытка добавить заказ без внешнего номера", func(t *testing.T) {
+		t.Parallel()
+
+		ref := models.ExternalRef{Source: "marketplace"}
+
+		_, err := module.AddOrder(context.Background(), ref, models.ID(1), time.Now().Add(time.Hour), models.Packaging{"box"}, models.Kilo(10), models.Dimensions{}, models.Rubles(100), nil, operator)
+		assert.ErrorIs(t, err, ErrExternalRef)
+	})
+
+	t.Run("Срок хранения истекает в конце рабочего дня пункта", func(t *testing.T) {
+		t.Parallel()
+
+		location, errLocation := time.LoadLocation("Asia/Vladivostok")
+		require.NoError(t, errLocation)
+		pointStorage := mockstorage.NewMockStorage(ctrl)
+		pointStorage.EXPECT().GetPackage(gomock.Any(), gomock.Any()).DoAndReturn(getTestPackage).AnyTimes()
+		pointModule := NewModule(Deps{Storage: pointStorage, PickupPoint: pickuppoint.Point{Location: location, ClosingTime: 21 * time.Hour}})
+
+		// Полночь UTC уже следующий день во Владивостоке, срок хранения переносится на 21:00 этого дня.
+		expirationDay := time.Now().AddDate(0, 0, 2)
+		expirationTime := time.Date(expirationDay.Year(), expirationDay.Month(), expirationDay.Day(), 20, 0, 0, 0, time.UTC)
+		localDay := expirationTime.In(location)
+		expected := time.Date(localDay.Year(), localDay.Month(), localDay.Day(), 21, 0, 0, 0, location)
+
+		pointStorage.EXPECT().AddOrder(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, order models.Order, event models.OrderEvent, code models.PickupCode, place func(cells []models.CellLoad) (models.CellCode, error)) (models.ID, error) {
+				assert.True(t, expected.Equal(order.ExpirationTime), "expiration %s, expected %s", order.ExpirationTime, expected)
+				return models.ID(3), nil
+			})
+
+		_, err := pointModule.AddOrder(context.Background(), models.ExternalRef{Source: "marketplace", Number: "3"}, models.ID(3), expirationTime, models.Packaging{"box"}, models.Kilo(1), models.Dimensions{}, models.Rubles(100), nil, operator)
+		require.NoError(t, err)
+	})
+
+	t.Run("Срок хранения длиннее разрешенного правилами", func(t *testing.T) {
+		t.Parallel()
+
+		policyModule := NewModule(Deps{Storage: mockStorage, Policy: fixedPolicy{MaxStorage: 7 * 24 * time.Hour, MaxWeight: 5}})
+		ref := models.ExternalRef{Source: "marketplace", Number: "4"}
+
+		_, err := policyModule.AddOrder(context.Background(), ref, models.ID(4), time.Now().AddDate(0, 0, 8), models.Packaging{"wrap"}, models.Kilo(1), models.Dimensions{}, models.Rubles(100), nil, operator)
+		assert.ErrorIs(t, err, ErrStoragePeriod)
+
+		_, err = policyModule.AddOrder(context.Background(), ref, models.ID(4), time.Now().AddDate(0, 0, 3), models.Packaging{"wrap"}, models.Kilo(5), models.Dimensions{}, models.Rubles(100), nil, operator)
+		assert.ErrorIs(t, err, packaging.ErrWeightExceeded)
+	})
+
+	t.Run("Упаковка не подходит заказу", func(t *testing.T) {
+		t.Parallel()
+
+		ref := models.ExternalRef{Source: "marketplace", Number: "5"}
+		expirationTime := time.Now().Add(time.Hour)
+
+		_, err := module.AddOrder(context.Background(), ref, models.ID(5), expirationTime, models.Packaging{"crate"}, models.Kilo(1), models.Dimensions{}, models.Rubles(100), nil, operator)
+		assert.ErrorIs(t, err, packaging.ErrInvalidPackage)
+
+		_, err = module.AddOrder(context.Background(), ref, models.ID(5), expirationTime, models.Packaging{"envelope"}, models.Kilo(1), models.Dimensions{}, models.Rubles(100), nil, operator)
+		assert.ErrorIs(t, err, packaging.ErrInvalidPackage)
+
+		_, err = module.AddOrder(context.Background(), ref, models.ID(5), expirationTime, models.Packaging{"pallet"}, models.Kilo(20), models.Dimensions{}, models.Rubles(100), nil, operator)
+		assert.ErrorIs(t, err, packaging.ErrWeightTooLow)
+
+		_, err = module.AddOrder(context.Background(), ref, models.ID(5), expirationTime, models.Packaging{"box"}, models.Kilo(30), models.Dimensions{}, models.Rubles(100), nil, operator)
+		assert.ErrorIs(t, err, packaging.ErrWeightExceeded)
+	})
+
+	t.Run("Составная упаковка", func(t *testing.T) {
+		t.Parallel()
+
+		ref := models.ExternalRef{Source: "marketplace", Number: "6"}
+		expirationTime := time.Now().Add(time.Hour)
+		pack := models.Packaging{"box", "wrap"}
+
+		mockStorage.EXPECT().AddOrder(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, order models.Order, event models.OrderEvent, code models.PickupCode, place func(cells []models.CellLoad) (models.CellCode, error)) (models.ID, error) {
+				assert.Equal(t, pack, order.Package)
+				assert.Equal(t, models.Rubles(21), order.PackageCost)
+				return models.ID(6), nil
+			})
+
+		_, err := module.AddOrder(context.Background(), ref, models.ID(6), expirationTime, pack, models.Kilo(20), models.Dimensions{}, models.Rubles(100), nil, operator)
+		require.NoError(t, err)
+
+		_, err = module.AddOrder(context.Background(), ref, models.ID(6), expirationTime, models.Packaging{"box", "film"}, models.Kilo(10), models.Dimensions{}, models.Rubles(100), nil, operator)
+		assert.ErrorIs(t, err, packaging.ErrWeightExceeded)
+
+		for _, invalid := range []models.Packaging{{"bag", "box"}, {"wrap", "box"}, {"box", "wrap", "film"}} {
+			_, err = module.AddOrder(context.Background(), ref, models.ID(6), expirationTime, invalid, models.Kilo(1), models.Dimensions{}, models.Rubles(100), nil, operator)
+			assert.ErrorIs(t, err, packaging.ErrInvalidCombination, invalid.String())
 		}
+	})
+
+	t.Run("Попытка добавить заказ в валюте, отличной от валюты упаковки", func(t *testing.T) {
+		t.Parallel()
 
-		mockStorage.EXPECT().GetOrder(orderID).Return(existingOrder, nil)
+		cost := models.NewMoney(10000, models.Currency("USD"))
 
-		err := module.AddOrder(orderID, customerID, expirationTime, pack, weight, cost)
+		_, err := module.AddOrder(context.Background(), models.ExternalRef{Source: "marketplace", Number: "2"}, models.ID(2), time.Now().Add(time.Hour), models.Packaging{"box"}, models.Kilo(10), models.Dimensions{}, cost, nil, operator)
 		require.Error(t, err)
-		assert.Contains(t, err.Error(), storage.ErrOrderExists.Error())
+		assert.ErrorIs(t, err, models.ErrCurrencyMismatch)
 	})
 }
 
@@ -73,34 +206,74 @@ func TestModule_ReturnOrder(t *testing.T) {
 
 		orderID := models.ID(1)
 		order := models.Order{
-			OrderID:            orderID,
-			ReceivedByCustomer: true,
-			ExpirationTime:     time.Now().Add(-24 * time.Hour),
+			OrderID:        orderID,
+			Status:         models.StatusAccepted,
+			ExpirationTime: time.Now().Add(-24 * time.Hour),
 		}
 
-		mockStorage.EXPECT().GetOrder(orderID).Return(order, nil)
-		mockStorage.EXPECT().ReturnOrder(orderID).Return(nil)
-
-		order, err := module.ReturnOrder(orderID)
+		mockStorage.EXPECT().GetOrder(gomock.Any(), orderID).Return(order, nil)
+		// Просрочка, которую еще не записала фоновая проверка, попадает в историю перед возвратом.
+		mockStorage.EXPECT().ChangeStatuses(gomock.Any(), []models.ID{orderID}, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ids []models.ID, change func([]models.Order) ([]models.OrderEvent, error)) error {
+				events, err := change([]models.Order{order})
+				require.NoError(t, err)
+				require.Len(t, events, 1)
+				assert.Equal(t, models.EventOrderExpired, events[0].Type)
+				assert.Equal(t, models.StatusAccepted, events[0].Change.From)
+				assert.Equal(t, models.StatusExpired, events[0].Change.To)
+				assert.True(t, order.ExpirationTime.Equal(events[0].Change.ChangedAt))
+				return nil
+			})
+		mockStorage.EXPECT().ReturnOrder(gomock.Any(), gomock.Any(), gomock.Nil()).DoAndReturn(func(ctx context.Context, event models.OrderEvent, refunds []models.RefundChange) (models.Order, error) {
+			assert.Equal(t, models.EventOrderReturned, event.Type)
+			assert.Equal(t, models.StatusExpired, event.Change.From)
+			assert.Equal(t, models.StatusReturnedToCourier, event.Change.To)
+			assert.Equal(t, operator, event.Change.Operator)
+			return order, nil
+		})
+
+		order, err := module.ReturnOrder(context.Background(), orderID, operator)
 		require.NoError(t, err)
 		assert.Equal(t, orderID, order.OrderID)
 	})
 
-	t.Run("Попытка вернуть курьеру заказ, который был возвращен ранее", func(t *testing.T) {
+	t.Run("Попытка вернуть курьеру заказ, который был выдан покупателю", func(t *testing.T) {
 		t.Parallel()
 
-		orderID := models.ID(1)
+		orderID := models.ID(2)
 		order := models.Order{
 			OrderID:            orderID,
-			ReceivedByCustomer: false,
+			ReceivedByCustomer: true,
+			Status:             models.StatusIssued,
 			ExpirationTime:     time.Now().Add(-24 * time.Hour),
 		}
 
-		mockStorage.EXPECT().GetOrder(orderID).Return(order, nil)
+		mockStorage.EXPECT().GetOrder(gomock.Any(), orderID).Return(order, nil)
 
-		_, err := module.ReturnOrder(orderID)
+		_, err := module.ReturnOrder(context.Background(), orderID, operator)
 		require.Error(t, err)
-		assert.Contains(t, err.Error(), ErrReturn.Error())
+		assert.ErrorIs(t, err, ErrReturn)
+		assert.ErrorIs(t, err, ErrTransition)
+	})
+
+	t.Run("Попытка вернуть курьеру заказ, срок хранения которого не истек", func(t *testing.T) {
+		t.Parallel()
+
+		for i, status := range []models.Status{models.StatusAccepted, models.StatusReadyForPickup} {
+			orderID := models.ID(3 + i)
+			order := models.Order{
+				OrderID:        orderID,
+				Status:         status,
+				ExpirationTime: time.Now().Add(24 * time.Hour),
+			}
+
+			mockStorage.EXPECT().GetOrder(gomock.Any(), orderID).Return(order, nil)
+
+			_, err := module.ReturnOrder(context.Background(), orderID, operator)
+			require.Error(t, err, status)
+			assert.ErrorIs(t, err, ErrReturn, status)
+			assert.ErrorIs(t, err, ErrTransition, status)
+		}
 	})
 }
 
@@ -119,8 +292,7 @@ func TestModule_ReceiveOrders(t *testing.T) {
 		expirationTime := time.Now().Add(24 * time.Hour)
 		pack := models.PackageType("box")
 		weight := models.Kilo(10)
-		cost := models.Rub(100)
-		p, _ := packaging.ParsePackage(pack)
+		cost := models.Rubles(100)
 
 		order := models.Order{
 			OrderID:            orderID,
@@ -129,20 +301,200 @@ func TestModule_ReceiveOrders(t *testing.T) {
 			ReceivedTime:       time.Time{},
 			ReceivedByCustomer: false,
 			Refunded:           false,
-			Package:            pack,
+			Status:             models.StatusAccepted,
+			Package:            models.Packaging{pack},
 			Weight:             weight,
 			Cost:               cost,
-			PackageCost:        p.GetCost(),
+			PackageCost:        testCatalog[pack].Price,
+			Cell:               "A-01-01",
 		}
 
-		mockStorage.EXPECT().GetOrder(orderID).Return(order, nil)
-		mockStorage.EXPECT().GetOrder(orderID).Return(order, nil)
-		mockStorage.EXPECT().ReceiveOrder(orderID).Return(order, nil)
-
-		receivedOrders, err := module.ReceiveOrders([]models.ID{orderID})
+		mockStorage.EXPECT().ChangeStatuses(gomock.Any(), []models.ID{orderID}, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ids []models.ID, change func([]models.Order) ([]models.OrderEvent, error)) error {
+				events, err := change([]models.Order{order})
+				require.NoError(t, err)
+				require.Len(t, events, 1)
+				assert.Equal(t, models.EventOrderReceived, events[0].Type)
+				assert.Empty(t, events[0].Order.Cell)
+				return nil
+			})
+
+		receivedOrders, err := module.ReceiveOrders(context.Background(), []models.ID{orderID}, "", nil, operator)
 		require.NoError(t, err)
 		assert.Equal(t, 1, len(receivedOrders))
 		assert.Equal(t, orderID, receivedOrders[0].OrderID)
+		assert.Equal(t, models.StatusIssued, receivedOrders[0].Status)
+		assert.True(t, receivedOrders[0].ReceivedByCustomer)
+		assert.Equal(t, models.CellCode("A-01-01"), receivedOrders[0].PickedFrom)
+	})
+
+	t.Run("Пачка не выдается, если один из заказов уже выдан", func(t *testing.T) {
+		t.Parallel()
+
+		customerID := models.ID(200)
+		orders := []models.Order{
+			{OrderID: 201, CustomerID: customerID, Status: models.StatusAccepted, ExpirationTime: time.Now().Add(time.Hour)},
+			{OrderID: 202, CustomerID: customerID, Status: models.StatusIssued, ReceivedByCustomer: true, ExpirationTime: time.Now().Add(time.Hour)},
+		}
+
+		mockStorage.EXPECT().ChangeStatuses(gomock.Any(), []models.ID{201, 202}, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ids []models.ID, change func([]models.Order) ([]models.OrderEvent, error)) error {
+				events, err := change(orders)
+				assert.Empty(t, events)
+				return err
+			})
+
+		_, err := module.ReceiveOrders(context.Background(), []models.ID{201, 202}, "", nil, operator)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrTransition)
+	})
+
+	t.Run("Пачка не выдается, если заказы принадлежат разным покупателям", func(t *testing.T) {
+		t.Parallel()
+
+		orders := []models.Order{
+			{OrderID: 301, CustomerID: 1, Status: models.StatusAccepted, ExpirationTime: time.Now().Add(time.Hour)},
+			{OrderID: 302, CustomerID: 2, Status: models.StatusAccepted, ExpirationTime: time.Now().Add(time.Hour)},
+		}
+
+		mockStorage.EXPECT().ChangeStatuses(gomock.Any(), []models.ID{301, 302}, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ids []models.ID, change func([]models.Order) ([]models.OrderEvent, error)) error {
+				_, err := change(orders)
+				return err
+			})
+
+		_, err := module.ReceiveOrders(context.Background(), []models.ID{301, 302}, "", nil, operator)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrReceive)
+	})
+}
+
+func TestModule_PickupCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	codes, errCodes := pickupcode.New(config.PickupCodeConfig{Secret: "secret", Length: 6, MaxAttempts: 3, LockoutMinutes: 15})
+	require.NoError(t, errCodes)
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage, PickupCodes: codes})
+
+	customerID := models.ID(10)
+	code, stored, errGenerate := codes.Generate(customerID, time.Now())
+	require.NoError(t, errGenerate)
+	order := models.Order{OrderID: 11, CustomerID: customerID, Status: models.StatusAccepted, ExpirationTime: time.Now().Add(time.Hour)}
+
+	t.Run("При приеме заказа код уходит в уведомление, а в хранилище только хеш", func(t *testing.T) {
+		mockStorage.EXPECT().GetPackage(gomock.Any(), gomock.Any()).DoAndReturn(getTestPackage)
+		mockStorage.EXPECT().AddOrder(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, order models.Order, event models.OrderEvent, issued models.PickupCode, place func(cells []models.CellLoad) (models.CellCode, error)) (models.ID, error) {
+				require.NotEmpty(t, event.PickupCode)
+				assert.Equal(t, customerID, issued.CustomerID)
+				assert.True(t, codes.Verify(issued, event.PickupCode))
+				return models.ID(11), nil
+			})
+
+		ref := models.ExternalRef{Source: "marketplace", Number: "11"}
+		_, err := module.AddOrder(context.Background(), ref, customerID, time.Now().Add(time.Hour), models.Packaging{"box"}, models.Kilo(1), models.Dimensions{}, models.Rubles(100), nil, operator)
+		require.NoError(t, err)
+	})
+
+	t.Run("Выдача гасит использованный код и выпускает следующий", func(t *testing.T) {
+		mockStorage.EXPECT().GetOrder(gomock.Any(), order.OrderID).Return(order, nil)
+		mockStorage.EXPECT().GetPickupCode(gomock.Any(), customerID).Return(stored, nil)
+		mockStorage.EXPECT().HandOverOrders(gomock.Any(), []models.ID{order.OrderID}, stored, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ids []models.ID, used models.PickupCode, next models.PickupCode, change func([]models.Order) ([]models.OrderEvent, error)) error {
+				events, err := change([]models.Order{order})
+				require.NoError(t, err)
+				require.Len(t, events, 1)
+				require.NotEmpty(t, events[0].PickupCode)
+				assert.NotEqual(t, code, events[0].PickupCode)
+				assert.Equal(t, customerID, next.CustomerID)
+				assert.True(t, codes.Verify(next, events[0].PickupCode))
+				return nil
+			})
+
+		received, err := module.ReceiveOrders(context.Background(), []models.ID{order.OrderID}, code, nil, operator)
+		require.NoError(t, err)
+		assert.Len(t, received, 1)
+	})
+
+	t.Run("Код, погашенный параллельной выдачей, не принимается", func(t *testing.T) {
+		mockStorage.EXPECT().GetOrder(gomock.Any(), order.OrderID).Return(order, nil)
+		mockStorage.EXPECT().GetPickupCode(gomock.Any(), customerID).Return(stored, nil)
+		mockStorage.EXPECT().HandOverOrders(gomock.Any(), []models.ID{order.OrderID}, stored, gomock.Any(), gomock.Any()).
+			Return(fmt.Errorf("storage.HandOverOrders error: %w", storage.ErrPickupCodeUsed))
+
+		_, err := module.ReceiveOrders(context.Background(), []models.ID{order.OrderID}, code, nil, operator)
+		assert.ErrorIs(t, err, ErrPickupCode)
+	})
+
+	t.Run("Неверный код засчитывается как попытка", func(t *testing.T) {
+		mockStorage.EXPECT().GetOrder(gomock.Any(), order.OrderID).Return(order, nil)
+		mockStorage.EXPECT().GetPickupCode(gomock.Any(), customerID).Return(stored, nil)
+		mockStorage.EXPECT().RecordPickupFailure(gomock.Any(), customerID, 3, gomock.Any()).Return(models.PickupCode{FailedAttempts: 1}, nil)
+
+		_, err := module.ReceiveOrders(context.Background(), []models.ID{order.OrderID}, "000000"+code, nil, operator)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrPickupCode)
+		assert.Contains(t, err.Error(), "2 attempts left")
+	})
+
+	t.Run("Последняя неверная попытка блокирует выдачу", func(t *testing.T) {
+		mockStorage.EXPECT().GetOrder(gomock.Any(), order.OrderID).Return(order, nil)
+		mockStorage.EXPECT().GetPickupCode(gomock.Any(), customerID).Return(stored, nil)
+		mockStorage.EXPECT().RecordPickupFailure(gomock.Any(), customerID, 3, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, customerId models.ID, maxAttempts int, lockedUntil time.Time) (models.PickupCode, error) {
+				return models.PickupCode{LockedUntil: lockedUntil}, nil
+			})
+
+		_, err := module.ReceiveOrders(context.Background(), []models.ID{order.OrderID}, "000000"+code, nil, operator)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrPickupLocked)
+	})
+
+	t.Run("Во время блокировки не принимается даже верный код", func(t *testing.T) {
+		locked := stored
+		locked.LockedUntil = time.Now().Add(time.Minute)
+		mockStorage.EXPECT().GetOrder(gomock.Any(), order.OrderID).Return(order, nil)
+		mockStorage.EXPECT().GetPickupCode(gomock.Any(), customerID).Return(locked, nil)
+
+		_, err := module.ReceiveOrders(context.Background(), []models.ID{order.OrderID}, code, nil, operator)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrPickupLocked)
+	})
+
+	t.Run("Код одного клиента не выдает заказы другого", func(t *testing.T) {
+		other := models.Order{OrderID: 12, CustomerID: 20, Status: models.StatusAccepted, ExpirationTime: time.Now().Add(time.Hour)}
+		mockStorage.EXPECT().GetOrder(gomock.Any(), order.OrderID).Return(order, nil)
+		mockStorage.EXPECT().GetPickupCode(gomock.Any(), customerID).Return(stored, nil)
+		mockStorage.EXPECT().HandOverOrders(gomock.Any(), []models.ID{order.OrderID, other.OrderID}, stored, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ids []models.ID, used models.PickupCode, next models.PickupCode, change func([]models.Order) ([]models.OrderEvent, error)) error {
+				_, err := change([]models.Order{order, other})
+				return err
+			})
+
+		_, err := module.ReceiveOrders(context.Background(), []models.ID{order.OrderID, other.OrderID}, code, nil, operator)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrReceive)
+	})
+
+	t.Run("Неизвестный первый заказ отклоняется до проверки кода", func(t *testing.T) {
+		mockStorage.EXPECT().GetOrder(gomock.Any(), models.ID(404)).Return(models.Order{}, nil)
+
+		_, err := module.ReceiveOrders(context.Background(), []models.ID{404, order.OrderID}, code, nil, operator)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrReceive)
+		assert.NotErrorIs(t, err, ErrPickupCode)
+	})
+
+	t.Run("Без выпущенного кода выдача невозможна", func(t *testing.T) {
+		mockStorage.EXPECT().GetOrder(gomock.Any(), order.OrderID).Return(order, nil)
+		mockStorage.EXPECT().GetPickupCode(gomock.Any(), customerID).Return(models.PickupCode{}, storage.ErrPickupCodeNotFound)
+
+		_, err := module.ReceiveOrders(context.Background(), []models.ID{order.OrderID}, code, nil, operator)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrPickupCode)
 	})
 }
 
@@ -162,83 +514,182 @@ func TestModule_GetOrders(t *testing.T) {
 			{OrderID: models.ID(2)},
 		}
 
-		mockStorage.EXPECT().GetCustomersOrders(customerID).Return(orders, nil)
+		mockStorage.EXPECT().GetCustomersOrders(gomock.Any(), models.OrdersQuery{CustomerID: customerID, Sort: models.SortByExpiration}).Return(orders, nil)
 
-		result, err := module.GetOrders(customerID, 2)
+		result, err := module.GetOrders(context.Background(), models.OrdersQuery{CustomerID: customerID})
 		require.NoError(t, err)
-		assert.Equal(t, 2, len(result))
+		assert.Equal(t, 2, len(result.Orders))
+		assert.Nil(t, result.Next)
+	})
+
+	t.Run("Курсор следующей страницы указывает на последний заказ страницы", func(t *testing.T) {
+		t.Parallel()
+
+		customerID := models.ID(2)
+		received := time.Date(2024, time.July, 1, 12, 0, 0, 0, time.UTC)
+		orders := []models.Order{
+			{OrderID: models.ID(3), CustomerID: customerID, ReceivedTime: received},
+			{OrderID: models.ID(4), CustomerID: customerID, ReceivedTime: received.Add(time.Hour)},
+			{OrderID: models.ID(5), CustomerID: customerID, ReceivedTime: received.Add(2 * time.Hour)},
+		}
+		query := models.OrdersQuery{CustomerID: customerID, Limit: 2, Sort: models.SortByReceived, State: models.StateReceived}
+
+		expected := query
+		expected.Limit = 3
+		mockStorage.EXPECT().GetCustomersOrders(gomock.Any(), expected).Return(orders, nil)
+
+		result, err := module.GetOrders(context.Background(), query)
+		require.NoError(t, err)
+		assert.Len(t, result.Orders, 2)
+		require.NotNil(t, result.Next)
+		assert.Equal(t, models.OrdersCursor{Sort: models.SortByReceived, Value: received.Add(time.Hour), OrderID: models.ID(4)}, *result.Next)
+	})
+
+	t.Run("Курсор с другой сортировкой отклоняется", func(t *testing.T) {
+		t.Parallel()
+
+		query := models.OrdersQuery{
+			CustomerID: models.ID(3),
+			Limit:      2,
+			Sort:       models.SortByExpiration,
+			After:      &models.OrdersCursor{Sort: models.SortByReceived, OrderID: models.ID(1)},
+		}
+
+		_, err := module.GetOrders(context.Background(), query)
+		assert.ErrorIs(t, err, ErrPageToken)
 	})
 }
 
-func TestModule_RefundOrder(t *testing.T) {
+func TestModule_GetRefunds(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStorage := mockstorage.NewMockStorage(ctrl)
 	module := NewModule(Deps{Storage: mockStorage})
 
-	t.Run("Успешный возврат заказа", func(t *testing.T) {
+	t.Run("Успешное получение списка возвратов", func(t *testing.T) {
 		t.Parallel()
 
-		orderID := models.ID(1)
-		customerID := models.ID(1)
-		order := models.Order{
-			OrderID:            orderID,
-			CustomerID:         customerID,
-			ReceivedByCustomer: true,
-			Refunded:           false,
-			ReceivedTime:       time.Now().Add(-time.Hour),
+		refunds := []models.Order{
+			{OrderID: models.ID(1)},
+			{OrderID: models.ID(2)},
 		}
 
-		mockStorage.EXPECT().GetOrder(orderID).Return(order, nil)
-		mockStorage.EXPECT().ChangeOrder(gomock.Any()).Return(nil)
+		mockStorage.EXPECT().GetRefunds(gomock.Any(), models.RefundsQuery{}).Return(refunds, 2, nil)
 
-		err := module.RefundOrder(customerID, orderID)
+		result, err := module.GetRefunds(context.Background(), models.RefundsQuery{})
 		require.NoError(t, err)
+		assert.Len(t, result.Refunds, 2)
+		assert.Equal(t, 2, result.Total)
+		assert.Nil(t, result.Next)
 	})
 
-	t.Run("Попытка вернуть ранее возвращенный заказ", func(t *testing.T) {
+	t.Run("Курсор следующей страницы указывает на последний возврат страницы", func(t *testing.T) {
+		t.Parallel()
+
+		refunded := time.Date(2024, time.August, 1, 12, 0, 0, 0, time.UTC)
+		refunds := []models.Order{
+			{OrderID: models.ID(3), RefundedTime: refunded},
+			{OrderID: models.ID(4), RefundedTime: refunded.Add(time.Hour)},
+			{OrderID: models.ID(5), RefundedTime: refunded.Add(2 * time.Hour)},
+		}
+		query := models.RefundsQuery{From: refunded, Limit: 2}
+
+		expected := query
+		expected.Limit = 3
+		mockStorage.EXPECT().GetRefunds(gomock.Any(), expected).Return(refunds, 5, nil)
+
+		result, err := module.GetRefunds(context.Background(), query)
+		require.NoError(t, err)
+		assert.Len(t, result.Refunds, 2)
+		assert.Equal(t, 5, result.Total)
+		require.NotNil(t, result.Next)
+		assert.Equal(t, models.RefundsCursor{RefundedTime: refunded.Add(time.Hour), OrderID: models.ID(4)}, *result.Next)
+	})
+
+	t.Run("Номер страницы игнорируется при переданном курсоре", func(t *testing.T) {
+		t.Parallel()
+
+		cursor := &models.RefundsCursor{OrderID: models.ID(7)}
+		mockStorage.EXPECT().GetRefunds(gomock.Any(), models.RefundsQuery{Limit: 11, After: cursor}).Return(nil, 20, nil)
+
+		result, err := module.GetRefunds(context.Background(), models.RefundsQuery{Limit: 10, Offset: 30, After: cursor})
+		require.NoError(t, err)
+		assert.Empty(t, result.Refunds)
+	})
+
+	t.Run("Страница за пределами списка возвратов", func(t *testing.T) {
+		t.Parallel()
+
+		mockStorage.EXPECT().GetRefunds(gomock.Any(), models.RefundsQuery{Limit: 3, Offset: 4}).Return(nil, 3, nil)
+
+		_, err := module.GetRefunds(context.Background(), models.RefundsQuery{Limit: 2, Offset: 4})
+		assert.ErrorIs(t, err, ErrPagination)
+	})
+}
+
+func TestModule_GetOrderHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage})
+
+	t.Run("Успешное получение истории заказа, возвращенного курьеру", func(t *testing.T) {
 		t.Parallel()
 
 		orderID := models.ID(1)
-		customerID := models.ID(1)
-		order := models.Order{
-			OrderID:            orderID,
-			CustomerID:         models.ID(2),
-			ReceivedByCustomer: true,
-			Refunded:           false,
-			ReceivedTime:       time.Now().Add(-time.Hour),
+		history := []models.StatusChange{
+			{OrderID: orderID, To: models.StatusAccepted, Operator: operator},
+			{OrderID: orderID, From: models.StatusExpired, To: models.StatusReturnedToCourier, Operator: operator},
 		}
 
-		mockStorage.EXPECT().GetOrder(orderID).Return(order, nil)
+		mockStorage.EXPECT().GetStatusHistory(gomock.Any(), orderID).Return(history, nil)
+
+		result, err := module.GetOrderHistory(context.Background(), orderID)
+		require.NoError(t, err)
+		assert.Equal(t, history, result)
+	})
+
+	t.Run("Попытка получить историю несуществующего заказа", func(t *testing.T) {
+		t.Parallel()
+
+		orderID := models.ID(2)
+
+		mockStorage.EXPECT().GetStatusHistory(gomock.Any(), orderID).Return(nil, nil)
 
-		err := module.RefundOrder(customerID, orderID)
+		_, err := module.GetOrderHistory(context.Background(), orderID)
 		require.Error(t, err)
-		assert.Contains(t, err.Error(), ErrRefund.Error())
 	})
 }
 
-func TestModule_GetRefunds(t *testing.T) {
+func TestModule_ResolveOrderID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStorage := mockstorage.NewMockStorage(ctrl)
 	module := NewModule(Deps{Storage: mockStorage})
 
-	t.Run("Успешное получение списка возвратов", func(t *testing.T) {
+	t.Run("Успешный поиск заказа по внешнему номеру", func(t *testing.T) {
 		t.Parallel()
 
-		page := 0
-		limit := 2
-		refunds := []models.Order{
-			{OrderID: models.ID(1)},
-			{OrderID: models.ID(2)},
-		}
+		ref := models.ExternalRef{Source: "marketplace", Number: "A-1"}
 
-		mockStorage.EXPECT().GetRefunds().Return(refunds, nil)
+		mockStorage.EXPECT().ResolveOrderID(gomock.Any(), ref).Return(models.ID(5), nil)
 
-		result, err := module.GetRefunds(page, limit)
+		orderID, err := module.ResolveOrderID(context.Background(), ref)
 		require.NoError(t, err)
-		assert.Equal(t, 2, len(result))
+		assert.Equal(t, models.ID(5), orderID)
+	})
+
+	t.Run("Попытка найти заказ по неизвестному внешнему номеру", func(t *testing.T) {
+		t.Parallel()
+
+		ref := models.ExternalRef{Source: "marketplace", Number: "A-2"}
+
+		mockStorage.EXPECT().ResolveOrderID(gomock.Any(), ref).Return(models.ID(0), storage.ErrOrderNotFound)
+
+		_, err := module.ResolveOrderID(context.Background(), ref)
+		assert.ErrorIs(t, err, storage.ErrOrderNotFound)
 	})
 }
@@ -0,0 +1,127 @@
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework-1/internal/models"
+	"homework-1/internal/services/packaging"
+	"homework-1/internal/storage"
+	"time"
+)
+
+// AddPackage Регистрирует новый тип упаковки в каталоге.
+func (m *Module) AddPackage(ctx context.Context, spec models.PackageSpec) (models.PackageSpec, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.AddPackage")
+	defer span.Finish()
+
+	if errValidate := packaging.Validate(spec); errValidate != nil {
+		return models.PackageSpec{}, fmt.Errorf("module.AddPackage error: %w", errValidate)
+	}
+
+	now := time.Now()
+	spec.RetiredAt = time.Time{}
+	spec.CreatedAt = now
+	spec.UpdatedAt = now
+
+	if errAdd := m.Storage.AddPackage(ctx, spec); errAdd != nil {
+		return models.PackageSpec{}, fmt.Errorf("module.AddPackage error: %w", errAdd)
+	}
+
+	return spec, nil
+}
+
+// UpdatePackage Заменяет описание типа упаковки. Новая цена применяется только к заказам, принятым после изменения.
+func (m *Module) UpdatePackage(ctx context.Context, spec models.PackageSpec) (models.PackageSpec, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.UpdatePackage")
+	defer span.Finish()
+
+	if errValidate := packaging.Validate(spec); errValidate != nil {
+		return models.PackageSpec{}, fmt.Errorf("module.UpdatePackage error: %w", errValidate)
+	}
+
+	spec.UpdatedAt = time.Now()
+	if errUpdate := m.Storage.UpdatePackage(ctx, spec); errUpdate != nil {
+		return models.PackageSpec{}, fmt.Errorf("module.UpdatePackage error: %w", errUpdate)
+	}
+
+	updated, errGet := m.Storage.GetPackage(ctx, spec.Type)
+	if errGet != nil {
+		return models.PackageSpec{}, fmt.Errorf("module.UpdatePackage error: %w", errGet)
+	}
+
+	return updated, nil
+}
+
+// RetirePackage Выводит тип упаковки из оборота: уже принятые в нем заказы не меняются, новые не принимаются.
+func (m *Module) RetirePackage(ctx context.Context, pack models.PackageType) (models.PackageSpec, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.RetirePackage")
+	defer span.Finish()
+
+	if errRetire := m.Storage.RetirePackage(ctx, pack, time.Now()); errRetire != nil {
+		return models.PackageSpec{}, fmt.Errorf("module.RetirePackage error: %w", errRetire)
+	}
+
+	retired, errGet := m.Storage.GetPackage(ctx, pack)
+	if errGet != nil {
+		return models.PackageSpec{}, fmt.Errorf("module.RetirePackage error: %w", errGet)
+	}
+
+	return retired, nil
+}
+
+func (m *Module) GetPackages(ctx context.Context, includeRetired bool) ([]models.PackageSpec, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.GetPackages")
+	defer span.Finish()
+
+	specs, errGet := m.Storage.GetPackages(ctx, includeRetired)
+	if errGet != nil {
+		return nil, fmt.Errorf("module.GetPackages error: %w", errGet)
+	}
+
+	return specs, nil
+}
+
+// SeedPackages Добавляет в каталог отсутствующие в нем типы из конфигурации и возвращает количество добавленных.
+// Ошибка в описании любого из типов отменяет заполнение целиком.
+func (m *Module) SeedPackages(ctx context.Context, specs []models.PackageSpec) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.SeedPackages")
+	defer span.Finish()
+
+	now := time.Now()
+	seeds := make([]models.PackageSpec, 0, len(specs))
+	for _, spec := range specs {
+		if errValidate := packaging.Validate(spec); errValidate != nil {
+			return 0, fmt.Errorf("module.SeedPackages error: %w", errValidate)
+		}
+		spec.CreatedAt = now
+		spec.UpdatedAt = now
+		seeds = append(seeds, spec)
+	}
+
+	added, errSeed := m.Storage.SeedPackages(ctx, seeds)
+	if errSeed != nil {
+		return 0, fmt.Errorf("module.SeedPackages error: %w", errSeed)
+	}
+
+	return added, nil
+}
+
+// getPackage Упаковка из каталога для нового заказа. Незарегистрированный тип отклоняется как ErrInvalidPackage,
+// а недопустимое сочетание слоев проверяет packaging.Compose.
+func (m *Module) getPackage(ctx context.Context, pack models.Packaging) (packaging.Package, error) {
+	specs := make([]models.PackageSpec, 0, len(pack))
+	for _, layer := range pack {
+		spec, errGet := m.Storage.GetPackage(ctx, layer)
+		if errGet != nil {
+			if errors.Is(errGet, storage.ErrPackageNotFound) {
+				return nil, fmt.Errorf("%w: %q", packaging.ErrInvalidPackage, layer)
+			}
+			return nil, errGet
+		}
+		specs = append(specs, spec)
+	}
+
+	return packaging.Compose(specs)
+}
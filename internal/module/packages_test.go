@@ -0,0 +1,123 @@
+package module
+
+import (
+	"context"
+	"homework-1/internal/models"
+	"homework-1/internal/services/packaging"
+	"homework-1/internal/storage"
+	mockstorage "homework-1/internal/storage/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModule_AddPackage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage})
+
+	t.Run("Регистрация нового типа", func(t *testing.T) {
+		spec := models.PackageSpec{Type: "crate", Kind: models.PackageKindContainer, Price: models.Rubles(150), MinWeight: 5, MaxWeight: 80,
+			Dimensions: models.Dimensions{Length: 120, Width: 80, Height: 60}}
+
+		mockStorage.EXPECT().AddPackage(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, added models.PackageSpec) error {
+				assert.Equal(t, spec.Type, added.Type)
+				assert.False(t, added.CreatedAt.IsZero())
+				assert.False(t, added.Retired())
+				return nil
+			})
+
+		added, err := module.AddPackage(context.Background(), spec)
+		require.NoError(t, err)
+		assert.Equal(t, models.Rubles(150), added.Price)
+	})
+
+	t.Run("Некорректное описание не попадает в каталог", func(t *testing.T) {
+		invalid := []models.PackageSpec{
+			{Type: "Crate", Kind: models.PackageKindContainer, Price: models.Rubles(1)},
+			{Type: "crate", Kind: models.PackageKindContainer, Price: models.Rubles(-1)},
+			{Type: "crate", Kind: models.PackageKindContainer, Price: models.Rubles(1), MinWeight: 10, MaxWeight: 10},
+			{Type: "crate", Kind: models.PackageKindContainer, Price: models.Rubles(1), Dimensions: models.Dimensions{Length: -1}},
+			{Type: "crate", Kind: "pallet", Price: models.Rubles(1)},
+			{Type: "film", Kind: models.PackageKindWrapping, Price: models.Rubles(1), AllowWrap: true},
+		}
+
+		for _, spec := range invalid {
+			_, err := module.AddPackage(context.Background(), spec)
+			assert.ErrorIs(t, err, packaging.ErrInvalidSpec, spec.String())
+		}
+	})
+
+	t.Run("Тип уже зарегистрирован", func(t *testing.T) {
+		mockStorage.EXPECT().AddPackage(gomock.Any(), gomock.Any()).Return(storage.ErrPackageExists)
+
+		_, err := module.AddPackage(context.Background(), models.PackageSpec{Type: "box", Kind: models.PackageKindContainer, Price: models.Rubles(20)})
+		assert.ErrorIs(t, err, storage.ErrPackageExists)
+	})
+}
+
+func TestModule_RetirePackage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage})
+
+	t.Run("Выведенный тип возвращается с временем вывода", func(t *testing.T) {
+		retiredAt := time.Now()
+		mockStorage.EXPECT().RetirePackage(gomock.Any(), models.PackageType("bag"), gomock.Any()).Return(nil)
+		mockStorage.EXPECT().GetPackage(gomock.Any(), models.PackageType("bag")).
+			Return(models.PackageSpec{Type: "bag", Kind: models.PackageKindContainer, Price: models.Rubles(5), RetiredAt: retiredAt}, nil)
+
+		retired, err := module.RetirePackage(context.Background(), models.PackageType("bag"))
+		require.NoError(t, err)
+		assert.True(t, retired.Retired())
+	})
+
+	t.Run("Тип не зарегистрирован", func(t *testing.T) {
+		mockStorage.EXPECT().RetirePackage(gomock.Any(), models.PackageType("crate"), gomock.Any()).Return(storage.ErrPackageNotFound)
+
+		_, err := module.RetirePackage(context.Background(), models.PackageType("crate"))
+		assert.ErrorIs(t, err, storage.ErrPackageNotFound)
+	})
+}
+
+func TestModule_SeedPackages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage})
+
+	t.Run("Ошибка в одном типе отменяет заполнение", func(t *testing.T) {
+		specs := []models.PackageSpec{
+			{Type: "bag", Kind: models.PackageKindContainer, Price: models.Rubles(5), MaxWeight: 10},
+			{Type: "", Kind: models.PackageKindContainer, Price: models.Rubles(1)},
+		}
+
+		_, err := module.SeedPackages(context.Background(), specs)
+		assert.ErrorIs(t, err, packaging.ErrInvalidSpec)
+	})
+
+	t.Run("В хранилище передаются типы с временем создания", func(t *testing.T) {
+		specs := []models.PackageSpec{{Type: "bag", Kind: models.PackageKindContainer, Price: models.Rubles(5), MaxWeight: 10}}
+
+		mockStorage.EXPECT().SeedPackages(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, seeds []models.PackageSpec) (int, error) {
+				require.Len(t, seeds, 1)
+				assert.False(t, seeds[0].CreatedAt.IsZero())
+				return 1, nil
+			})
+
+		added, err := module.SeedPackages(context.Background(), specs)
+		require.NoError(t, err)
+		assert.Equal(t, 1, added)
+		assert.True(t, specs[0].CreatedAt.IsZero())
+	})
+}
@@ -0,0 +1,73 @@
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework-1/internal/models"
+	"homework-1/internal/services/pickuppoint"
+	"homework-1/internal/services/shelving"
+)
+
+var ErrPickupPoint = errors.New("invalid pickup point")
+
+// SeedPickupPoints Добавляет в справочник отсутствующие в нем пункты из конфигурации и закрепляет за пунктами
+// их сотрудников. Ошибка в настройках любого из пунктов отменяет заполнение целиком.
+func (m *Module) SeedPickupPoints(ctx context.Context, points []models.PickupPoint) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.SeedPickupPoints")
+	defer span.Finish()
+
+	operators := make(map[models.Operator]models.PointID)
+	for _, point := range points {
+		if point.ID == "" {
+			return 0, fmt.Errorf("module.SeedPickupPoints error: %w: empty id", ErrPickupPoint)
+		}
+		if _, errParse := pickuppoint.FromModel(point); errParse != nil {
+			return 0, fmt.Errorf("module.SeedPickupPoints error: %w %s: %w", ErrPickupPoint, point.ID, errParse)
+		}
+		if errCells := shelving.Validate(point.Cells); errCells != nil {
+			return 0, fmt.Errorf("module.SeedPickupPoints error: %w %s: %w", ErrPickupPoint, point.ID, errCells)
+		}
+		for _, operator := range point.Operators {
+			if other, ok := operators[operator]; ok && other != point.ID {
+				return 0, fmt.Errorf("module.SeedPickupPoints error: %w: operator %s works at %s and %s",
+					ErrPickupPoint, operator, other, point.ID)
+			}
+			operators[operator] = point.ID
+		}
+	}
+
+	added, errSeed := m.Storage.SeedPickupPoints(ctx, points)
+	if errSeed != nil {
+		return 0, fmt.Errorf("module.SeedPickupPoints error: %w", errSeed)
+	}
+
+	return added, nil
+}
+
+// OperatorPoint Пункт, в котором работает сотрудник. По нему определяются границы каждого запроса сотрудника.
+func (m *Module) OperatorPoint(ctx context.Context, operator models.Operator) (models.PointID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.OperatorPoint")
+	defer span.Finish()
+
+	point, errGet := m.Storage.GetOperatorPoint(ctx, operator)
+	if errGet != nil {
+		return "", fmt.Errorf("module.OperatorPoint error: %w", errGet)
+	}
+
+	return point, nil
+}
+
+// PickupPoints Все пункты выдачи из справочника.
+func (m *Module) PickupPoints(ctx context.Context) ([]models.PickupPoint, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.PickupPoints")
+	defer span.Finish()
+
+	points, errGet := m.Storage.GetPickupPoints(ctx)
+	if errGet != nil {
+		return nil, fmt.Errorf("module.PickupPoints error: %w", errGet)
+	}
+
+	return points, nil
+}
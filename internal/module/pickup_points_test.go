@@ -0,0 +1,50 @@
+package module
+
+import (
+	"context"
+	"homework-1/internal/models"
+	mockstorage "homework-1/internal/storage/mocks"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModule_SeedPickupPoints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage})
+
+	t.Run("Пункты из конфигурации попадают в справочник", func(t *testing.T) {
+		points := []models.PickupPoint{
+			{ID: "msk-1", TimeZone: "Europe/Moscow", ClosingTime: "21:00", Operators: []models.Operator{"anna"}},
+			{ID: "ekb-1", TimeZone: "Asia/Yekaterinburg", Operators: []models.Operator{"oleg"}},
+		}
+		mockStorage.EXPECT().SeedPickupPoints(gomock.Any(), points).Return(2, nil)
+
+		added, err := module.SeedPickupPoints(context.Background(), points)
+		require.NoError(t, err)
+		assert.Equal(t, 2, added)
+	})
+
+	t.Run("Ошибка в одном пункте отменяет заполнение", func(t *testing.T) {
+		invalid := [][]models.PickupPoint{
+			{{TimeZone: "Europe/Moscow"}},
+			{{ID: "msk-1", TimeZone: "Moscow/Tverskaya"}},
+			{{ID: "msk-1", TimeZone: "Europe/Moscow", ClosingTime: "9pm"}},
+			{{ID: "msk-1", TimeZone: "Europe/Moscow", Cells: []models.Cell{{Code: "A-01-01"}}}},
+			{
+				{ID: "msk-1", TimeZone: "Europe/Moscow", Operators: []models.Operator{"anna"}},
+				{ID: "msk-2", TimeZone: "Europe/Moscow", Operators: []models.Operator{"anna"}},
+			},
+		}
+
+		for _, points := range invalid {
+			_, err := module.SeedPickupPoints(context.Background(), points)
+			assert.ErrorIs(t, err, ErrPickupPoint, points[0].String())
+		}
+	})
+}
@@ -0,0 +1,65 @@
+package module
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework-1/internal/models"
+	"homework-1/internal/services/packaging"
+	"sort"
+)
+
+// GetQuote Подбирает упаковки из каталога для заказа весом weight, размером size и стоимостью cost.
+// Варианты упорядочены по итоговой стоимости, при равной стоимости по имени упаковки. Упаковки, которые не подходят
+// заказу по весу, размеру или правилам пункта, в ответ не попадают. Стоимость в валюте, отличной от валюты упаковки,
+// возвращает ErrCurrencyMismatch: иначе клиент получил бы пустой список и принял бы это за отсутствие упаковки.
+// Предлагаются только однослойные упаковки: заказ должен подходить каждому слою составной упаковки, а ее цена
+// складывается из цен слоев, поэтому составная упаковка никогда не дешевле своего внутреннего слоя.
+func (m *Module) GetQuote(ctx context.Context, weight models.Kilo, size models.Dimensions, cost models.Money) ([]models.Quote, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.GetQuote")
+	defer span.Finish()
+
+	specs, errGet := m.Storage.GetPackages(ctx, false)
+	if errGet != nil {
+		return nil, fmt.Errorf("module.GetQuote error: %w", errGet)
+	}
+
+	quotes := make([]models.Quote, 0, len(specs))
+	for _, spec := range specs {
+		pack := models.Packaging{spec.Type}
+		p, errNew := packaging.New(spec)
+		if errNew != nil {
+			continue
+		}
+		if p.ValidateWeight(weight) != nil || p.ValidateSize(size) != nil || m.rules(ctx, pack).ValidateWeight(weight) != nil {
+			continue
+		}
+
+		total, errTotal := models.Order{Cost: cost, PackageCost: p.GetCost()}.GetTotalCost()
+		if errTotal != nil {
+			return nil, fmt.Errorf("module.GetQuote error: %w", errTotal)
+		}
+		quotes = append(quotes, models.Quote{Package: pack, PackageCost: p.GetCost(), Total: total})
+	}
+
+	sort.SliceStable(quotes, func(i, j int) bool {
+		if quotes[i].Total.Amount != quotes[j].Total.Amount {
+			return quotes[i].Total.Amount < quotes[j].Total.Amount
+		}
+		return quotes[i].Package.String() < quotes[j].Package.String()
+	})
+
+	return quotes, nil
+}
+
+// cheapestPackage Упаковка для заказа, принятого с package_type auto.
+func (m *Module) cheapestPackage(ctx context.Context, weight models.Kilo, size models.Dimensions, cost models.Money) (models.Packaging, error) {
+	quotes, errQuote := m.GetQuote(ctx, weight, size, cost)
+	if errQuote != nil {
+		return nil, errQuote
+	}
+	if len(quotes) == 0 {
+		return nil, fmt.Errorf("%w: %g kg", ErrNoPackage, weight)
+	}
+	return quotes[0].Package, nil
+}
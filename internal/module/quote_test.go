@@ -0,0 +1,144 @@
+package module
+
+import (
+	"context"
+	"homework-1/internal/models"
+	"homework-1/internal/services/packaging"
+	mockstorage "homework-1/internal/storage/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCatalogList Действующие типы testCatalog в том виде, в каком их возвращает GetPackages.
+func testCatalogList() []models.PackageSpec {
+	var specs []models.PackageSpec
+	for _, spec := range testCatalog {
+		if !spec.Retired() {
+			specs = append(specs, spec)
+		}
+	}
+	return specs
+}
+
+func TestModule_GetQuote(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	mockStorage.EXPECT().GetPackages(gomock.Any(), false).DoAndReturn(
+		func(context.Context, bool) ([]models.PackageSpec, error) {
+			return testCatalogList(), nil
+		}).AnyTimes()
+	module := NewModule(Deps{Storage: mockStorage})
+
+	t.Run("Подходящие упаковки по возрастанию стоимости", func(t *testing.T) {
+		quotes, err := module.GetQuote(context.Background(), models.Kilo(9), models.Dimensions{}, models.Rubles(100))
+		require.NoError(t, err)
+
+		var packs []string
+		for _, quote := range quotes {
+			packs = append(packs, quote.Package.String())
+		}
+		assert.Equal(t, []string{"wrap", "bag", "box"}, packs)
+		assert.Equal(t, models.Rubles(101), quotes[0].Total)
+		assert.Equal(t, models.Rubles(5), quotes[1].PackageCost)
+	})
+
+	t.Run("Составные упаковки не предлагаются", func(t *testing.T) {
+		quotes, err := module.GetQuote(context.Background(), models.Kilo(1), models.Dimensions{}, models.Rubles(100))
+		require.NoError(t, err)
+		require.NotEmpty(t, quotes)
+		for _, quote := range quotes {
+			assert.Len(t, quote.Package, 1, quote.Package.String())
+		}
+	})
+
+	t.Run("Правила пункта исключают упаковки", func(t *testing.T) {
+		policyModule := NewModule(Deps{Storage: mockStorage, Policy: fixedPolicy{MaxWeight: 5}})
+
+		quotes, err := policyModule.GetQuote(context.Background(), models.Kilo(9), models.Dimensions{}, models.Rubles(100))
+		require.NoError(t, err)
+		assert.Empty(t, quotes)
+	})
+
+	t.Run("Валюта заказа не совпадает с валютой упаковок", func(t *testing.T) {
+		_, err := module.GetQuote(context.Background(), models.Kilo(9), models.Dimensions{}, models.NewMoney(10000, "USD"))
+		assert.ErrorIs(t, err, models.ErrCurrencyMismatch)
+		assert.NotErrorIs(t, err, ErrNoPackage)
+	})
+
+	t.Run("Упаковка auto выбирает самую дешевую", func(t *testing.T) {
+		mockStorage.EXPECT().GetPackage(gomock.Any(), gomock.Any()).DoAndReturn(getTestPackage).AnyTimes()
+		mockStorage.EXPECT().AddOrder(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, order models.Order, event models.OrderEvent, code models.PickupCode, place func(cells []models.CellLoad) (models.CellCode, error)) (models.ID, error) {
+				assert.Equal(t, models.Packaging{"wrap"}, order.Package)
+				assert.Equal(t, models.Rubles(1), order.PackageCost)
+				return models.ID(9), nil
+			})
+
+		_, err := module.AddOrder(context.Background(), models.ExternalRef{Source: "marketplace", Number: "9"}, models.ID(9),
+			time.Now().Add(time.Hour), models.Packaging{models.PackageAuto}, models.Kilo(9), models.Dimensions{}, models.Rubles(100), nil, operator)
+		require.NoError(t, err)
+	})
+
+	t.Run("Для auto не нашлось упаковки", func(t *testing.T) {
+		policyModule := NewModule(Deps{Storage: mockStorage, Policy: fixedPolicy{MaxWeight: 5}})
+
+		_, err := policyModule.AddOrder(context.Background(), models.ExternalRef{Source: "marketplace", Number: "10"}, models.ID(10),
+			time.Now().Add(time.Hour), models.Packaging{models.PackageAuto}, models.Kilo(9), models.Dimensions{}, models.Rubles(100), nil, operator)
+		assert.ErrorIs(t, err, ErrNoPackage)
+
+		_, err = module.AddOrder(context.Background(), models.ExternalRef{Source: "marketplace", Number: "11"}, models.ID(11),
+			time.Now().Add(time.Hour), models.Packaging{models.PackageAuto}, models.Kilo(9), models.Dimensions{}, models.NewMoney(10000, "USD"), nil, operator)
+		assert.ErrorIs(t, err, models.ErrCurrencyMismatch)
+	})
+}
+
+func TestModule_QuoteSize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	catalog := map[models.PackageType]models.PackageSpec{
+		"bag": {Type: "bag", Kind: models.PackageKindContainer, Price: models.Rubles(5), MaxWeight: 10,
+			Dimensions: models.Dimensions{Length: 30, Width: 20, Height: 5}},
+		"box": {Type: "box", Kind: models.PackageKindContainer, Price: models.Rubles(20), MaxWeight: 30,
+			Dimensions: models.Dimensions{Length: 60, Width: 40, Height: 40}},
+	}
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	mockStorage.EXPECT().GetPackages(gomock.Any(), false).Return([]models.PackageSpec{catalog["bag"], catalog["box"]}, nil).AnyTimes()
+	mockStorage.EXPECT().GetPackage(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, pack models.PackageType) (models.PackageSpec, error) {
+			return catalog[pack], nil
+		}).AnyTimes()
+	module := NewModule(Deps{Storage: mockStorage})
+	size := models.Dimensions{Length: 30, Width: 35, Height: 20}
+
+	t.Run("Упаковка, в которую заказ не помещается, не предлагается", func(t *testing.T) {
+		quotes, err := module.GetQuote(context.Background(), models.Kilo(1), size, models.Rubles(100))
+		require.NoError(t, err)
+		require.Len(t, quotes, 1)
+		assert.Equal(t, models.Packaging{"box"}, quotes[0].Package)
+	})
+
+	t.Run("Упаковка auto учитывает размеры заказа", func(t *testing.T) {
+		mockStorage.EXPECT().AddOrder(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, order models.Order, event models.OrderEvent, code models.PickupCode, place func(cells []models.CellLoad) (models.CellCode, error)) (models.ID, error) {
+				assert.Equal(t, models.Packaging{"box"}, order.Package)
+				return models.ID(12), nil
+			})
+
+		_, err := module.AddOrder(context.Background(), models.ExternalRef{Source: "marketplace", Number: "12"}, models.ID(12),
+			time.Now().Add(time.Hour), models.Packaging{models.PackageAuto}, models.Kilo(1), size, models.Rubles(100), nil, operator)
+		require.NoError(t, err)
+	})
+
+	t.Run("Заказ не принимается в упаковку меньше его размеров", func(t *testing.T) {
+		_, err := module.AddOrder(context.Background(), models.ExternalRef{Source: "marketplace", Number: "13"}, models.ID(13),
+			time.Now().Add(time.Hour), models.Packaging{"bag"}, models.Kilo(1), size, models.Rubles(100), nil, operator)
+		assert.ErrorIs(t, err, packaging.ErrSizeExceeded)
+	})
+}
@@ -0,0 +1,204 @@
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework-1/internal/models"
+	"strings"
+	"time"
+)
+
+var (
+	ErrRefundReason    = errors.New("unknown refund reason")
+	ErrRefundDecision  = errors.New("refund decision is not allowed in the current refund state")
+	ErrDecisionComment = errors.New("approval or rejection of a refund must be explained in a comment")
+)
+
+// refundTransitions Таблица допустимых переходов возврата. Принять возврат можно только после осмотра заказа,
+// отклонить и до осмотра, например если клиент передумал на месте.
+var refundTransitions = map[models.RefundState][]models.RefundState{
+	models.RefundRequested: {models.RefundInspected, models.RefundRejected},
+	models.RefundInspected: {models.RefundApproved, models.RefundRejected},
+	models.RefundApproved:  {models.RefundHandedToCourier},
+}
+
+// refundDecisions Этап, в который переводит возврат решение сотрудника.
+var refundDecisions = map[models.RefundDecision]models.RefundState{
+	models.DecisionInspect: models.RefundInspected,
+	models.DecisionApprove: models.RefundApproved,
+	models.DecisionReject:  models.RefundRejected,
+}
+
+// RefundOrder Оформляет возврат выданного заказа. Заказ переходит в статус ожидания решения,
+// деньги клиенту возвращаются только после того, как сотрудник примет возврат в DecideRefund.
+// Если у заказа есть товары, клиент может вернуть только часть из них, перечислив их в itemsId.
+func (m *Module) RefundOrder(ctx context.Context, customerId models.ID, orderId models.ID, reason models.RefundReason, comment string, itemsId []models.ID, operator models.Operator) (models.Refund, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.RefundOrder")
+	defer span.Finish()
+
+	if !reason.Valid() {
+		return models.Refund{}, fmt.Errorf("module.RefundOrder error: %w: %q", ErrRefundReason, reason)
+	}
+
+	order, errGet := m.Storage.GetOrder(ctx, orderId)
+	if errGet != nil {
+		return models.Refund{}, fmt.Errorf("module.RefundOrder error: %w", errGet)
+	}
+
+	now := time.Now()
+	if order.CustomerID != customerId || !m.rules(ctx, order.Package).RefundAllowed(order.ReceivedTime, now) {
+		return models.Refund{}, fmt.Errorf("module.RefundOrder error: %w", ErrRefund)
+	}
+
+	items, errItems := refundItems(order, itemsId)
+	if errItems != nil {
+		return models.Refund{}, fmt.Errorf("module.RefundOrder error: %w", errItems)
+	}
+	amount := order.Cost
+	if len(items) > 0 {
+		itemsCost, errCost := models.ItemsCost(items)
+		if errCost != nil {
+			return models.Refund{}, fmt.Errorf("module.RefundOrder error: %w", errCost)
+		}
+		amount = itemsCost
+	}
+
+	requested, change, errTransit := transit(setItemsStatus(order, itemIds(items), models.ItemIssued, models.ItemRefundRequested),
+		models.StatusRefundRequested, reasonRefundRequested, operator, now)
+	if errTransit != nil {
+		return models.Refund{}, fmt.Errorf("module.RefundOrder error: %w: %w", ErrRefund, errTransit)
+	}
+
+	refund := models.Refund{
+		OrderID:    order.OrderID,
+		CustomerID: order.CustomerID,
+		Reason:     reason,
+		Comment:    comment,
+		State:      models.RefundRequested,
+		Amount:     amount,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if len(items) > 0 {
+		refund.Items = itemIds(items)
+	}
+	refundChange := models.RefundChange{
+		To:        models.RefundRequested,
+		Comment:   comment,
+		Operator:  operator,
+		ChangedAt: now,
+	}
+
+	created, errCreate := m.Storage.CreateRefund(ctx, refund, refundChange, newEvent(models.EventOrderRefundRequested, requested, change))
+	if errCreate != nil {
+		return models.Refund{}, fmt.Errorf("module.RefundOrder error: %w", errCreate)
+	}
+
+	return created, nil
+}
+
+// DecideRefund Применяет решение сотрудника к возврату. Принятие и отклонение без комментария не допускаются:
+// по комментарию пункт выдачи разбирает спор с продавцом. Принятый возврат помечает заказ возвращенным,
+// отклоненный возвращает заказ в статус выданного. Если после возврата части товаров у клиента остались
+// другие товары заказа, заказ остается выданным, а возвращенными помечаются только товары.
+func (m *Module) DecideRefund(ctx context.Context, refundId models.ID, decision models.RefundDecision, comment string, operator models.Operator) (models.Refund, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.DecideRefund")
+	defer span.Finish()
+
+	to, ok := refundDecisions[decision]
+	if !ok {
+		return models.Refund{}, fmt.Errorf("module.DecideRefund error: %w: %q", ErrRefundDecision, decision)
+	}
+	if decision != models.DecisionInspect && strings.TrimSpace(comment) == "" {
+		return models.Refund{}, fmt.Errorf("module.DecideRefund error: %w", ErrDecisionComment)
+	}
+
+	decided, errChange := m.Storage.ChangeRefund(ctx, refundId, func(refund models.Refund, order models.Order) (models.Refund, models.RefundChange, []models.OrderEvent, error) {
+		now := time.Now()
+
+		changed, refundChange, errRefund := refundTransit(refund, to, comment, operator, now)
+		if errRefund != nil {
+			return models.Refund{}, models.RefundChange{}, nil, errRefund
+		}
+
+		var (
+			orderStatus models.Status
+			reason      string
+			eventType   models.EventType
+		)
+		switch to {
+		case models.RefundApproved:
+			order = setItemsStatus(order, refund.Items, models.ItemRefundRequested, models.ItemRefunded)
+			orderStatus, reason, eventType = models.StatusRefunded, reasonRefunded, models.EventOrderRefunded
+			if len(order.ItemsIn(models.ItemIssued, models.ItemRefundRequested)) > 0 {
+				orderStatus, reason = models.StatusIssued, reasonItemsRefunded
+			}
+		case models.RefundRejected:
+			order = setItemsStatus(order, refund.Items, models.ItemRefundRequested, models.ItemIssued)
+			orderStatus, reason, eventType = models.StatusIssued, reasonRefundRejected, models.EventOrderRefundRejected
+		default:
+			return changed, refundChange, nil, nil
+		}
+
+		decidedOrder, change, errTransit := transit(order, orderStatus, reason, operator, now)
+		if errTransit != nil {
+			return models.Refund{}, models.RefundChange{}, nil, fmt.Errorf("%w: %w", ErrRefundDecision, errTransit)
+		}
+
+		return changed, refundChange, []models.OrderEvent{newEvent(eventType, decidedOrder, change)}, nil
+	})
+	if errChange != nil {
+		return models.Refund{}, fmt.Errorf("module.DecideRefund error: %w", errChange)
+	}
+
+	return decided, nil
+}
+
+// GetRefund Возвращает возврат вместе с историей решений по нему.
+func (m *Module) GetRefund(ctx context.Context, refundId models.ID) (models.Refund, []models.RefundChange, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.GetRefund")
+	defer span.Finish()
+
+	refund, errGet := m.Storage.GetRefund(ctx, refundId)
+	if errGet != nil {
+		return models.Refund{}, nil, fmt.Errorf("module.GetRefund error: %w", errGet)
+	}
+
+	history, errHistory := m.Storage.GetRefundHistory(ctx, refundId)
+	if errHistory != nil {
+		return models.Refund{}, nil, fmt.Errorf("module.GetRefund error: %w", errHistory)
+	}
+
+	return refund, history, nil
+}
+
+func canTransitRefund(from models.RefundState, to models.RefundState) bool {
+	for _, allowed := range refundTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// refundTransit Проверяет переход возврата на новый этап и возвращает обновленный возврат вместе с записью для истории.
+func refundTransit(refund models.Refund, to models.RefundState, comment string, operator models.Operator, now time.Time) (models.Refund, models.RefundChange, error) {
+	from := refund.State
+	if !canTransitRefund(from, to) {
+		return models.Refund{}, models.RefundChange{}, fmt.Errorf("%w: %s -> %s", ErrRefundDecision, from, to)
+	}
+
+	refund.State = to
+	refund.UpdatedAt = now
+
+	return refund, models.RefundChange{
+		RefundID:  refund.ID,
+		From:      from,
+		To:        to,
+		Comment:   comment,
+		Operator:  operator,
+		ChangedAt: now,
+	}, nil
+}
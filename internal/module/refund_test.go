@@ -0,0 +1,247 @@
+package module
+
+import (
+	"context"
+	"homework-1/internal/models"
+	"homework-1/internal/storage"
+	mockstorage "homework-1/internal/storage/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModule_RefundOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage})
+
+	t.Run("Успешное оформление возврата", func(t *testing.T) {
+		t.Parallel()
+
+		orderID := models.ID(1)
+		customerID := models.ID(1)
+		order := models.Order{
+			OrderID:            orderID,
+			CustomerID:         customerID,
+			ReceivedByCustomer: true,
+			Refunded:           false,
+			Status:             models.StatusIssued,
+			ReceivedTime:       time.Now().Add(-time.Hour),
+			Cost:               models.Rubles(100),
+			PackageCost:        models.Rubles(20),
+		}
+
+		mockStorage.EXPECT().GetOrder(gomock.Any(), orderID).Return(order, nil)
+		mockStorage.EXPECT().CreateRefund(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, refund models.Refund, change models.RefundChange, event models.OrderEvent) (models.Refund, error) {
+				assert.Equal(t, models.RefundRequested, refund.State)
+				assert.Equal(t, models.RefundReasonDamaged, refund.Reason)
+				assert.Equal(t, models.Rubles(100), refund.Amount)
+				assert.Equal(t, models.RefundRequested, change.To)
+				assert.Equal(t, "разбит экран", change.Comment)
+				assert.False(t, event.Order.Refunded)
+				assert.Equal(t, models.EventOrderRefundRequested, event.Type)
+				assert.Equal(t, models.StatusRefundRequested, event.Change.To)
+				refund.ID = models.ID(5)
+				return refund, nil
+			})
+
+		refund, err := module.RefundOrder(context.Background(), customerID, orderID, models.RefundReasonDamaged, "разбит экран", nil, operator)
+		require.NoError(t, err)
+		assert.Equal(t, models.ID(5), refund.ID)
+	})
+
+	t.Run("Попытка вернуть чужой заказ", func(t *testing.T) {
+		t.Parallel()
+
+		orderID := models.ID(2)
+		customerID := models.ID(1)
+		order := models.Order{
+			OrderID:            orderID,
+			CustomerID:         models.ID(2),
+			ReceivedByCustomer: true,
+			Refunded:           false,
+			Status:             models.StatusIssued,
+			ReceivedTime:       time.Now().Add(-time.Hour),
+		}
+
+		mockStorage.EXPECT().GetOrder(gomock.Any(), orderID).Return(order, nil)
+
+		_, err := module.RefundOrder(context.Background(), customerID, orderID, models.RefundReasonOther, "", nil, operator)
+		assert.ErrorIs(t, err, ErrRefund)
+	})
+
+	t.Run("Срок возврата задается правилами пункта", func(t *testing.T) {
+		t.Parallel()
+
+		orderID := models.ID(4)
+		customerID := models.ID(1)
+		order := models.Order{
+			OrderID:            orderID,
+			CustomerID:         customerID,
+			ReceivedByCustomer: true,
+			Status:             models.StatusIssued,
+			ReceivedTime:       time.Now().AddDate(0, 0, -10),
+		}
+		longWindow := NewModule(Deps{Storage: mockStorage, Policy: fixedPolicy{RefundWindow: 14 * 24 * time.Hour}})
+
+		mockStorage.EXPECT().GetOrder(gomock.Any(), orderID).Return(order, nil).Times(2)
+		mockStorage.EXPECT().CreateRefund(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(models.Refund{ID: models.ID(6)}, nil)
+
+		_, err := module.RefundOrder(context.Background(), customerID, orderID, models.RefundReasonDefective, "", nil, operator)
+		assert.ErrorIs(t, err, ErrRefund)
+
+		refund, err := longWindow.RefundOrder(context.Background(), customerID, orderID, models.RefundReasonDefective, "", nil, operator)
+		require.NoError(t, err)
+		assert.Equal(t, models.ID(6), refund.ID)
+	})
+
+	t.Run("Неизвестная причина возврата", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := module.RefundOrder(context.Background(), models.ID(1), models.ID(3), models.RefundReason("unknown"), "", nil, operator)
+		assert.ErrorIs(t, err, ErrRefundReason)
+	})
+}
+
+func TestModule_DecideRefund(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage})
+
+	issued := models.Order{
+		OrderID:            models.ID(1),
+		CustomerID:         models.ID(1),
+		ReceivedByCustomer: true,
+		Status:             models.StatusRefundRequested,
+		ReceivedTime:       time.Now().Add(-time.Hour),
+	}
+
+	// applyDecision Подставляет в ChangeRefund возврат и заказ и возвращает то, что решил модуль.
+	applyDecision := func(refund models.Refund, order models.Order, result *[]models.OrderEvent, change *models.RefundChange) {
+		mockStorage.EXPECT().ChangeRefund(gomock.Any(), refund.ID, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, refundId models.ID, f func(models.Refund, models.Order) (models.Refund, models.RefundChange, []models.OrderEvent, error)) (models.Refund, error) {
+				changed, refundChange, events, err := f(refund, order)
+				*result = events
+				*change = refundChange
+				return changed, err
+			})
+	}
+
+	t.Run("Осмотр не меняет заказ", func(t *testing.T) {
+		refund := models.Refund{ID: models.ID(1), OrderID: issued.OrderID, State: models.RefundRequested}
+
+		var (
+			events []models.OrderEvent
+			change models.RefundChange
+		)
+		applyDecision(refund, issued, &events, &change)
+
+		decided, err := module.DecideRefund(context.Background(), refund.ID, models.DecisionInspect, "", operator)
+		require.NoError(t, err)
+		assert.Equal(t, models.RefundInspected, decided.State)
+		assert.Empty(t, events)
+		assert.Equal(t, models.RefundRequested, change.From)
+		assert.Equal(t, models.RefundInspected, change.To)
+	})
+
+	t.Run("Принятие возврата помечает заказ возвращенным", func(t *testing.T) {
+		refund := models.Refund{ID: models.ID(2), OrderID: issued.OrderID, State: models.RefundInspected}
+
+		var (
+			events []models.OrderEvent
+			change models.RefundChange
+		)
+		applyDecision(refund, issued, &events, &change)
+
+		decided, err := module.DecideRefund(context.Background(), refund.ID, models.DecisionApprove, "следы удара на корпусе", operator)
+		require.NoError(t, err)
+		assert.Equal(t, models.RefundApproved, decided.State)
+		assert.Equal(t, "следы удара на корпусе", change.Comment)
+		assert.Equal(t, operator, change.Operator)
+		require.Len(t, events, 1)
+		assert.Equal(t, models.EventOrderRefunded, events[0].Type)
+		assert.True(t, events[0].Order.Refunded)
+		assert.Equal(t, models.StatusRefunded, events[0].Order.Status)
+	})
+
+	t.Run("Отклонение возвращает заказ в статус выданного", func(t *testing.T) {
+		refund := models.Refund{ID: models.ID(3), OrderID: issued.OrderID, State: models.RefundRequested}
+
+		var (
+			events []models.OrderEvent
+			change models.RefundChange
+		)
+		applyDecision(refund, issued, &events, &change)
+
+		decided, err := module.DecideRefund(context.Background(), refund.ID, models.DecisionReject, "заказ использовался", operator)
+		require.NoError(t, err)
+		assert.Equal(t, models.RefundRejected, decided.State)
+		require.Len(t, events, 1)
+		assert.Equal(t, models.EventOrderRefundRejected, events[0].Type)
+		assert.False(t, events[0].Order.Refunded)
+		assert.Equal(t, models.StatusIssued, events[0].Order.Status)
+	})
+
+	t.Run("Принятие без осмотра", func(t *testing.T) {
+		refund := models.Refund{ID: models.ID(4), OrderID: issued.OrderID, State: models.RefundRequested}
+
+		var (
+			events []models.OrderEvent
+			change models.RefundChange
+		)
+		applyDecision(refund, issued, &events, &change)
+
+		_, err := module.DecideRefund(context.Background(), refund.ID, models.DecisionApprove, "все в порядке", operator)
+		assert.ErrorIs(t, err, ErrRefundDecision)
+	})
+
+	t.Run("Решение без комментария", func(t *testing.T) {
+		_, err := module.DecideRefund(context.Background(), models.ID(5), models.DecisionReject, "  ", operator)
+		assert.ErrorIs(t, err, ErrDecisionComment)
+	})
+}
+
+func TestModule_ReturnRefundedOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage})
+
+	t.Run("Возврат передается курьеру вместе с заказом", func(t *testing.T) {
+		order := models.Order{OrderID: models.ID(1), Status: models.StatusRefunded, Refunded: true}
+		refund := models.Refund{ID: models.ID(7), OrderID: order.OrderID, State: models.RefundApproved}
+
+		mockStorage.EXPECT().GetOrder(gomock.Any(), order.OrderID).Return(order, nil)
+		mockStorage.EXPECT().GetOrderRefunds(gomock.Any(), order.OrderID, models.RefundApproved).Return([]models.Refund{refund}, nil)
+		mockStorage.EXPECT().ReturnOrder(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, event models.OrderEvent, handed []models.RefundChange) (models.Order, error) {
+				require.Len(t, handed, 1)
+				assert.Equal(t, refund.ID, handed[0].RefundID)
+				assert.Equal(t, models.RefundApproved, handed[0].From)
+				assert.Equal(t, models.RefundHandedToCourier, handed[0].To)
+				return order, nil
+			})
+
+		_, err := module.ReturnOrder(context.Background(), order.OrderID, operator)
+		require.NoError(t, err)
+	})
+
+	t.Run("Возврат не найден", func(t *testing.T) {
+		order := models.Order{OrderID: models.ID(2), Status: models.StatusRefunded, Refunded: true}
+
+		mockStorage.EXPECT().GetOrder(gomock.Any(), order.OrderID).Return(order, nil)
+		mockStorage.EXPECT().GetOrderRefunds(gomock.Any(), order.OrderID, models.RefundApproved).Return(nil, nil)
+
+		_, err := module.ReturnOrder(context.Background(), order.OrderID, operator)
+		assert.ErrorIs(t, err, storage.ErrRefundNotFound)
+	})
+}
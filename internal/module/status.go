@@ -0,0 +1,123 @@
+package module
+
+import (
+	"errors"
+	"fmt"
+	"homework-1/internal/models"
+	"time"
+)
+
+var (
+	ErrTransition = errors.New("order status transition is not allowed")
+)
+
+const (
+	reasonAccepted        = "accepted from courier"
+	reasonIssued          = "issued to customer"
+	reasonRefundRequested = "refund requested by customer"
+	reasonRefundRejected  = "refund rejected"
+	reasonRefunded        = "refunded by customer"
+	reasonReturned        = "returned to courier"
+	reasonDeclined        = "declined by customer at pickup"
+	reasonItemsRefunded   = "items refunded by customer"
+	reasonItemsReturned   = "declined and refunded items returned to courier"
+	reasonTryOnStarted    = "handed to customer for try-on"
+	reasonTryOnRejected   = "rejected after try-on"
+	reasonTryOnReturned   = "rejected after try-on, awaits courier"
+	reasonTryOnTimedOut   = "try-on timed out"
+	reasonExpired         = "storage period expired"
+)
+
+// transitions Таблица допустимых переходов между статусами заказа.
+// Любой переход, которого нет в таблице, завершается ошибкой ErrTransition.
+var transitions = map[models.Status][]models.Status{
+	models.StatusAccepted:        {models.StatusReadyForPickup, models.StatusIssued, models.StatusTryingOn, models.StatusDeclined, models.StatusExpired},
+	models.StatusReadyForPickup:  {models.StatusIssued, models.StatusTryingOn, models.StatusDeclined, models.StatusExpired},
+	models.StatusTryingOn:        {models.StatusIssued, models.StatusReadyForPickup, models.StatusDeclined},
+	models.StatusIssued:          {models.StatusRefundRequested},
+	models.StatusRefundRequested: {models.StatusRefunded, models.StatusIssued},
+	models.StatusRefunded:        {models.StatusReturnedToCourier},
+	models.StatusExpired:         {models.StatusReturnedToCourier},
+	models.StatusDeclined:        {models.StatusReturnedToCourier},
+}
+
+func canTransit(from models.Status, to models.Status) bool {
+	for _, allowed := range transitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// currentStatus Возвращает фактический статус заказа: заказ, ожидающий выдачи дольше срока хранения, считается просроченным.
+// Заказ на примерке не просрочивается, пока клиент его не вернет: после возврата на полку срок хранения проверяется снова.
+func currentStatus(order models.Order, now time.Time) models.Status {
+	if (order.Status == models.StatusAccepted || order.Status == models.StatusReadyForPickup) &&
+		order.ExpirationTime.Before(now) {
+		return models.StatusExpired
+	}
+	return order.Status
+}
+
+// expire Переход в статус просрочки, который currentStatus до сих пор только вычислял. Переход датируется окончанием
+// срока хранения, а не моментом записи, чтобы история показывала, когда заказ на самом деле стал просроченным.
+// Если просрочка уже записана или срок хранения не истек, ok равен false.
+func expire(order models.Order, operator models.Operator, now time.Time) (expired models.Order, change models.StatusChange, ok bool) {
+	if order.Status == models.StatusExpired || currentStatus(order, now) != models.StatusExpired {
+		return order, models.StatusChange{}, false
+	}
+
+	change = models.StatusChange{
+		OrderID:   order.OrderID,
+		From:      order.Status,
+		To:        models.StatusExpired,
+		Reason:    reasonExpired,
+		Operator:  operator,
+		ChangedAt: order.ExpirationTime,
+	}
+	order.Status = models.StatusExpired
+	return order, change, true
+}
+
+// transit Проверяет переход заказа в новый статус и возвращает обновленный заказ вместе с записью для истории.
+func transit(order models.Order, to models.Status, reason string, operator models.Operator, now time.Time) (models.Order, models.StatusChange, error) {
+	from := currentStatus(order, now)
+	if !canTransit(from, to) {
+		return models.Order{}, models.StatusChange{}, fmt.Errorf("%w: %s -> %s", ErrTransition, from, to)
+	}
+
+	order.Status = to
+	if from == models.StatusTryingOn {
+		order.TryOnUntil = time.Time{}
+	}
+	switch to {
+	case models.StatusIssued:
+		// Клиент забрал заказ, и ячейка освобождается для других заказов.
+		order.Cell = ""
+		if !order.ReceivedByCustomer {
+			order.ReceivedByCustomer = true
+			order.ReceivedTime = now
+		}
+	case models.StatusRefunded:
+		order.Refunded = true
+		order.RefundedTime = now
+	}
+
+	return order, models.StatusChange{
+		OrderID:   order.OrderID,
+		From:      from,
+		To:        to,
+		Reason:    reason,
+		Operator:  operator,
+		ChangedAt: now,
+	}, nil
+}
+
+func newEvent(eventType models.EventType, order models.Order, change models.StatusChange) models.OrderEvent {
+	return models.OrderEvent{
+		Type:   eventType,
+		Order:  order,
+		Change: change,
+	}
+}
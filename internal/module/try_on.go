@@ -0,0 +1,199 @@
+package module
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework-1/internal/models"
+	"time"
+)
+
+var (
+	ErrTryOn        = errors.New("orders are not on try-on or belong to different customers")
+	ErrTryOnTimeout = errors.New("try-on time is over, the order will be returned to the shelf")
+	ErrTryOnOutcome = errors.New("unknown try-on outcome")
+)
+
+// defaultTryOnTimeout Время примерки, если оно не задано в Deps.
+const defaultTryOnTimeout = 20 * time.Minute
+
+func (m *Module) tryOnTimeout() time.Duration {
+	if m.TryOnTimeout <= 0 {
+		return defaultTryOnTimeout
+	}
+	return m.TryOnTimeout
+}
+
+// StartTryOn Отдает клиенту пачку заказов в примерочную. Проверки те же, что у ReceiveOrders: код выдачи,
+// один клиент на пачку, все заказы ждут выдачи. Заказы резервируются за клиентом до TryOnUntil,
+// решение по каждому заказу принимает ConfirmTryOn. Если клиент не вернулся вовремя, заказы возвращает
+// на полку ReleaseTryOns.
+func (m *Module) StartTryOn(ctx context.Context, ordersId []models.ID, code string, operator models.Operator) ([]models.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.StartTryOn")
+	defer span.Finish()
+
+	used, errVerify := m.verifyReceiver(ctx, ordersId, code)
+	if errVerify != nil {
+		return nil, fmt.Errorf("module.StartTryOn error: %w", errVerify)
+	}
+
+	var reserved []models.Order
+	errChange := m.handOver(ctx, ordersId, used, func(orders []models.Order) ([]models.OrderEvent, error) {
+		batch, errBatch := m.receiverBatch(orders, ordersId, used.CustomerID)
+		if errBatch != nil {
+			return nil, errBatch
+		}
+
+		now := time.Now()
+		until := now.Add(m.tryOnTimeout())
+
+		reserved = make([]models.Order, 0, len(batch))
+		events := make([]models.OrderEvent, 0, len(batch))
+		for _, order := range batch {
+			trying, change, errTransit := transit(order, models.StatusTryingOn, reasonTryOnStarted, operator, now)
+			if errTransit != nil {
+				return nil, fmt.Errorf("%w: %w", ErrReceive, errTransit)
+			}
+			trying.TryOnUntil = until
+
+			reserved = append(reserved, trying)
+			events = append(events, newEvent(models.EventOrderTryOnStarted, trying, change))
+		}
+
+		return events, nil
+	})
+	if errChange != nil {
+		return nil, fmt.Errorf("module.StartTryOn error: %w", errChange)
+	}
+
+	return reserved, nil
+}
+
+// ConfirmTryOn Применяет решения клиента по заказам из примерочной в одной транзакции. Забранные заказы
+// выдаются как в ReceiveOrders, в том числе с отказом от товаров declined. Отклоненные заказы возвращаются
+// на полку или, если клиент отказался от них совсем, ждут возврата курьеру. Возврат по таким заказам
+// не оформляется: клиент их не получал.
+// Код выдачи повторно не нужен, клиент подтвердил его в StartTryOn. После окончания времени примерки
+// решение не принимается, заказы возвращаются на полку.
+func (m *Module) ConfirmTryOn(ctx context.Context, decisions []models.TryOnDecision, declined []models.ID, operator models.Operator) ([]models.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.ConfirmTryOn")
+	defer span.Finish()
+
+	if len(decisions) == 0 {
+		return nil, fmt.Errorf("module.ConfirmTryOn error: %w", ErrTryOn)
+	}
+
+	ordersId := make([]models.ID, 0, len(decisions))
+	outcomes := make(map[models.ID]models.TryOnOutcome, len(decisions))
+	for _, decision := range decisions {
+		if !decision.Outcome.Valid() {
+			return nil, fmt.Errorf("module.ConfirmTryOn error: %w: %q", ErrTryOnOutcome, decision.Outcome)
+		}
+		ordersId = append(ordersId, decision.OrderID)
+		outcomes[decision.OrderID] = decision.Outcome
+	}
+
+	var decided []models.Order
+	errChange := m.Storage.ChangeStatuses(ctx, ordersId, func(orders []models.Order) ([]models.OrderEvent, error) {
+		batch, errBatch := customerBatch(orders, ordersId)
+		if errBatch != nil {
+			return nil, fmt.Errorf("%w: %w", ErrTryOn, errBatch)
+		}
+
+		now := time.Now()
+		declinedItems := idSet(declined)
+
+		decided = make([]models.Order, 0, len(batch))
+		events := make([]models.OrderEvent, 0, len(batch))
+		for _, order := range batch {
+			if order.Status != models.StatusTryingOn {
+				return nil, fmt.Errorf("%w: order %d is %s", ErrTryOn, order.OrderID, order.Status)
+			}
+			if order.TryOnUntil.Before(now) {
+				return nil, fmt.Errorf("%w: order %d", ErrTryOnTimeout, order.OrderID)
+			}
+
+			var (
+				to        models.Status
+				reason    string
+				eventType models.EventType
+			)
+			switch outcomes[order.OrderID] {
+			case models.TryOnKeep:
+				to, reason, eventType = models.StatusIssued, reasonIssued, models.EventOrderReceived
+				var allDeclined bool
+				order, allDeclined = receiveItems(order, declinedItems)
+				if allDeclined {
+					to, reason, eventType = models.StatusDeclined, reasonDeclined, models.EventOrderDeclined
+				}
+			case models.TryOnReject:
+				to, reason, eventType = models.StatusReadyForPickup, reasonTryOnRejected, models.EventOrderTryOnRejected
+			case models.TryOnReturn:
+				to, reason, eventType = models.StatusDeclined, reasonTryOnReturned, models.EventOrderDeclined
+				order = declineItems(order)
+			}
+
+			decidedOrder, change, errTransit := transit(order, to, reason, operator, now)
+			if errTransit != nil {
+				return nil, fmt.Errorf("%w: %w", ErrTryOn, errTransit)
+			}
+
+			decided = append(decided, decidedOrder)
+			events = append(events, newEvent(eventType, decidedOrder, change))
+		}
+
+		if len(declinedItems) > 0 {
+			return nil, fmt.Errorf("%w: declined items are not in the kept orders", ErrItems)
+		}
+
+		return events, nil
+	})
+	if errChange != nil {
+		return nil, fmt.Errorf("module.ConfirmTryOn error: %w", errChange)
+	}
+
+	return decided, nil
+}
+
+// ReleaseTryOns Возвращает на полку не более limit заказов, время примерки которых вышло, и возвращает эти заказы.
+// Заказ, по которому клиент успел принять решение, пока шла проверка, не меняется. Если за время примерки
+// истек срок хранения, вернувшийся на полку заказ считается просроченным и уходит курьеру обычным путем.
+func (m *Module) ReleaseTryOns(ctx context.Context, limit int, operator models.Operator) ([]models.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "module.Module.ReleaseTryOns")
+	defer span.Finish()
+
+	now := time.Now()
+	ordersId, errGet := m.Storage.GetTimedOutTryOns(ctx, now, limit)
+	if errGet != nil {
+		return nil, fmt.Errorf("module.ReleaseTryOns error: %w", errGet)
+	}
+	if len(ordersId) == 0 {
+		return nil, nil
+	}
+
+	var released []models.Order
+	errChange := m.Storage.ChangeStatuses(ctx, ordersId, func(orders []models.Order) ([]models.OrderEvent, error) {
+		released = make([]models.Order, 0, len(orders))
+		events := make([]models.OrderEvent, 0, len(orders))
+		for _, order := range orders {
+			if order.Status != models.StatusTryingOn || !order.TryOnUntil.Before(now) {
+				continue
+			}
+
+			back, change, errTransit := transit(order, models.StatusReadyForPickup, reasonTryOnTimedOut, operator, now)
+			if errTransit != nil {
+				return nil, errTransit
+			}
+			released = append(released, back)
+			events = append(events, newEvent(models.EventOrderTryOnTimedOut, back, change))
+		}
+
+		return events, nil
+	})
+	if errChange != nil {
+		return nil, fmt.Errorf("module.ReleaseTryOns error: %w", errChange)
+	}
+
+	return released, nil
+}
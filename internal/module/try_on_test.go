@@ -0,0 +1,208 @@
+package module
+
+import (
+	"context"
+	"homework-1/internal/models"
+	mockstorage "homework-1/internal/storage/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tryOnOrder Заказ клиента 1, который примеряют до until.
+func tryOnOrder(orderID models.ID, until time.Time) models.Order {
+	order := itemsOrder(orderID, models.StatusTryingOn, models.ItemAtPoint, models.ItemAtPoint)
+	order.ReceivedByCustomer = false
+	order.TryOnUntil = until
+	return order
+}
+
+func TestModule_StartTryOn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage, TryOnTimeout: 15 * time.Minute})
+
+	t.Run("Заказы резервируются на время примерки", func(t *testing.T) {
+		order := itemsOrder(models.ID(1), models.StatusAccepted, models.ItemAtPoint, models.ItemAtPoint)
+
+		var events []models.OrderEvent
+		mockStorage.EXPECT().ChangeStatuses(gomock.Any(), []models.ID{order.OrderID}, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ids []models.ID, change func([]models.Order) ([]models.OrderEvent, error)) error {
+				changed, err := change([]models.Order{order})
+				events = changed
+				return err
+			})
+
+		reserved, err := module.StartTryOn(context.Background(), []models.ID{order.OrderID}, "", operator)
+		require.NoError(t, err)
+		require.Len(t, reserved, 1)
+		assert.Equal(t, models.StatusTryingOn, reserved[0].Status)
+		assert.False(t, reserved[0].ReceivedByCustomer)
+		assert.WithinDuration(t, time.Now().Add(15*time.Minute), reserved[0].TryOnUntil, time.Minute)
+		require.Len(t, events, 1)
+		assert.Equal(t, models.EventOrderTryOnStarted, events[0].Type)
+	})
+
+	t.Run("Выданный заказ нельзя отдать на примерку", func(t *testing.T) {
+		order := itemsOrder(models.ID(2), models.StatusIssued, models.ItemIssued, models.ItemIssued)
+
+		mockStorage.EXPECT().ChangeStatuses(gomock.Any(), []models.ID{order.OrderID}, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ids []models.ID, change func([]models.Order) ([]models.OrderEvent, error)) error {
+				_, err := change([]models.Order{order})
+				return err
+			})
+
+		_, err := module.StartTryOn(context.Background(), []models.ID{order.OrderID}, "", operator)
+		assert.ErrorIs(t, err, ErrReceive)
+	})
+}
+
+func TestModule_ConfirmTryOn(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage})
+
+	// confirm Подставляет заказы в ChangeStatuses и возвращает события, которые сформировал модуль.
+	confirm := func(orders []models.Order, events *[]models.OrderEvent) {
+		mockStorage.EXPECT().ChangeStatuses(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ids []models.ID, change func([]models.Order) ([]models.OrderEvent, error)) error {
+				changed, err := change(orders)
+				*events = changed
+				return err
+			})
+	}
+
+	t.Run("Клиент забирает, возвращает на полку и отказывается от заказов", func(t *testing.T) {
+		until := time.Now().Add(10 * time.Minute)
+		kept, rejected, returned := tryOnOrder(models.ID(1), until), tryOnOrder(models.ID(2), until), tryOnOrder(models.ID(3), until)
+		rejected.Items, returned.Items = nil, nil
+
+		var events []models.OrderEvent
+		confirm([]models.Order{kept, rejected, returned}, &events)
+
+		decided, err := module.ConfirmTryOn(context.Background(), []models.TryOnDecision{
+			{OrderID: kept.OrderID, Outcome: models.TryOnKeep},
+			{OrderID: rejected.OrderID, Outcome: models.TryOnReject},
+			{OrderID: returned.OrderID, Outcome: models.TryOnReturn},
+		}, []models.ID{11}, operator)
+		require.NoError(t, err)
+		require.Len(t, decided, 3)
+
+		assert.Equal(t, models.StatusIssued, decided[0].Status)
+		assert.True(t, decided[0].ReceivedByCustomer)
+		assert.Equal(t, models.ItemIssued, decided[0].Items[0].Status)
+		assert.Equal(t, models.ItemDeclined, decided[0].Items[1].Status)
+		assert.Equal(t, models.StatusReadyForPickup, decided[1].Status)
+		assert.Equal(t, models.StatusDeclined, decided[2].Status)
+		for _, order := range decided {
+			assert.True(t, order.TryOnUntil.IsZero())
+		}
+
+		require.Len(t, events, 3)
+		assert.Equal(t, models.EventOrderReceived, events[0].Type)
+		assert.Equal(t, models.EventOrderTryOnRejected, events[1].Type)
+		assert.Equal(t, models.EventOrderDeclined, events[2].Type)
+	})
+
+	t.Run("Отказ от заказа переводит его товары в отказные", func(t *testing.T) {
+		order := tryOnOrder(models.ID(4), time.Now().Add(10*time.Minute))
+
+		var events []models.OrderEvent
+		confirm([]models.Order{order}, &events)
+
+		decided, err := module.ConfirmTryOn(context.Background(),
+			[]models.TryOnDecision{{OrderID: order.OrderID, Outcome: models.TryOnReturn}}, nil, operator)
+		require.NoError(t, err)
+		assert.Equal(t, models.StatusDeclined, decided[0].Status)
+		assert.Equal(t, models.ItemDeclined, decided[0].Items[0].Status)
+		assert.Equal(t, models.ItemDeclined, decided[0].Items[1].Status)
+	})
+
+	t.Run("Заказ не на примерке", func(t *testing.T) {
+		order := itemsOrder(models.ID(5), models.StatusAccepted, models.ItemAtPoint, models.ItemAtPoint)
+
+		var events []models.OrderEvent
+		confirm([]models.Order{order}, &events)
+
+		_, err := module.ConfirmTryOn(context.Background(),
+			[]models.TryOnDecision{{OrderID: order.OrderID, Outcome: models.TryOnKeep}}, nil, operator)
+		assert.ErrorIs(t, err, ErrTryOn)
+		assert.Empty(t, events)
+	})
+
+	t.Run("Время примерки вышло", func(t *testing.T) {
+		order := tryOnOrder(models.ID(6), time.Now().Add(-time.Minute))
+
+		var events []models.OrderEvent
+		confirm([]models.Order{order}, &events)
+
+		_, err := module.ConfirmTryOn(context.Background(),
+			[]models.TryOnDecision{{OrderID: order.OrderID, Outcome: models.TryOnKeep}}, nil, operator)
+		assert.ErrorIs(t, err, ErrTryOnTimeout)
+		assert.Empty(t, events)
+	})
+
+	t.Run("Отказ от товара не из забранных заказов", func(t *testing.T) {
+		order := tryOnOrder(models.ID(7), time.Now().Add(10*time.Minute))
+
+		var events []models.OrderEvent
+		confirm([]models.Order{order}, &events)
+
+		_, err := module.ConfirmTryOn(context.Background(),
+			[]models.TryOnDecision{{OrderID: order.OrderID, Outcome: models.TryOnReject}}, []models.ID{10}, operator)
+		assert.ErrorIs(t, err, ErrItems)
+		assert.Empty(t, events)
+	})
+
+	t.Run("Неизвестное решение", func(t *testing.T) {
+		_, err := module.ConfirmTryOn(context.Background(),
+			[]models.TryOnDecision{{OrderID: models.ID(8), Outcome: models.TryOnOutcome("maybe")}}, nil, operator)
+		assert.ErrorIs(t, err, ErrTryOnOutcome)
+	})
+}
+
+func TestModule_ReleaseTryOns(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mockstorage.NewMockStorage(ctrl)
+	module := NewModule(Deps{Storage: mockStorage})
+
+	t.Run("Заказы с истекшим временем примерки возвращаются на полку", func(t *testing.T) {
+		timedOut := tryOnOrder(models.ID(1), time.Now().Add(-time.Minute))
+		decided := itemsOrder(models.ID(2), models.StatusIssued, models.ItemIssued, models.ItemIssued)
+
+		var events []models.OrderEvent
+		mockStorage.EXPECT().GetTimedOutTryOns(gomock.Any(), gomock.Any(), 10).Return([]models.ID{timedOut.OrderID, decided.OrderID}, nil)
+		mockStorage.EXPECT().ChangeStatuses(gomock.Any(), []models.ID{timedOut.OrderID, decided.OrderID}, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, ids []models.ID, change func([]models.Order) ([]models.OrderEvent, error)) error {
+				changed, err := change([]models.Order{timedOut, decided})
+				events = changed
+				return err
+			})
+
+		released, err := module.ReleaseTryOns(context.Background(), 10, operator)
+		require.NoError(t, err)
+		require.Len(t, released, 1)
+		assert.Equal(t, timedOut.OrderID, released[0].OrderID)
+		assert.Equal(t, models.StatusReadyForPickup, released[0].Status)
+		assert.True(t, released[0].TryOnUntil.IsZero())
+		require.Len(t, events, 1)
+		assert.Equal(t, models.EventOrderTryOnTimedOut, events[0].Type)
+	})
+
+	t.Run("Нет заказов с истекшим временем примерки", func(t *testing.T) {
+		mockStorage.EXPECT().GetTimedOutTryOns(gomock.Any(), gomock.Any(), 10).Return(nil, nil)
+
+		released, err := module.ReleaseTryOns(context.Background(), 10, operator)
+		require.NoError(t, err)
+		assert.Empty(t, released)
+	})
+}
@@ -0,0 +1,32 @@
+package expiry
+
+import (
+	"context"
+	"homework-1/internal/models"
+	"homework-1/internal/services/sweeper"
+	"time"
+)
+
+// expiryOperator Под этим оператором в истории заказа записывается окончание срока хранения.
+const expiryOperator = models.Operator("storage-expiry")
+
+type Deps = sweeper.Deps
+
+// Sweeper Периодически записывает в историю просрочку заказов, срок хранения которых истек, чтобы в истории
+// было видно, когда заказ стал просроченным, а не только его возврат курьеру.
+type Sweeper struct {
+	*sweeper.Sweeper
+}
+
+func NewSweeper(d Deps, interval time.Duration, batchSize int) *Sweeper {
+	expire := func(ctx context.Context, limit int) ([]models.Order, error) {
+		return d.Module.ExpireOrders(ctx, limit, expiryOperator)
+	}
+	return &Sweeper{Sweeper: sweeper.NewSweeper(d, "expiry.Sweeper", expire, interval, batchSize)}
+}
+
+// ExpireOverdue Записывает просрочку всех заказов с истекшим сроком хранения во всех пунктах выдачи и возвращает
+// их количество.
+func (s *Sweeper) ExpireOverdue(ctx context.Context) (int, error) {
+	return s.Sweep(ctx)
+}
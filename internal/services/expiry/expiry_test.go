@@ -0,0 +1,70 @@
+package expiry
+
+import (
+	"context"
+	"errors"
+	"homework-1/internal/cache"
+	mockcache "homework-1/internal/cache/mocks"
+	"homework-1/internal/models"
+	mockmodule "homework-1/internal/module/mocks"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pointMatcher Проверяет, что запрос к модулю выполняется в границах пункта point.
+type pointMatcher models.PointID
+
+func (m pointMatcher) Matches(x interface{}) bool {
+	ctx, ok := x.(context.Context)
+	return ok && models.PointFromContext(ctx) == models.PointID(m)
+}
+
+func (m pointMatcher) String() string {
+	return "context with point " + string(m)
+}
+
+func TestSweeper_ExpireOverdue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	mockCache := mockcache.NewMockCacheInterface(ctrl)
+	sweeper := NewSweeper(Deps{Module: mockModule, Redis: mockCache}, 0, 2)
+
+	points := []models.PickupPoint{{ID: "msk-1"}, {ID: "spb-1"}}
+
+	t.Run("Пачки запрашиваются, пока очередная не окажется неполной", func(t *testing.T) {
+		mockModule.EXPECT().PickupPoints(gomock.Any()).Return(points[:1], nil)
+		gomock.InOrder(
+			mockModule.EXPECT().ExpireOrders(pointMatcher("msk-1"), 2, expiryOperator).Return([]models.Order{
+				{OrderID: 1, CustomerID: 10},
+				{OrderID: 2, CustomerID: 10},
+			}, nil),
+			mockModule.EXPECT().ExpireOrders(pointMatcher("msk-1"), 2, expiryOperator).Return([]models.Order{
+				{OrderID: 3, CustomerID: 20},
+			}, nil),
+		)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("msk-1", 10)).Return(nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("msk-1", 20)).Return(nil)
+
+		expired, err := sweeper.ExpireOverdue(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 3, expired)
+	})
+
+	t.Run("Ошибка в одном пункте не мешает остальным", func(t *testing.T) {
+		mockModule.EXPECT().PickupPoints(gomock.Any()).Return(points, nil)
+		mockModule.EXPECT().ExpireOrders(pointMatcher("msk-1"), 2, expiryOperator).Return(nil, errors.New("connection refused"))
+		mockModule.EXPECT().ExpireOrders(pointMatcher("spb-1"), 2, expiryOperator).Return([]models.Order{
+			{OrderID: 5, CustomerID: 10},
+		}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("spb-1", 10)).Return(nil)
+
+		expired, err := sweeper.ExpireOverdue(context.Background())
+		require.Error(t, err)
+		assert.Equal(t, 1, expired)
+	})
+}
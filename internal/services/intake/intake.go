@@ -0,0 +1,172 @@
+package intake
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"homework-1/internal/cache"
+	"homework-1/internal/infrastructure/messaging/messages"
+	"homework-1/internal/metrics"
+	"homework-1/internal/models"
+	"homework-1/internal/module"
+	"homework-1/internal/services/packaging"
+	"homework-1/internal/services/shelving"
+	"homework-1/internal/storage"
+	"log"
+	"strconv"
+	"time"
+)
+
+// Причины отказа в приеме позиции манифеста. Передаются логистической системе в ответном сообщении.
+const (
+	ReasonBadPackage      = "bad_package"
+	ReasonOverweight      = "overweight"
+	ReasonUnderweight     = "underweight"
+	ReasonDuplicateID     = "duplicate_id"
+	ReasonWrongExpiration = "wrong_expiration"
+	ReasonInvalidItem     = "invalid_item"
+	ReasonNoFreeCell      = "no_free_cell"
+	ReasonInternal        = "internal"
+)
+
+const intakeOperator = models.Operator("delivery-intake")
+
+var (
+	errInvalidItem = errors.New("empty or non-positive order or customer id, negative weight or cost")
+)
+
+type ResultSender interface {
+	SendManifestResult(result *messages.ManifestResult) error
+}
+
+type Deps struct {
+	Module module.ModuleInterface
+	Sender ResultSender
+	Redis  cache.CacheInterface
+}
+
+// Intake Принимает заказы из манифестов поставки, которые курьерская служба присылает через Kafka.
+// Каждая позиция регистрируется независимо, поэтому ошибка в одной из них не мешает принять остальные.
+type Intake struct {
+	Deps
+}
+
+func NewIntake(d Deps) *Intake {
+	return &Intake{Deps: d}
+}
+
+// Handle Разбирает сообщение с манифестом, регистрирует заказы и отправляет результат в топик ответов.
+func (i *Intake) Handle(value []byte) {
+	var manifest messages.DeliveryManifest
+	if err := json.Unmarshal(value, &manifest); err != nil {
+		log.Printf("intake.Handle error: %s\n", err)
+		return
+	}
+
+	result := i.ProcessManifest(context.Background(), manifest)
+	if err := i.Sender.SendManifestResult(&result); err != nil {
+		log.Printf("intake.Handle error: %s\n", err)
+	}
+}
+
+func (i *Intake) ProcessManifest(ctx context.Context, manifest messages.DeliveryManifest) messages.ManifestResult {
+	result := messages.ManifestResult{
+		ManifestID: manifest.ManifestID,
+		Items:      make([]messages.ManifestItemResult, 0, len(manifest.Items)),
+	}
+
+	point := models.PointID(manifest.PointID)
+	if point == "" {
+		point = models.DefaultPoint
+	}
+	ctx = models.WithPoint(ctx, point)
+
+	accepted := 0
+	for _, item := range manifest.Items {
+		itemResult := messages.ManifestItemResult{
+			OrderID:  item.OrderID,
+			Source:   item.Source,
+			Accepted: true,
+		}
+
+		order, err := i.addOrder(ctx, item)
+		if err != nil {
+			itemResult.Accepted = false
+			itemResult.Reason = reason(err)
+			itemResult.Error = err.Error()
+		} else {
+			itemResult.AssignedOrderID = int64(order.OrderID)
+			itemResult.AssignedCell = string(order.Cell)
+			accepted++
+		}
+
+		result.Items = append(result.Items, itemResult)
+	}
+
+	metrics.IncAddedOrders(accepted)
+	result.ProcessedAt = time.Now()
+
+	return result
+}
+
+func (i *Intake) addOrder(ctx context.Context, item messages.ManifestItem) (models.Order, error) {
+	cost := itemCost(item)
+	if item.OrderID <= 0 || item.CustomerID <= 0 || item.Weight < 0 || cost.IsNegative() {
+		return models.Order{}, fmt.Errorf("intake.addOrder error: %w", errInvalidItem)
+	}
+
+	ref := models.ExternalRef{Source: item.Source, Number: strconv.FormatInt(item.OrderID, 10)}
+	if ref.Source == "" {
+		ref.Source = models.LegacySource
+	}
+
+	customerId := models.ID(item.CustomerID)
+	order, errAdd := i.Module.AddOrder(ctx, ref, customerId, item.ExpirationTime,
+		models.ParsePackaging(item.PackageType), models.Kilo(item.Weight), models.Dimensions{}, cost, nil, intakeOperator)
+	if errAdd != nil {
+		return models.Order{}, fmt.Errorf("intake.addOrder error: %w", errAdd)
+	}
+
+	if errCache := i.Redis.Delete(ctx, cache.OrdersKey(models.PointFromContext(ctx), customerId)); errCache != nil {
+		log.Printf("intake.addOrder error clearing cache: %s\n", errCache)
+	}
+
+	return order, nil
+}
+
+// itemCost Стоимость позиции. Манифесты без costMinor передают стоимость в устаревшем поле cost в целых рублях.
+func itemCost(item messages.ManifestItem) models.Money {
+	if item.CostMinor == nil {
+		return models.Rubles(item.Cost)
+	}
+
+	currency := models.Currency(item.Currency)
+	if currency == "" {
+		currency = models.CurrencyRUB
+	}
+
+	return models.NewMoney(*item.CostMinor, currency)
+}
+
+func reason(err error) string {
+	switch {
+	case errors.Is(err, packaging.ErrInvalidPackage), errors.Is(err, packaging.ErrInvalidCombination),
+		errors.Is(err, module.ErrNoPackage):
+		return ReasonBadPackage
+	case errors.Is(err, packaging.ErrWeightExceeded):
+		return ReasonOverweight
+	case errors.Is(err, packaging.ErrWeightTooLow):
+		return ReasonUnderweight
+	case errors.Is(err, storage.ErrOrderExists):
+		return ReasonDuplicateID
+	case errors.Is(err, module.ErrWrongExpiration), errors.Is(err, module.ErrStoragePeriod):
+		return ReasonWrongExpiration
+	case errors.Is(err, errInvalidItem):
+		return ReasonInvalidItem
+	case errors.Is(err, shelving.ErrNoCell):
+		return ReasonNoFreeCell
+	default:
+		return ReasonInternal
+	}
+}
@@ -0,0 +1,108 @@
+package intake
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"homework-1/internal/cache"
+	mockcache "homework-1/internal/cache/mocks"
+	"homework-1/internal/infrastructure/messaging/messages"
+	"homework-1/internal/models"
+	mockmodule "homework-1/internal/module/mocks"
+	"homework-1/internal/services/packaging"
+	"homework-1/internal/storage"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type senderStub struct {
+	results []*messages.ManifestResult
+}
+
+func (s *senderStub) SendManifestResult(result *messages.ManifestResult) error {
+	s.results = append(s.results, result)
+	return nil
+}
+
+func TestIntake_ProcessManifest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	mockCache := mockcache.NewMockCacheInterface(ctrl)
+	deliveryIntake := NewIntake(Deps{Module: mockModule, Sender: &senderStub{}, Redis: mockCache})
+
+	t.Run("Позиции манифеста принимаются независимо друг от друга", func(t *testing.T) {
+		expiration := time.Now().Add(24 * time.Hour)
+		costMinor, negativeCost := int64(1050), int64(-1)
+		manifest := messages.DeliveryManifest{
+			ManifestID: "m-1",
+			Items: []messages.ManifestItem{
+				{OrderID: 1, Source: "marketplace", CustomerID: 1, ExpirationTime: expiration, PackageType: "box", Weight: 1, Cost: 100},
+				{OrderID: 2, CustomerID: 1, ExpirationTime: expiration, PackageType: "bag", Weight: 50, Cost: 100},
+				{OrderID: 3, CustomerID: 1, ExpirationTime: expiration, PackageType: "crate", Weight: 1, Cost: 100},
+				{OrderID: 4, CustomerID: 1, ExpirationTime: expiration, PackageType: "box", Weight: 1, Cost: 100},
+				{OrderID: 0, CustomerID: 1, ExpirationTime: expiration, PackageType: "box", Weight: 1, Cost: 100},
+				{OrderID: 5, CustomerID: 1, ExpirationTime: expiration, PackageType: "box", Weight: 1, CostMinor: &costMinor, Currency: "USD"},
+				{OrderID: 6, CustomerID: 1, ExpirationTime: expiration, PackageType: "box", Weight: 1, CostMinor: &negativeCost},
+			},
+		}
+
+		mockModule.EXPECT().AddOrder(gomock.Any(), models.ExternalRef{Source: "marketplace", Number: "1"}, models.ID(1), expiration, models.Packaging{"box"}, models.Kilo(1), models.Dimensions{}, models.Rubles(100), gomock.Nil(), intakeOperator).Return(models.Order{OrderID: models.ID(101), Cell: "A-01-01"}, nil)
+		mockModule.EXPECT().AddOrder(gomock.Any(), models.ExternalRef{Source: models.LegacySource, Number: "2"}, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(models.Order{}, fmt.Errorf("module.AddOrder error: %w", packaging.ErrWeightExceeded))
+		mockModule.EXPECT().AddOrder(gomock.Any(), models.ExternalRef{Source: models.LegacySource, Number: "3"}, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(models.Order{}, fmt.Errorf("module.AddOrder error: %w", packaging.ErrInvalidPackage))
+		mockModule.EXPECT().AddOrder(gomock.Any(), models.ExternalRef{Source: models.LegacySource, Number: "4"}, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(models.Order{}, fmt.Errorf("module.AddOrder error: %w", storage.ErrOrderExists))
+		mockModule.EXPECT().AddOrder(gomock.Any(), models.ExternalRef{Source: models.LegacySource, Number: "5"}, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), models.NewMoney(1050, "USD"), gomock.Any(), gomock.Any()).
+			Return(models.Order{OrderID: models.ID(105)}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey(models.DefaultPoint, 1)).Return(nil).Times(2)
+
+		result := deliveryIntake.ProcessManifest(context.Background(), manifest)
+		assert.Equal(t, "m-1", result.ManifestID)
+		require.Len(t, result.Items, 7)
+		assert.True(t, result.Items[0].Accepted)
+		assert.Equal(t, int64(101), result.Items[0].AssignedOrderID)
+		assert.Equal(t, "A-01-01", result.Items[0].AssignedCell)
+		assert.Equal(t, ReasonOverweight, result.Items[1].Reason)
+		assert.Equal(t, ReasonBadPackage, result.Items[2].Reason)
+		assert.Equal(t, ReasonDuplicateID, result.Items[3].Reason)
+		assert.Equal(t, ReasonInvalidItem, result.Items[4].Reason)
+		assert.True(t, result.Items[5].Accepted)
+		assert.Equal(t, ReasonInvalidItem, result.Items[6].Reason)
+	})
+}
+
+func TestIntake_Handle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	mockCache := mockcache.NewMockCacheInterface(ctrl)
+	sender := &senderStub{}
+	deliveryIntake := NewIntake(Deps{Module: mockModule, Sender: sender, Redis: mockCache})
+
+	t.Run("Результат приема манифеста отправляется в топик ответов", func(t *testing.T) {
+		value, err := json.Marshal(messages.DeliveryManifest{
+			ManifestID: "m-2",
+			PointID:    "spb-1",
+			Items: []messages.ManifestItem{
+				{OrderID: 10, CustomerID: 2, ExpirationTime: time.Now().Add(time.Hour), PackageType: "wrap", Weight: 1, Cost: 1},
+			},
+		})
+		require.NoError(t, err)
+
+		mockModule.EXPECT().AddOrder(gomock.Any(), models.ExternalRef{Source: models.LegacySource, Number: "10"}, models.ID(2), gomock.Any(), models.Packaging{"wrap"}, models.Kilo(1), models.Dimensions{}, models.Rubles(1), gomock.Nil(), intakeOperator).Return(models.Order{OrderID: models.ID(1)}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("spb-1", 2)).Return(nil)
+
+		deliveryIntake.Handle(value)
+		require.Len(t, sender.results, 1)
+		assert.Equal(t, "m-2", sender.results[0].ManifestID)
+		assert.True(t, sender.results[0].Items[0].Accepted)
+	})
+}
@@ -0,0 +1,113 @@
+package operatortoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"homework-1/internal/config"
+	"homework-1/internal/models"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidConfig = errors.New("invalid operator token config")
+	ErrInvalidToken  = errors.New("invalid operator token")
+)
+
+// Role Роль сотрудника. Администратор, кроме работы с заказами пункта, меняет общий для всех пунктов каталог упаковок.
+type Role string
+
+const (
+	RoleOperator Role = "operator"
+	RoleAdmin    Role = "admin"
+)
+
+// Claims Сотрудник и его роль из проверенного токена.
+type Claims struct {
+	Operator models.Operator
+	Role     Role
+}
+
+// IsAdmin Сотрудник может менять каталог упаковок.
+func (c Claims) IsAdmin() bool {
+	return c.Role == RoleAdmin
+}
+
+// Tokens Выпускает и проверяет токены сотрудников пунктов выдачи. Токен имеет вид
+// base64url(сотрудник).роль.срок-действия-unix.base64url(HMAC-SHA256), поэтому подделать, продлить его
+// или повысить роль без секрета сервера нельзя, а сотрудник берется только из проверенного токена.
+type Tokens struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func New(cfg config.OperatorTokenConfig) (*Tokens, error) {
+	switch {
+	case cfg.Secret == "":
+		return nil, fmt.Errorf("operatortoken.New error: %w: empty secret", ErrInvalidConfig)
+	case cfg.TTLHours <= 0:
+		return nil, fmt.Errorf("operatortoken.New error: %w: ttl must be positive", ErrInvalidConfig)
+	}
+
+	return &Tokens{
+		secret: []byte(cfg.Secret),
+		ttl:    time.Duration(cfg.TTLHours) * time.Hour,
+	}, nil
+}
+
+// Issue Токен сотрудника, действующий TTLHours с момента now.
+func (t *Tokens) Issue(claims Claims, now time.Time) (string, error) {
+	if claims.Operator == "" {
+		return "", fmt.Errorf("operatortoken.Issue error: %w: empty operator", ErrInvalidToken)
+	}
+	if claims.Role != RoleOperator && claims.Role != RoleAdmin {
+		return "", fmt.Errorf("operatortoken.Issue error: %w: unknown role %q", ErrInvalidToken, claims.Role)
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString([]byte(claims.Operator)) + "." + string(claims.Role) + "." +
+		strconv.FormatInt(now.Add(t.ttl).Unix(), 10)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(t.sign(payload)), nil
+}
+
+// Verify Сотрудник и роль из токена. Подпись сравнивается за постоянное время, просроченный токен отклоняется.
+func (t *Tokens) Verify(token string, now time.Time) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 4 {
+		return Claims{}, fmt.Errorf("operatortoken.Verify error: %w: malformed token", ErrInvalidToken)
+	}
+
+	signature, errSignature := base64.RawURLEncoding.DecodeString(parts[3])
+	if errSignature != nil || !hmac.Equal(signature, t.sign(strings.Join(parts[:3], "."))) {
+		return Claims{}, fmt.Errorf("operatortoken.Verify error: %w: bad signature", ErrInvalidToken)
+	}
+
+	expires, errExpires := strconv.ParseInt(parts[2], 10, 64)
+	if errExpires != nil {
+		return Claims{}, fmt.Errorf("operatortoken.Verify error: %w: malformed expiration", ErrInvalidToken)
+	}
+	if !now.Before(time.Unix(expires, 0)) {
+		return Claims{}, fmt.Errorf("operatortoken.Verify error: %w: token expired", ErrInvalidToken)
+	}
+
+	role := Role(parts[1])
+	if role != RoleOperator && role != RoleAdmin {
+		return Claims{}, fmt.Errorf("operatortoken.Verify error: %w: unknown role %q", ErrInvalidToken, role)
+	}
+
+	operator, errOperator := base64.RawURLEncoding.DecodeString(parts[0])
+	if errOperator != nil || len(operator) == 0 {
+		return Claims{}, fmt.Errorf("operatortoken.Verify error: %w: malformed operator", ErrInvalidToken)
+	}
+
+	return Claims{Operator: models.Operator(operator), Role: role}, nil
+}
+
+func (t *Tokens) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, t.secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
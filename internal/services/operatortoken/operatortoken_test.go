@@ -0,0 +1,91 @@
+package operatortoken
+
+import (
+	"homework-1/internal/config"
+	"homework-1/internal/models"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = config.OperatorTokenConfig{Secret: "secret", TTLHours: 12}
+
+func TestTokens(t *testing.T) {
+	tokens, err := New(testConfig)
+	require.NoError(t, err)
+	now := time.Now()
+
+	t.Run("Выпущенный токен возвращает сотрудника", func(t *testing.T) {
+		token, errIssue := tokens.Issue(Claims{Operator: "anna.k", Role: RoleOperator}, now)
+		require.NoError(t, errIssue)
+
+		claims, errVerify := tokens.Verify(token, now.Add(time.Hour))
+		require.NoError(t, errVerify)
+		assert.Equal(t, models.Operator("anna.k"), claims.Operator)
+		assert.False(t, claims.IsAdmin())
+	})
+
+	t.Run("Роль администратора подписана вместе с сотрудником", func(t *testing.T) {
+		token, errIssue := tokens.Issue(Claims{Operator: "anna", Role: RoleAdmin}, now)
+		require.NoError(t, errIssue)
+
+		claims, errVerify := tokens.Verify(token, now)
+		require.NoError(t, errVerify)
+		assert.True(t, claims.IsAdmin())
+
+		operatorToken, errIssue := tokens.Issue(Claims{Operator: "boris", Role: RoleOperator}, now)
+		require.NoError(t, errIssue)
+		parts := strings.Split(operatorToken, ".")
+		parts[1] = string(RoleAdmin)
+		_, errVerify = tokens.Verify(strings.Join(parts, "."), now)
+		assert.ErrorIs(t, errVerify, ErrInvalidToken)
+	})
+
+	t.Run("Токен с подмененным сотрудником отклоняется", func(t *testing.T) {
+		token, errIssue := tokens.Issue(Claims{Operator: "anna", Role: RoleOperator}, now)
+		require.NoError(t, errIssue)
+		forged, errForged := tokens.Issue(Claims{Operator: "boris", Role: RoleOperator}, now)
+		require.NoError(t, errForged)
+
+		parts := strings.Split(token, ".")
+		parts[0] = strings.Split(forged, ".")[0]
+		_, errVerify := tokens.Verify(strings.Join(parts, "."), now)
+		assert.ErrorIs(t, errVerify, ErrInvalidToken)
+	})
+
+	t.Run("Токен зависит от секрета сервера", func(t *testing.T) {
+		other, errNew := New(config.OperatorTokenConfig{Secret: "other", TTLHours: 12})
+		require.NoError(t, errNew)
+		token, errIssue := other.Issue(Claims{Operator: "anna", Role: RoleOperator}, now)
+		require.NoError(t, errIssue)
+
+		_, errVerify := tokens.Verify(token, now)
+		assert.ErrorIs(t, errVerify, ErrInvalidToken)
+	})
+
+	t.Run("Просроченный токен отклоняется", func(t *testing.T) {
+		token, errIssue := tokens.Issue(Claims{Operator: "anna", Role: RoleOperator}, now)
+		require.NoError(t, errIssue)
+
+		_, errVerify := tokens.Verify(token, now.Add(13*time.Hour))
+		assert.ErrorIs(t, errVerify, ErrInvalidToken)
+	})
+
+	t.Run("Пустой и произвольный токены отклоняются", func(t *testing.T) {
+		for _, token := range []string{"", "anna", "a.b.c", "a.b.c.d"} {
+			_, errVerify := tokens.Verify(token, now)
+			assert.ErrorIs(t, errVerify, ErrInvalidToken, token)
+		}
+	})
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(config.OperatorTokenConfig{TTLHours: 12})
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+
+	_, err = New(config.OperatorTokenConfig{Secret: "secret"})
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+}
@@ -2,27 +2,197 @@ package packaging
 
 import (
 	"errors"
+	"fmt"
+	"homework-1/internal/config"
 	"homework-1/internal/models"
+	"regexp"
 )
 
 var (
-	errWeightExceededErr = errors.New("weight exceeded")
-	invalidPackageErr    = errors.New("invalid package")
+	ErrWeightExceeded = errors.New("weight exceeded")
+	ErrWeightTooLow   = errors.New("weight is below the package minimum")
+	ErrSizeExceeded   = errors.New("order does not fit the package")
+	ErrInvalidPackage = errors.New("invalid package")
+	ErrInvalidSpec    = errors.New("invalid package type description")
+	// ErrInvalidCombination Слои упаковки нельзя сочетать в таком порядке, например коробку в пакете.
+	ErrInvalidCombination = errors.New("invalid package combination")
 )
 
+// typePattern Имя типа попадает в заказы и URL администрирования, поэтому допускаются только строчные латинские буквы,
+// цифры, дефис и подчеркивание.
+var typePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+
+// Package Упаковка, в которую принимается заказ. Цена и ограничения по весу берутся из каталога.
+// Dimensions Размеры упакованного заказа, по ним подбирается ячейка хранения.
 type Package interface {
 	ValidateWeight(weight models.Kilo) error
-	GetCost() models.Rub
+	ValidateSize(size models.Dimensions) error
+	GetCost() models.Money
+	Dimensions() models.Dimensions
+}
+
+type catalogPackage struct {
+	spec models.PackageSpec
+}
+
+// New Упаковка по описанию из каталога. Выведенный из оборота тип для новых заказов не годится.
+func New(spec models.PackageSpec) (Package, error) {
+	if spec.Retired() {
+		return nil, fmt.Errorf("%w: %s is retired", ErrInvalidPackage, spec.Type)
+	}
+	return catalogPackage{spec: spec}, nil
+}
+
+func (p catalogPackage) ValidateWeight(weight models.Kilo) error {
+	if weight < p.spec.MinWeight {
+		return fmt.Errorf("%w: %s accepts from %g kg", ErrWeightTooLow, p.spec.Type, p.spec.MinWeight)
+	}
+	if p.spec.MaxWeight > 0 && weight >= p.spec.MaxWeight {
+		return fmt.Errorf("%w: %s accepts less than %g kg", ErrWeightExceeded, p.spec.Type, p.spec.MaxWeight)
+	}
+	return nil
+}
+
+func (p catalogPackage) ValidateSize(size models.Dimensions) error {
+	if !p.spec.Dimensions.Fits(size) {
+		d := p.spec.Dimensions
+		return fmt.Errorf("%w: %s is %dx%dx%d cm", ErrSizeExceeded, p.spec.Type, d.Length, d.Width, d.Height)
+	}
+	return nil
+}
+
+func (p catalogPackage) GetCost() models.Money {
+	return p.spec.Price
+}
+
+func (p catalogPackage) Dimensions() models.Dimensions {
+	return p.spec.Dimensions
+}
+
+// compositePackage Упаковка из нескольких слоев: заказ должен подходить каждому слою, поэтому действует
+// самое строгое ограничение по весу, а стоимость складывается из цен слоев.
+type compositePackage struct {
+	layers []Package
+	cost   models.Money
+}
+
+// Compose Упаковка из слоев от внутреннего к внешнему. Внутренний слой может быть любым, каждый следующий
+// должен быть оберткой и надеваться только на упаковку, которую разрешено оборачивать.
+// Единственный слой дает ту же упаковку, что и New.
+func Compose(specs []models.PackageSpec) (Package, error) {
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("%w: no package layers", ErrInvalidPackage)
+	}
+	if len(specs) == 1 {
+		return New(specs[0])
+	}
+
+	composite := compositePackage{
+		layers: make([]Package, 0, len(specs)),
+		cost:   models.NewMoney(0, specs[0].Price.Currency),
+	}
+	for i, spec := range specs {
+		if i > 0 {
+			inner := specs[i-1]
+			if spec.Kind != models.PackageKindWrapping {
+				return nil, fmt.Errorf("%w: %s can not contain %s", ErrInvalidCombination, spec.Type, inner.Type)
+			}
+			if !inner.AllowWrap {
+				return nil, fmt.Errorf("%w: %s can not be wrapped in %s", ErrInvalidCombination, inner.Type, spec.Type)
+			}
+		}
+
+		layer, errNew := New(spec)
+		if errNew != nil {
+			return nil, errNew
+		}
+
+		cost, errCost := composite.cost.Add(spec.Price)
+		if errCost != nil {
+			return nil, fmt.Errorf("%w: %s is priced in %s: %w", ErrInvalidCombination, spec.Type, spec.Price.Currency, errCost)
+		}
+		composite.layers = append(composite.layers, layer)
+		composite.cost = cost
+	}
+	return composite, nil
 }
 
-func ParsePackage(p models.PackageType) (Package, error) {
-	switch p {
-	case "bag":
-		return Bag{}, nil
-	case "box":
-		return Box{}, nil
-	case "wrap":
-		return Wrap{}, nil
+func (p compositePackage) ValidateWeight(weight models.Kilo) error {
+	for _, layer := range p.layers {
+		if err := layer.ValidateWeight(weight); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p compositePackage) ValidateSize(size models.Dimensions) error {
+	for _, layer := range p.layers {
+		if err := layer.ValidateSize(size); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p compositePackage) GetCost() models.Money {
+	return p.cost
+}
+
+// Dimensions Обертки надеваются по форме внутреннего слоя, поэтому размеры задает он.
+func (p compositePackage) Dimensions() models.Dimensions {
+	return p.layers[0].Dimensions()
+}
+
+// Validate Проверяет описание типа перед записью в каталог.
+func Validate(spec models.PackageSpec) error {
+	switch {
+	case !typePattern.MatchString(string(spec.Type)):
+		return fmt.Errorf("%w: name %q must match %s", ErrInvalidSpec, spec.Type, typePattern)
+	case spec.Type == models.PackageAuto:
+		return fmt.Errorf("%w: name %q is reserved for automatic choice", ErrInvalidSpec, spec.Type)
+	case spec.Kind != models.PackageKindContainer && spec.Kind != models.PackageKindWrapping:
+		return fmt.Errorf("%w: unknown kind %q", ErrInvalidSpec, spec.Kind)
+	case spec.Kind == models.PackageKindWrapping && spec.AllowWrap:
+		return fmt.Errorf("%w: wrapping can not be wrapped again", ErrInvalidSpec)
+	case spec.Price.Amount < 0 || len(spec.Price.Currency) != 3:
+		return fmt.Errorf("%w: invalid price %s", ErrInvalidSpec, spec.Price)
+	case spec.MinWeight < 0 || spec.MaxWeight < 0:
+		return fmt.Errorf("%w: negative weight limit", ErrInvalidSpec)
+	case spec.MaxWeight > 0 && spec.MinWeight >= spec.MaxWeight:
+		return fmt.Errorf("%w: min weight %g must be less than max weight %g", ErrInvalidSpec, spec.MinWeight, spec.MaxWeight)
+	case spec.Dimensions.Length < 0 || spec.Dimensions.Width < 0 || spec.Dimensions.Height < 0:
+		return fmt.Errorf("%w: negative dimensions", ErrInvalidSpec)
+	}
+	return nil
+}
+
+// FromConfig Типы упаковок, которыми заполняется каталог при старте сервера.
+func FromConfig(cfg config.PackagingConfig) []models.PackageSpec {
+	specs := make([]models.PackageSpec, 0, len(cfg.Types))
+	for _, t := range cfg.Types {
+		currency := models.Currency(t.Currency)
+		if currency == "" {
+			currency = models.CurrencyRUB
+		}
+		kind := models.PackageKind(t.Kind)
+		if kind == "" {
+			kind = models.PackageKindContainer
+		}
+
+		specs = append(specs, models.PackageSpec{
+			Type:      models.PackageType(t.Name),
+			Kind:      kind,
+			Price:     models.NewMoney(t.PriceMinor, currency),
+			MinWeight: models.Kilo(t.MinWeightKg),
+			MaxWeight: models.Kilo(t.MaxWeightKg),
+			Dimensions: models.Dimensions{
+				Length: models.Centimeter(t.LengthCm),
+				Width:  models.Centimeter(t.WidthCm),
+				Height: models.Centimeter(t.HeightCm),
+			},
+			AllowWrap: t.AllowWrap,
+		})
 	}
-	return nil, invalidPackageErr
+	return specs
 }
@@ -0,0 +1,95 @@
+package pickupcode
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"homework-1/internal/config"
+	"homework-1/internal/models"
+	"math/big"
+	"time"
+)
+
+var ErrInvalidConfig = errors.New("invalid pickup code config")
+
+const (
+	minLength = 4
+	maxLength = 8
+	saltSize  = 16
+)
+
+// Codes Выпускает и проверяет коды выдачи. Код хешируется HMAC-SHA256 с секретом сервера и солью кода,
+// поэтому утечка таблицы кодов без секрета не позволяет подобрать коды перебором.
+type Codes struct {
+	secret      []byte
+	length      int
+	maxAttempts int
+	lockout     time.Duration
+}
+
+func New(cfg config.PickupCodeConfig) (*Codes, error) {
+	switch {
+	case cfg.Secret == "":
+		return nil, fmt.Errorf("pickupcode.New error: %w: empty secret", ErrInvalidConfig)
+	case cfg.Length < minLength || cfg.Length > maxLength:
+		return nil, fmt.Errorf("pickupcode.New error: %w: length must be from %d to %d", ErrInvalidConfig, minLength, maxLength)
+	case cfg.MaxAttempts <= 0 || cfg.LockoutMinutes <= 0:
+		return nil, fmt.Errorf("pickupcode.New error: %w: attempts and lockout must be positive", ErrInvalidConfig)
+	}
+
+	return &Codes{
+		secret:      []byte(cfg.Secret),
+		length:      cfg.Length,
+		maxAttempts: cfg.MaxAttempts,
+		lockout:     time.Duration(cfg.LockoutMinutes) * time.Minute,
+	}, nil
+}
+
+// Generate Новый код для клиента: сам код для уведомления и запись для хранилища.
+func (c *Codes) Generate(customerId models.ID, now time.Time) (string, models.PickupCode, error) {
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(c.length)), nil)
+	n, errRand := rand.Int(rand.Reader, limit)
+	if errRand != nil {
+		return "", models.PickupCode{}, fmt.Errorf("pickupcode.Generate error: %w", errRand)
+	}
+	code := fmt.Sprintf("%0*d", c.length, n)
+
+	salt := make([]byte, saltSize)
+	if _, errSalt := rand.Read(salt); errSalt != nil {
+		return "", models.PickupCode{}, fmt.Errorf("pickupcode.Generate error: %w", errSalt)
+	}
+
+	return code, models.PickupCode{
+		CustomerID: customerId,
+		Hash:       c.hash(customerId, salt, code),
+		Salt:       salt,
+		CreatedAt:  now,
+	}, nil
+}
+
+// Verify Сравнивает код с сохраненным за постоянное время.
+func (c *Codes) Verify(stored models.PickupCode, code string) bool {
+	return hmac.Equal(c.hash(stored.CustomerID, stored.Salt, code), stored.Hash)
+}
+
+// MaxAttempts Сколько неверных кодов подряд допускается до блокировки.
+func (c *Codes) MaxAttempts() int {
+	return c.maxAttempts
+}
+
+// LockedUntil Время окончания блокировки, которая начинается в now.
+func (c *Codes) LockedUntil(now time.Time) time.Time {
+	return now.Add(c.lockout)
+}
+
+// hash Код привязан к клиенту, чтобы запись одного клиента нельзя было подставить другому.
+func (c *Codes) hash(customerId models.ID, salt []byte, code string) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(salt)
+	_ = binary.Write(mac, binary.BigEndian, int64(customerId))
+	mac.Write([]byte(code))
+	return mac.Sum(nil)
+}
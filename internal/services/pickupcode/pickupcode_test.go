@@ -0,0 +1,59 @@
+package pickupcode
+
+import (
+	"homework-1/internal/config"
+	"homework-1/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = config.PickupCodeConfig{Secret: "secret", Length: 6, MaxAttempts: 3, LockoutMinutes: 15}
+
+func TestCodes(t *testing.T) {
+	codes, err := New(testConfig)
+	require.NoError(t, err)
+
+	t.Run("Выпущенный код проверяется, а не хранится", func(t *testing.T) {
+		code, stored, errGenerate := codes.Generate(models.ID(1), time.Now())
+		require.NoError(t, errGenerate)
+
+		assert.Len(t, code, 6)
+		assert.NotContains(t, string(stored.Hash), code)
+		assert.True(t, codes.Verify(stored, code))
+	})
+
+	t.Run("Неверный код и код другого клиента отклоняются", func(t *testing.T) {
+		code, stored, errGenerate := codes.Generate(models.ID(1), time.Now())
+		require.NoError(t, errGenerate)
+
+		assert.False(t, codes.Verify(stored, "x"+code[1:]))
+
+		stored.CustomerID = models.ID(2)
+		assert.False(t, codes.Verify(stored, code))
+	})
+
+	t.Run("Код зависит от секрета сервера", func(t *testing.T) {
+		code, stored, errGenerate := codes.Generate(models.ID(1), time.Now())
+		require.NoError(t, errGenerate)
+
+		other, errNew := New(config.PickupCodeConfig{Secret: "other", Length: 6, MaxAttempts: 3, LockoutMinutes: 15})
+		require.NoError(t, errNew)
+		assert.False(t, other.Verify(stored, code))
+	})
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Некорректные настройки", func(t *testing.T) {
+		for _, cfg := range []config.PickupCodeConfig{
+			{Length: 6, MaxAttempts: 3, LockoutMinutes: 15},
+			{Secret: "secret", Length: 3, MaxAttempts: 3, LockoutMinutes: 15},
+			{Secret: "secret", Length: 6, LockoutMinutes: 15},
+		} {
+			_, err := New(cfg)
+			assert.ErrorIs(t, err, ErrInvalidConfig)
+		}
+	})
+}
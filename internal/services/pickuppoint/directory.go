@@ -0,0 +1,101 @@
+package pickuppoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"homework-1/internal/config"
+	"homework-1/internal/models"
+	"homework-1/internal/services/shelving"
+	"sync"
+)
+
+var ErrNoPoint = errors.New("request has no pickup point")
+
+// Provider Настройки пункта выдачи по его идентификатору.
+type Provider interface {
+	Point(ctx context.Context, id models.PointID) (Point, error)
+}
+
+// Source Справочник пунктов выдачи, например хранилище.
+type Source interface {
+	GetPickupPoint(ctx context.Context, pointId models.PointID) (models.PickupPoint, error)
+}
+
+// FromModel Настройки пункта из справочника.
+func FromModel(point models.PickupPoint) (Point, error) {
+	return New(string(point.ID), point.TimeZone, point.ClosingTime)
+}
+
+// FromConfig Пункты выдачи, их сотрудники и ячейки хранения, которыми заполняется справочник при старте сервера.
+func FromConfig(cfg []config.PickupPointConfig) []models.PickupPoint {
+	points := make([]models.PickupPoint, 0, len(cfg))
+	for _, p := range cfg {
+		operators := make([]models.Operator, 0, len(p.Operators))
+		for _, operator := range p.Operators {
+			operators = append(operators, models.Operator(operator))
+		}
+
+		points = append(points, models.PickupPoint{
+			ID:          models.PointID(p.ID),
+			Name:        p.Name,
+			TimeZone:    p.TimeZone,
+			ClosingTime: p.ClosingTime,
+			Operators:   operators,
+			Cells:       shelving.FromConfig(p.Cells),
+		})
+	}
+	return points
+}
+
+// Current Настройки пункта, в границах которого выполняется запрос. Без справочника (provider == nil) сервер
+// работает как один пункт fallback, как до появления нескольких пунктов.
+func Current(ctx context.Context, provider Provider, fallback Point) (Point, error) {
+	if provider == nil {
+		return fallback, nil
+	}
+
+	id := models.PointFromContext(ctx)
+	if id == "" {
+		return Point{}, ErrNoPoint
+	}
+	return provider.Point(ctx, id)
+}
+
+// Directory Настройки пунктов из справочника. Пункты добавляются только при старте сервера, поэтому
+// разобранные настройки кешируются до перезапуска.
+type Directory struct {
+	source Source
+
+	mu     sync.RWMutex
+	points map[models.PointID]Point
+}
+
+func NewDirectory(source Source) *Directory {
+	return &Directory{source: source, points: make(map[models.PointID]Point)}
+}
+
+func (d *Directory) Point(ctx context.Context, id models.PointID) (Point, error) {
+	d.mu.RLock()
+	point, ok := d.points[id]
+	d.mu.RUnlock()
+	if ok {
+		return point, nil
+	}
+
+	stored, errGet := d.source.GetPickupPoint(ctx, id)
+	if errGet != nil {
+		return Point{}, fmt.Errorf("pickuppoint.Directory.Point error: %w", errGet)
+	}
+
+	point, errParse := FromModel(stored)
+	if errParse != nil {
+		return Point{}, fmt.Errorf("pickuppoint.Directory.Point error: %w", errParse)
+	}
+
+	d.mu.Lock()
+	d.points[id] = point
+	d.mu.Unlock()
+
+	return point, nil
+}
@@ -0,0 +1,82 @@
+package pickuppoint
+
+import (
+	"context"
+	"errors"
+	"homework-1/internal/config"
+	"homework-1/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sourceStub struct {
+	points map[models.PointID]models.PickupPoint
+	calls  int
+}
+
+func (s *sourceStub) GetPickupPoint(_ context.Context, pointId models.PointID) (models.PickupPoint, error) {
+	s.calls++
+	point, ok := s.points[pointId]
+	if !ok {
+		return models.PickupPoint{}, errors.New("pickup point not found")
+	}
+	return point, nil
+}
+
+func TestCurrent(t *testing.T) {
+	source := &sourceStub{points: map[models.PointID]models.PickupPoint{
+		"ekb-1": {ID: "ekb-1", TimeZone: "Asia/Yekaterinburg", ClosingTime: "20:00"},
+	}}
+	directory := NewDirectory(source)
+	fallback, err := New("default", "Europe/Moscow", "21:00")
+	require.NoError(t, err)
+
+	t.Run("Настройки берутся у пункта запроса и кешируются", func(t *testing.T) {
+		ctx := models.WithPoint(context.Background(), "ekb-1")
+
+		point, errCurrent := Current(ctx, directory, fallback)
+		require.NoError(t, errCurrent)
+		assert.Equal(t, "ekb-1", point.ID)
+		assert.Equal(t, "Asia/Yekaterinburg", point.Location.String())
+
+		_, errCurrent = Current(ctx, directory, fallback)
+		require.NoError(t, errCurrent)
+		assert.Equal(t, 1, source.calls)
+	})
+
+	t.Run("Запрос без пункта", func(t *testing.T) {
+		_, errCurrent := Current(context.Background(), directory, fallback)
+		assert.ErrorIs(t, errCurrent, ErrNoPoint)
+	})
+
+	t.Run("Без справочника используется единственный пункт", func(t *testing.T) {
+		point, errCurrent := Current(models.WithPoint(context.Background(), "ekb-1"), nil, fallback)
+		require.NoError(t, errCurrent)
+		assert.Equal(t, fallback, point)
+	})
+}
+
+func TestFromConfig(t *testing.T) {
+	t.Run("Сотрудники переносятся вместе с пунктом", func(t *testing.T) {
+		points := FromConfig([]config.PickupPointConfig{
+			{ID: "msk-1", Name: "Тверская", TimeZone: "Europe/Moscow", ClosingTime: "21:00", Operators: []string{"anna", "oleg"}},
+		})
+
+		require.Len(t, points, 1)
+		assert.Equal(t, models.PointID("msk-1"), points[0].ID)
+		assert.Equal(t, []models.Operator{"anna", "oleg"}, points[0].Operators)
+	})
+
+	t.Run("Зоны хранения раскладываются на ячейки", func(t *testing.T) {
+		points := FromConfig([]config.PickupPointConfig{
+			{ID: "msk-1", TimeZone: "Europe/Moscow", Cells: []config.CellZoneConfig{{Zone: "A", Racks: 2, Shelves: 2, Capacity: 4}}},
+		})
+
+		require.Len(t, points, 1)
+		require.Len(t, points[0].Cells, 4)
+		assert.Equal(t, models.CellCode("A-01-01"), points[0].Cells[0].Code)
+		assert.Equal(t, models.CellCode("A-02-02"), points[0].Cells[3].Code)
+	})
+}
@@ -0,0 +1,74 @@
+package pickuppoint
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	dateLayout        = "02-01-2006"
+	closingTimeLayout = "15:04"
+
+	// defaultClosingTime Если время закрытия не задано, рабочий день пункта заканчивается в конце календарных суток.
+	defaultClosingTime = 24*time.Hour - time.Second
+)
+
+var ErrClosingTime = errors.New("closing time must be in HH:MM format")
+
+// Point Настройки пункта выдачи, от которых зависит расчет сроков хранения.
+// Нулевое значение соответствует пункту в UTC, работающему до конца суток.
+// По ID выбираются правила пункта в пакете policy.
+type Point struct {
+	ID          string
+	Location    *time.Location
+	ClosingTime time.Duration
+}
+
+// New timeZone задается именем из базы IANA (Europe/Moscow), closingTime в формате HH:MM.
+func New(id, timeZone, closingTime string) (Point, error) {
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return Point{}, fmt.Errorf("pickuppoint.New error: %w", err)
+	}
+
+	closing := defaultClosingTime
+	if closingTime != "" {
+		parsed, errParse := time.Parse(closingTimeLayout, closingTime)
+		if errParse != nil {
+			return Point{}, fmt.Errorf("pickuppoint.New error: %w: %w", ErrClosingTime, errParse)
+		}
+		closing = time.Duration(parsed.Hour())*time.Hour + time.Duration(parsed.Minute())*time.Minute
+	}
+
+	return Point{ID: id, Location: location, ClosingTime: closing}, nil
+}
+
+// EndOfBusinessDay Момент закрытия пункта в тот календарный день, на который приходится t по местному времени пункта.
+func (p Point) EndOfBusinessDay(t time.Time) time.Time {
+	location := p.location()
+	closing := p.closingTime()
+
+	year, month, day := t.In(location).Date()
+	return time.Date(year, month, day,
+		int(closing/time.Hour), int(closing%time.Hour/time.Minute), int(closing%time.Minute/time.Second), 0, location)
+}
+
+// ParseDate Разбирает дату в формате DD-MM-YYYY как полночь по местному времени пункта.
+func (p Point) ParseDate(date string) (time.Time, error) {
+	return time.ParseInLocation(dateLayout, date, p.location())
+}
+
+func (p Point) location() *time.Location {
+	if p.Location == nil {
+		return time.UTC
+	}
+	return p.Location
+}
+
+func (p Point) closingTime() time.Duration {
+	if p.ClosingTime == 0 {
+		return defaultClosingTime
+	}
+	return p.ClosingTime
+}
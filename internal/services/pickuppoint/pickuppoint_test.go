@@ -0,0 +1,47 @@
+package pickuppoint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoint_EndOfBusinessDay(t *testing.T) {
+	point, err := New("default", "Asia/Vladivostok", "21:00")
+	require.NoError(t, err)
+
+	t.Run("Дата берется по местному времени пункта", func(t *testing.T) {
+		// 20:00 UTC 9 октября во Владивостоке (UTC+10) уже 06:00 10 октября.
+		moment := time.Date(2024, time.October, 9, 20, 0, 0, 0, time.UTC)
+
+		end := point.EndOfBusinessDay(moment)
+		assert.Equal(t, time.Date(2024, time.October, 10, 21, 0, 0, 0, point.Location), end)
+		assert.Equal(t, time.Date(2024, time.October, 10, 11, 0, 0, 0, time.UTC), end.UTC())
+	})
+
+	t.Run("Дата из строки относится к пункту, а не к UTC", func(t *testing.T) {
+		date, errParse := point.ParseDate("10-10-2024")
+		require.NoError(t, errParse)
+
+		assert.Equal(t, time.Date(2024, time.October, 10, 21, 0, 0, 0, point.Location), point.EndOfBusinessDay(date))
+	})
+
+	t.Run("Без настроек рабочий день заканчивается в конце суток UTC", func(t *testing.T) {
+		end := Point{}.EndOfBusinessDay(time.Date(2024, time.October, 10, 3, 0, 0, 0, time.UTC))
+		assert.Equal(t, time.Date(2024, time.October, 10, 23, 59, 59, 0, time.UTC), end)
+	})
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Некорректное время закрытия", func(t *testing.T) {
+		_, err := New("default", "Europe/Moscow", "9pm")
+		assert.ErrorIs(t, err, ErrClosingTime)
+	})
+
+	t.Run("Неизвестный часовой пояс", func(t *testing.T) {
+		_, err := New("default", "Mars/Olympus", "21:00")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,245 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"homework-1/internal/config"
+	"homework-1/internal/models"
+	"homework-1/internal/services/packaging"
+	"log"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var ErrInvalidRule = errors.New("invalid policy rule")
+
+// Rules Правила, которые действуют для заказа в конкретном пункте и в конкретной упаковке.
+type Rules struct {
+	// RefundWindow Срок после выдачи, в течение которого клиент может оформить возврат.
+	RefundWindow time.Duration
+	// MaxStorage Наибольший срок хранения от момента приема. Ноль снимает ограничение.
+	MaxStorage time.Duration
+	// MaxWeight Вес, начиная с которого пункт не принимает заказ, даже если он подходит упаковке. Ноль снимает ограничение.
+	MaxWeight models.Kilo
+}
+
+// ValidateWeight Проверяет вес заказа по ограничению пункта. Ограничения самой упаковки проверяет packaging.
+func (r Rules) ValidateWeight(weight models.Kilo) error {
+	if r.MaxWeight > 0 && weight >= r.MaxWeight {
+		return fmt.Errorf("%w: %g kg is over the limit of %g kg", packaging.ErrWeightExceeded, weight, r.MaxWeight)
+	}
+	return nil
+}
+
+// RefundAllowed Проверяет, что срок возврата заказа, выданного в received, еще не истек к моменту now.
+func (r Rules) RefundAllowed(received time.Time, now time.Time) bool {
+	return !received.Add(r.RefundWindow).Before(now)
+}
+
+// Provider Источник правил. Module запрашивает правила при каждой операции, поэтому новые правила
+// применяются к следующему же запросу.
+type Provider interface {
+	Rules(point string, pack models.PackageType) Rules
+}
+
+// ForPackaging Правила для заказа в составной упаковке: из правил всех слоев берется самое строгое
+// ограничение, нулевые ограничения при этом считаются отсутствующими.
+func ForPackaging(provider Provider, point string, packaging models.Packaging) Rules {
+	if len(packaging) == 0 {
+		return provider.Rules(point, "")
+	}
+
+	result := provider.Rules(point, packaging[0])
+	for _, layer := range packaging[1:] {
+		rules := provider.Rules(point, layer)
+		if rules.RefundWindow < result.RefundWindow {
+			result.RefundWindow = rules.RefundWindow
+		}
+		result.MaxStorage = stricter(result.MaxStorage, rules.MaxStorage)
+		result.MaxWeight = stricter(result.MaxWeight, rules.MaxWeight)
+	}
+	return result
+}
+
+// stricter Меньшее из двух ограничений, где ноль означает отсутствие ограничения.
+func stricter[T time.Duration | models.Kilo](a T, b T) T {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// defaultRules Правила, действовавшие до появления настроек: они применяются, если в конфигурации нет своих.
+// Вес по умолчанию ограничивает только каталог упаковок.
+var defaultRules = []config.PolicyRuleConfig{
+	{RefundWindowHours: intPtr(48)},
+}
+
+var builtin, _ = compile(config.PolicyConfig{})
+
+// Default Встроенные правила без учета конфигурации.
+func Default() Provider {
+	return builtin
+}
+
+// ruleSet Неизменяемый снимок правил, заменяется целиком при перезагрузке.
+type ruleSet struct {
+	rules []config.PolicyRuleConfig
+}
+
+func compile(cfg config.PolicyConfig) (*ruleSet, error) {
+	for i, rule := range cfg.Rules {
+		if err := validate(rule); err != nil {
+			return nil, fmt.Errorf("policy.compile error: rule %d: %w", i, err)
+		}
+	}
+
+	rules := make([]config.PolicyRuleConfig, 0, len(defaultRules)+len(cfg.Rules))
+	rules = append(rules, defaultRules...)
+	rules = append(rules, cfg.Rules...)
+	return &ruleSet{rules: rules}, nil
+}
+
+func validate(rule config.PolicyRuleConfig) error {
+	if rule.RefundWindowHours != nil && *rule.RefundWindowHours < 0 {
+		return fmt.Errorf("%w: negative refund window", ErrInvalidRule)
+	}
+	if rule.MaxStorageDays != nil && *rule.MaxStorageDays < 0 {
+		return fmt.Errorf("%w: negative storage period", ErrInvalidRule)
+	}
+	if rule.MaxWeightKg != nil && *rule.MaxWeightKg < 0 {
+		return fmt.Errorf("%w: negative weight limit", ErrInvalidRule)
+	}
+	return nil
+}
+
+// Rules Применяет подходящие правила от общих к частным: сначала без пункта и упаковки,
+// затем по упаковке, по пункту и, наконец, по пункту и упаковке вместе.
+func (s *ruleSet) Rules(point string, pack models.PackageType) Rules {
+	var result Rules
+	for specificity := 0; specificity <= 3; specificity++ {
+		for _, rule := range s.rules {
+			if !matches(rule, point, pack) || ruleSpecificity(rule) != specificity {
+				continue
+			}
+			if rule.RefundWindowHours != nil {
+				result.RefundWindow = time.Duration(*rule.RefundWindowHours) * time.Hour
+			}
+			if rule.MaxStorageDays != nil {
+				result.MaxStorage = time.Duration(*rule.MaxStorageDays) * 24 * time.Hour
+			}
+			if rule.MaxWeightKg != nil {
+				result.MaxWeight = models.Kilo(*rule.MaxWeightKg)
+			}
+		}
+	}
+	return result
+}
+
+func matches(rule config.PolicyRuleConfig, point string, pack models.PackageType) bool {
+	return (rule.PickupPoint == "" || rule.PickupPoint == point) &&
+		(rule.Package == "" || models.PackageType(rule.Package) == pack)
+}
+
+func ruleSpecificity(rule config.PolicyRuleConfig) int {
+	specificity := 0
+	if rule.Package != "" {
+		specificity++
+	}
+	if rule.PickupPoint != "" {
+		specificity += 2
+	}
+	return specificity
+}
+
+// Engine Правила из файла конфигурации. Файл перечитывается при изменении, а если новые правила
+// не удалось прочитать, продолжают действовать прежние.
+type Engine struct {
+	path    string
+	current atomic.Pointer[ruleSet]
+
+	mu      sync.Mutex
+	modTime time.Time
+}
+
+// NewEngine Читает правила из файла конфигурации. Ошибка в правилах при старте останавливает сервер,
+// чтобы пункт не начал работать по правилам, о которых не знает оператор.
+func NewEngine(path string) (*Engine, error) {
+	e := &Engine{path: path}
+	if err := e.Reload(); err != nil {
+		return nil, fmt.Errorf("policy.NewEngine error: %w", err)
+	}
+	return e, nil
+}
+
+// Rules Правила для заказа в пункте point в упаковке pack по последнему успешно прочитанному файлу.
+func (e *Engine) Rules(point string, pack models.PackageType) Rules {
+	return e.current.Load().Rules(point, pack)
+}
+
+// Reload Перечитывает правила из файла. При ошибке действующие правила не меняются, а повторно
+// файл читается только после следующего изменения.
+func (e *Engine) Reload() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	info, errStat := os.Stat(e.path)
+	if errStat != nil {
+		return fmt.Errorf("policy.Reload error: %w", errStat)
+	}
+	e.modTime = info.ModTime()
+
+	cfg, errLoad := config.LoadPolicy(e.path)
+	if errLoad != nil {
+		return fmt.Errorf("policy.Reload error: %w", errLoad)
+	}
+
+	set, errCompile := compile(cfg)
+	if errCompile != nil {
+		return fmt.Errorf("policy.Reload error: %w", errCompile)
+	}
+
+	e.current.Store(set)
+	return nil
+}
+
+// Run Раз в interval проверяет время изменения файла и перечитывает правила, если файл изменился.
+func (e *Engine) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Println("policy.Engine: stopping reload")
+			return
+		case <-ticker.C:
+			if !e.changed() {
+				continue
+			}
+			if err := e.Reload(); err != nil {
+				log.Printf("policy.Engine error: keeping previous rules: %s\n", err)
+				continue
+			}
+			log.Println("policy.Engine: rules reloaded")
+		}
+	}
+}
+
+func (e *Engine) changed() bool {
+	info, err := os.Stat(e.path)
+	if err != nil {
+		return false
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return !info.ModTime().Equal(e.modTime)
+}
+
+func intPtr(v int) *int {
+	return &v
+}
@@ -0,0 +1,130 @@
+package policy
+
+import (
+	"homework-1/internal/models"
+	"homework-1/internal/services/packaging"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const rulesYaml = `policy:
+    rules:
+        - max-storage-days: 30
+        - package: "box"
+          refund-window-hours: 336
+        - pickup-point: "spb-1"
+          max-weight-kg: 15
+        - pickup-point: "spb-1"
+          package: "box"
+          max-weight-kg: 25
+`
+
+func writeConfig(t *testing.T, path string, content string, modTime time.Time) {
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestDefault(t *testing.T) {
+	t.Run("Встроенные правила совпадают с прежними ограничениями", func(t *testing.T) {
+		rules := Default().Rules("default", models.PackageType("bag"))
+		assert.Equal(t, 48*time.Hour, rules.RefundWindow)
+		assert.Zero(t, rules.MaxStorage)
+		assert.NoError(t, rules.ValidateWeight(models.Kilo(1000)))
+	})
+
+	t.Run("Ограничение веса пункта", func(t *testing.T) {
+		rules := Rules{MaxWeight: 15}
+		assert.ErrorIs(t, rules.ValidateWeight(models.Kilo(15)), packaging.ErrWeightExceeded)
+		assert.NoError(t, rules.ValidateWeight(models.Kilo(14.9)))
+	})
+}
+
+func TestEngine_Rules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig(t, path, rulesYaml, time.Now())
+
+	engine, err := NewEngine(path)
+	require.NoError(t, err)
+
+	t.Run("Правило по упаковке переопределяет общее", func(t *testing.T) {
+		rules := engine.Rules("msk-1", models.PackageType("box"))
+		assert.Equal(t, 14*24*time.Hour, rules.RefundWindow)
+		assert.Equal(t, 30*24*time.Hour, rules.MaxStorage)
+		assert.Zero(t, rules.MaxWeight)
+	})
+
+	t.Run("Правило пункта и упаковки точнее правила пункта", func(t *testing.T) {
+		assert.Equal(t, models.Kilo(25), engine.Rules("spb-1", models.PackageType("box")).MaxWeight)
+		assert.Equal(t, models.Kilo(15), engine.Rules("spb-1", models.PackageType("bag")).MaxWeight)
+	})
+
+	t.Run("Незаданные ограничения наследуются", func(t *testing.T) {
+		rules := engine.Rules("spb-1", models.PackageType("wrap"))
+		assert.Equal(t, 48*time.Hour, rules.RefundWindow)
+		assert.Equal(t, models.Kilo(15), rules.MaxWeight)
+	})
+}
+
+func TestForPackaging(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig(t, path, rulesYaml+`        - package: "wrap"
+          refund-window-hours: 24
+          max-storage-days: 60
+          max-weight-kg: 20
+`, time.Now())
+
+	engine, err := NewEngine(path)
+	require.NoError(t, err)
+
+	t.Run("Для составной упаковки действует самое строгое правило слоев", func(t *testing.T) {
+		rules := ForPackaging(engine, "spb-1", models.Packaging{"box", "wrap"})
+		assert.Equal(t, 24*time.Hour, rules.RefundWindow)
+		assert.Equal(t, 30*24*time.Hour, rules.MaxStorage)
+		assert.Equal(t, models.Kilo(15), rules.MaxWeight)
+	})
+
+	t.Run("Отсутствие ограничения не ослабляет правило другого слоя", func(t *testing.T) {
+		rules := ForPackaging(engine, "msk-1", models.Packaging{"box", "wrap"})
+		assert.Equal(t, models.Kilo(20), rules.MaxWeight)
+	})
+
+	t.Run("Одиночная упаковка", func(t *testing.T) {
+		assert.Equal(t, engine.Rules("spb-1", "box"), ForPackaging(engine, "spb-1", models.Packaging{"box"}))
+	})
+}
+
+func TestEngine_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	started := time.Now().Add(-time.Hour)
+	writeConfig(t, path, rulesYaml, started)
+
+	engine, err := NewEngine(path)
+	require.NoError(t, err)
+
+	t.Run("Измененный файл перечитывается", func(t *testing.T) {
+		writeConfig(t, path, "policy:\n    rules:\n        - refund-window-hours: 72\n", started.Add(time.Minute))
+		require.True(t, engine.changed())
+
+		require.NoError(t, engine.Reload())
+		assert.False(t, engine.changed())
+		assert.Equal(t, 72*time.Hour, engine.Rules("msk-1", models.PackageType("bag")).RefundWindow)
+	})
+
+	t.Run("Ошибка в правилах оставляет прежние", func(t *testing.T) {
+		writeConfig(t, path, "policy:\n    rules:\n        - max-weight-kg: -1\n", started.Add(2*time.Minute))
+
+		assert.ErrorIs(t, engine.Reload(), ErrInvalidRule)
+		assert.False(t, engine.changed())
+		assert.Equal(t, 72*time.Hour, engine.Rules("msk-1", models.PackageType("bag")).RefundWindow)
+	})
+
+	t.Run("Ошибка в правилах при старте", func(t *testing.T) {
+		_, errNew := NewEngine(path)
+		assert.ErrorIs(t, errNew, ErrInvalidRule)
+	})
+}
@@ -0,0 +1,144 @@
+package shelving
+
+import (
+	"errors"
+	"fmt"
+	"homework-1/internal/config"
+	"homework-1/internal/models"
+	"math"
+	"sort"
+)
+
+var (
+	ErrNoCell      = errors.New("no free storage cell fits the order")
+	ErrUnknownCell = errors.New("storage cell is not in the pickup point layout")
+	ErrCellFull    = errors.New("storage cell is full")
+	ErrCellSize    = errors.New("order does not fit the storage cell")
+	ErrInvalidCell = errors.New("invalid storage cell layout")
+)
+
+// Parcel То, что нужно положить в ячейку: заказ весом Weight в упаковке размера Size.
+// Нулевой Size означает, что размеры неизвестны, и такой заказ ограничивается только весом.
+type Parcel struct {
+	Weight models.Kilo
+	Size   models.Dimensions
+}
+
+// Fits Помещается ли заказ в ячейку по весу и размерам, без учета ее заполненности.
+func Fits(cell models.Cell, parcel Parcel) bool {
+	if cell.MaxWeight > 0 && parcel.Weight > cell.MaxWeight {
+		return false
+	}
+	return cell.Dimensions.Fits(parcel.Size)
+}
+
+// Choose Подбирает ячейку для нового заказа клиента. Если в подходящей ячейке уже лежат заказы этого клиента,
+// заказ кладется к ним, чтобы при выдаче все забиралось из одного места. Иначе выбирается самая маленькая
+// подходящая ячейка: большие остаются крупным заказам, которые в маленькие не помещаются.
+// При прочих равных ячейки заполняются в порядке адресов.
+func Choose(cells []models.CellLoad, parcel Parcel) (models.CellCode, error) {
+	candidates := make([]models.CellLoad, 0, len(cells))
+	for _, cell := range cells {
+		if cell.Free() > 0 && Fits(cell.Cell, parcel) {
+			candidates = append(candidates, cell)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("%w: %g kg", ErrNoCell, parcel.Weight)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.HasCustomer != b.HasCustomer {
+			return a.HasCustomer
+		}
+		if sizeA, sizeB := size(a.Cell), size(b.Cell); sizeA != sizeB {
+			return sizeA < sizeB
+		}
+		if weightA, weightB := maxWeight(a.Cell), maxWeight(b.Cell); weightA != weightB {
+			return weightA < weightB
+		}
+		return a.Code < b.Code
+	})
+
+	return candidates[0].Code, nil
+}
+
+// CheckMove Проверяет, что заказ можно переложить в ячейку target схемы пункта cells.
+func CheckMove(cells []models.CellLoad, target models.CellCode, parcel Parcel) error {
+	for _, cell := range cells {
+		if cell.Code != target {
+			continue
+		}
+		if !Fits(cell.Cell, parcel) {
+			return fmt.Errorf("%w: %s", ErrCellSize, cell)
+		}
+		if cell.Free() <= 0 {
+			return fmt.Errorf("%w: %s holds %d orders", ErrCellFull, target, cell.Occupied)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrUnknownCell, target)
+}
+
+// Validate Проверяет схему ячеек пункта перед записью в справочник.
+func Validate(cells []models.Cell) error {
+	codes := make(map[models.CellCode]struct{}, len(cells))
+	for _, cell := range cells {
+		switch {
+		case cell.Code == "":
+			return fmt.Errorf("%w: empty cell code", ErrInvalidCell)
+		case cell.Capacity <= 0:
+			return fmt.Errorf("%w: cell %s must hold at least one order", ErrInvalidCell, cell.Code)
+		case cell.MaxWeight < 0 || cell.Dimensions.Length < 0 || cell.Dimensions.Width < 0 || cell.Dimensions.Height < 0:
+			return fmt.Errorf("%w: cell %s has negative limits", ErrInvalidCell, cell.Code)
+		}
+		if _, ok := codes[cell.Code]; ok {
+			return fmt.Errorf("%w: cell %s is listed twice", ErrInvalidCell, cell.Code)
+		}
+		codes[cell.Code] = struct{}{}
+	}
+	return nil
+}
+
+// FromConfig Ячейки зон схемы пункта: каждая полка каждого стеллажа зоны становится ячейкой.
+func FromConfig(zones []config.CellZoneConfig) []models.Cell {
+	var cells []models.Cell
+	for _, zone := range zones {
+		for rack := 1; rack <= zone.Racks; rack++ {
+			for shelf := 1; shelf <= zone.Shelves; shelf++ {
+				cells = append(cells, models.Cell{
+					Code:      models.NewCellCode(zone.Zone, rack, shelf),
+					Zone:      zone.Zone,
+					Rack:      rack,
+					Shelf:     shelf,
+					Capacity:  zone.Capacity,
+					MaxWeight: models.Kilo(zone.MaxWeightKg),
+					Dimensions: models.Dimensions{
+						Length: models.Centimeter(zone.LengthCm),
+						Width:  models.Centimeter(zone.WidthCm),
+						Height: models.Centimeter(zone.HeightCm),
+					},
+				})
+			}
+		}
+	}
+	return cells
+}
+
+// size Объем ячейки, ячейка без ограничения размеров считается больше любой другой.
+func size(cell models.Cell) int64 {
+	d := cell.Dimensions
+	if d == (models.Dimensions{}) {
+		return math.MaxInt64
+	}
+	return int64(d.Length) * int64(d.Width) * int64(d.Height)
+}
+
+// maxWeight Предельный вес ячейки, ячейка без ограничения веса считается грузоподъемнее любой другой.
+func maxWeight(cell models.Cell) float64 {
+	if cell.MaxWeight == 0 {
+		return math.MaxFloat64
+	}
+	return float64(cell.MaxWeight)
+}
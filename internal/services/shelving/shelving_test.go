@@ -0,0 +1,120 @@
+package shelving
+
+import (
+	"homework-1/internal/config"
+	"homework-1/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	smallCell = models.Cell{Code: "A-01-01", Capacity: 2, MaxWeight: 10, Dimensions: models.Dimensions{Length: 60, Width: 40, Height: 40}}
+	otherCell = models.Cell{Code: "A-01-02", Capacity: 2, MaxWeight: 10, Dimensions: models.Dimensions{Length: 60, Width: 40, Height: 40}}
+	largeCell = models.Cell{Code: "L-01-01", Capacity: 2}
+)
+
+func TestChoose(t *testing.T) {
+	t.Run("Заказ кладется в самую маленькую подходящую ячейку", func(t *testing.T) {
+		cells := []models.CellLoad{{Cell: largeCell}, {Cell: otherCell}, {Cell: smallCell}}
+
+		cell, err := Choose(cells, Parcel{Weight: 1})
+		require.NoError(t, err)
+		assert.Equal(t, smallCell.Code, cell)
+	})
+
+	t.Run("Заказ кладется к другим заказам клиента", func(t *testing.T) {
+		cells := []models.CellLoad{{Cell: smallCell}, {Cell: otherCell, Occupied: 1, HasCustomer: true}}
+
+		cell, err := Choose(cells, Parcel{Weight: 1})
+		require.NoError(t, err)
+		assert.Equal(t, otherCell.Code, cell)
+	})
+
+	t.Run("Крупный заказ уходит в ячейку без ограничений", func(t *testing.T) {
+		cells := []models.CellLoad{{Cell: smallCell, HasCustomer: true, Occupied: 1}, {Cell: largeCell}}
+
+		cell, err := Choose(cells, Parcel{Weight: 25})
+		require.NoError(t, err)
+		assert.Equal(t, largeCell.Code, cell)
+
+		cell, err = Choose(cells, Parcel{Weight: 1, Size: models.Dimensions{Length: 80, Width: 40, Height: 40}})
+		require.NoError(t, err)
+		assert.Equal(t, largeCell.Code, cell)
+	})
+
+	t.Run("Заполненные ячейки пропускаются", func(t *testing.T) {
+		cells := []models.CellLoad{{Cell: smallCell, Occupied: 2, HasCustomer: true}, {Cell: otherCell, Occupied: 1}}
+
+		cell, err := Choose(cells, Parcel{Weight: 1})
+		require.NoError(t, err)
+		assert.Equal(t, otherCell.Code, cell)
+	})
+
+	t.Run("Нет подходящей ячейки", func(t *testing.T) {
+		cells := []models.CellLoad{{Cell: smallCell}, {Cell: largeCell, Occupied: 2}}
+
+		_, err := Choose(cells, Parcel{Weight: 25})
+		assert.ErrorIs(t, err, ErrNoCell)
+
+		_, err = Choose(nil, Parcel{Weight: 1})
+		assert.ErrorIs(t, err, ErrNoCell)
+	})
+}
+
+func TestCheckMove(t *testing.T) {
+	cells := []models.CellLoad{{Cell: smallCell, Occupied: 2}, {Cell: otherCell}, {Cell: largeCell}}
+
+	t.Run("Заказ можно переложить в свободную подходящую ячейку", func(t *testing.T) {
+		assert.NoError(t, CheckMove(cells, otherCell.Code, Parcel{Weight: 1}))
+		assert.NoError(t, CheckMove(cells, largeCell.Code, Parcel{Weight: 30}))
+	})
+
+	t.Run("Ячейка заполнена", func(t *testing.T) {
+		assert.ErrorIs(t, CheckMove(cells, smallCell.Code, Parcel{Weight: 1}), ErrCellFull)
+	})
+
+	t.Run("Заказ не помещается в ячейку", func(t *testing.T) {
+		assert.ErrorIs(t, CheckMove(cells, otherCell.Code, Parcel{Weight: 11}), ErrCellSize)
+	})
+
+	t.Run("Ячейки нет в схеме пункта", func(t *testing.T) {
+		assert.ErrorIs(t, CheckMove(cells, "Z-01-01", Parcel{Weight: 1}), ErrUnknownCell)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("Корректная схема", func(t *testing.T) {
+		assert.NoError(t, Validate([]models.Cell{smallCell, otherCell, largeCell}))
+		assert.NoError(t, Validate(nil))
+	})
+
+	t.Run("Некорректная схема", func(t *testing.T) {
+		invalid := [][]models.Cell{
+			{{Capacity: 1}},
+			{{Code: "A-01-01"}},
+			{{Code: "A-01-01", Capacity: 1, MaxWeight: -1}},
+			{{Code: "A-01-01", Capacity: 1, Dimensions: models.Dimensions{Length: -1}}},
+			{smallCell, smallCell},
+		}
+		for _, cells := range invalid {
+			assert.ErrorIs(t, Validate(cells), ErrInvalidCell, "cells %v", cells)
+		}
+	})
+}
+
+func TestFromConfig(t *testing.T) {
+	cells := FromConfig([]config.CellZoneConfig{
+		{Zone: "A", Racks: 2, Shelves: 3, Capacity: 6, MaxWeightKg: 10, LengthCm: 60, WidthCm: 40, HeightCm: 40},
+		{Zone: "L", Racks: 1, Shelves: 1, Capacity: 2},
+	})
+
+	require.Len(t, cells, 7)
+	assert.Equal(t, models.Cell{
+		Code: "A-02-03", Zone: "A", Rack: 2, Shelf: 3, Capacity: 6, MaxWeight: 10,
+		Dimensions: models.Dimensions{Length: 60, Width: 40, Height: 40},
+	}, cells[5])
+	assert.Equal(t, models.Cell{Code: "L-01-01", Zone: "L", Rack: 1, Shelf: 1, Capacity: 2}, cells[6])
+	assert.NoError(t, Validate(cells))
+}
@@ -0,0 +1,113 @@
+package sweeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"homework-1/internal/cache"
+	"homework-1/internal/models"
+	"homework-1/internal/module"
+	"log"
+	"time"
+)
+
+// Batch Обрабатывает не больше limit заказов пункта из контекста и возвращает обработанные заказы.
+type Batch func(ctx context.Context, limit int) ([]models.Order, error)
+
+type Deps struct {
+	Module module.ModuleInterface
+	Redis  cache.CacheInterface
+}
+
+// Sweeper Периодически обходит все пункты выдачи и обрабатывает заказы каждого пункта пачками. Что делать
+// с пачкой, решает batch; после каждой пачки сбрасывается кеш заказов затронутых клиентов.
+type Sweeper struct {
+	Deps
+	name      string
+	batch     Batch
+	interval  time.Duration
+	batchSize int
+}
+
+func NewSweeper(d Deps, name string, batch Batch, interval time.Duration, batchSize int) *Sweeper {
+	return &Sweeper{
+		Deps:      d,
+		name:      name,
+		batch:     batch,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Printf("%s: stopping sweeper\n", s.name)
+			return
+		case <-ticker.C:
+			processed, err := s.Sweep(ctx)
+			if err != nil {
+				log.Printf("%s error: %s\n", s.name, err)
+			}
+			if processed > 0 {
+				log.Printf("%s: %d orders processed\n", s.name, processed)
+			}
+		}
+	}
+}
+
+// Sweep Обрабатывает заказы во всех пунктах выдачи и возвращает их количество. Ошибка в одном пункте
+// не мешает обработать остальные.
+func (s *Sweeper) Sweep(ctx context.Context) (int, error) {
+	points, err := s.Module.PickupPoints(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("sweeper.Sweep error: %w", err)
+	}
+
+	total := 0
+	var errs []error
+	for _, point := range points {
+		processed, errPoint := s.sweepPoint(models.WithPoint(ctx, point.ID))
+		total += processed
+		if errPoint != nil {
+			errs = append(errs, fmt.Errorf("point %s: %w", point.ID, errPoint))
+		}
+	}
+
+	if len(errs) > 0 {
+		return total, fmt.Errorf("sweeper.Sweep error: %w", errors.Join(errs...))
+	}
+	return total, nil
+}
+
+// sweepPoint Заказы пункта из контекста обрабатываются пачками: полная пачка означает, что такие заказы
+// еще могли остаться.
+func (s *Sweeper) sweepPoint(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		processed, err := s.batch(ctx, s.batchSize)
+		if err != nil {
+			return total, fmt.Errorf("sweeper.sweepPoint error: %w", err)
+		}
+		total += len(processed)
+
+		// У заказа сменился статус, и список заказов клиента в кеше устарел.
+		customers := make(map[models.ID]struct{}, len(processed))
+		for _, order := range processed {
+			customers[order.CustomerID] = struct{}{}
+		}
+		for customerId := range customers {
+			if errCache := s.Redis.Delete(ctx, cache.OrdersKey(models.PointFromContext(ctx), customerId)); errCache != nil {
+				return total, fmt.Errorf("sweeper.sweepPoint error clearing cache: %w", errCache)
+			}
+		}
+
+		if len(processed) < s.batchSize {
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package sweeper
+
+import (
+	"context"
+	"errors"
+	"homework-1/internal/cache"
+	mockcache "homework-1/internal/cache/mocks"
+	"homework-1/internal/models"
+	mockmodule "homework-1/internal/module/mocks"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSweeper_Sweep(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	mockCache := mockcache.NewMockCacheInterface(ctrl)
+
+	t.Run("Пачка получает пункт из контекста и размер пачки", func(t *testing.T) {
+		var seen []models.PointID
+		batch := func(ctx context.Context, limit int) ([]models.Order, error) {
+			assert.Equal(t, 2, limit)
+			seen = append(seen, models.PointFromContext(ctx))
+			return []models.Order{{OrderID: 1, CustomerID: 10}}, nil
+		}
+		sweeper := NewSweeper(Deps{Module: mockModule, Redis: mockCache}, "test", batch, 0, 2)
+
+		mockModule.EXPECT().PickupPoints(gomock.Any()).Return([]models.PickupPoint{{ID: "msk-1"}, {ID: "spb-1"}}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("msk-1", 10)).Return(nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("spb-1", 10)).Return(nil)
+
+		processed, err := sweeper.Sweep(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, processed)
+		assert.Equal(t, []models.PointID{"msk-1", "spb-1"}, seen)
+	})
+
+	t.Run("Ошибка сброса кеша прекращает обработку пункта", func(t *testing.T) {
+		errCache := errors.New("redis is unavailable")
+		calls := 0
+		batch := func(ctx context.Context, limit int) ([]models.Order, error) {
+			calls++
+			return []models.Order{{OrderID: 1, CustomerID: 10}, {OrderID: 2, CustomerID: 10}}, nil
+		}
+		sweeper := NewSweeper(Deps{Module: mockModule, Redis: mockCache}, "test", batch, 0, 2)
+
+		mockModule.EXPECT().PickupPoints(gomock.Any()).Return([]models.PickupPoint{{ID: "msk-1"}}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("msk-1", 10)).Return(errCache)
+
+		processed, err := sweeper.Sweep(context.Background())
+		assert.ErrorIs(t, err, errCache)
+		assert.Equal(t, 2, processed)
+		assert.Equal(t, 1, calls)
+	})
+}
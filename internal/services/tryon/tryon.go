@@ -0,0 +1,32 @@
+package tryon
+
+import (
+	"context"
+	"homework-1/internal/models"
+	"homework-1/internal/services/sweeper"
+	"time"
+)
+
+// timeoutOperator Под этим оператором в истории заказа записывается возврат на полку по окончании примерки.
+const timeoutOperator = models.Operator("try-on-timeout")
+
+type Deps = sweeper.Deps
+
+// Sweeper Периодически возвращает на полку заказы, время примерки которых вышло, чтобы их снова можно было
+// выдать или вернуть курьеру.
+type Sweeper struct {
+	*sweeper.Sweeper
+}
+
+func NewSweeper(d Deps, interval time.Duration, batchSize int) *Sweeper {
+	release := func(ctx context.Context, limit int) ([]models.Order, error) {
+		return d.Module.ReleaseTryOns(ctx, limit, timeoutOperator)
+	}
+	return &Sweeper{Sweeper: sweeper.NewSweeper(d, "tryon.Sweeper", release, interval, batchSize)}
+}
+
+// ReleaseTimedOut Возвращает на полку все заказы с вышедшим временем примерки во всех пунктах выдачи и возвращает
+// их количество.
+func (s *Sweeper) ReleaseTimedOut(ctx context.Context) (int, error) {
+	return s.Sweep(ctx)
+}
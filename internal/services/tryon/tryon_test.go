@@ -0,0 +1,104 @@
+package tryon
+
+import (
+	"context"
+	"errors"
+	"homework-1/internal/cache"
+	mockcache "homework-1/internal/cache/mocks"
+	"homework-1/internal/models"
+	mockmodule "homework-1/internal/module/mocks"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pointMatcher Проверяет, что запрос к модулю выполняется в границах пункта point.
+type pointMatcher models.PointID
+
+func (m pointMatcher) Matches(x interface{}) bool {
+	ctx, ok := x.(context.Context)
+	return ok && models.PointFromContext(ctx) == models.PointID(m)
+}
+
+func (m pointMatcher) String() string {
+	return "context with point " + string(m)
+}
+
+func TestSweeper_ReleaseTimedOut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModule := mockmodule.NewMockModuleInterface(ctrl)
+	mockCache := mockcache.NewMockCacheInterface(ctrl)
+	sweeper := NewSweeper(Deps{Module: mockModule, Redis: mockCache}, 0, 2)
+
+	points := []models.PickupPoint{{ID: "msk-1"}, {ID: "spb-1"}}
+
+	t.Run("Пачки запрашиваются, пока очередная не окажется неполной", func(t *testing.T) {
+		mockModule.EXPECT().PickupPoints(gomock.Any()).Return(points[:1], nil)
+		gomock.InOrder(
+			mockModule.EXPECT().ReleaseTryOns(pointMatcher("msk-1"), 2, timeoutOperator).Return([]models.Order{
+				{OrderID: 1, CustomerID: 10},
+				{OrderID: 2, CustomerID: 10},
+			}, nil),
+			mockModule.EXPECT().ReleaseTryOns(pointMatcher("msk-1"), 2, timeoutOperator).Return([]models.Order{
+				{OrderID: 3, CustomerID: 20},
+			}, nil),
+		)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("msk-1", 10)).Return(nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("msk-1", 20)).Return(nil)
+
+		released, err := sweeper.ReleaseTimedOut(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 3, released)
+	})
+
+	t.Run("Заказы каждого пункта возвращаются в границах этого пункта", func(t *testing.T) {
+		mockModule.EXPECT().PickupPoints(gomock.Any()).Return(points, nil)
+		mockModule.EXPECT().ReleaseTryOns(pointMatcher("msk-1"), 2, timeoutOperator).Return([]models.Order{
+			{OrderID: 1, CustomerID: 10},
+		}, nil)
+		mockModule.EXPECT().ReleaseTryOns(pointMatcher("spb-1"), 2, timeoutOperator).Return([]models.Order{
+			{OrderID: 5, CustomerID: 10},
+		}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("msk-1", 10)).Return(nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("spb-1", 10)).Return(nil)
+
+		released, err := sweeper.ReleaseTimedOut(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 2, released)
+	})
+
+	t.Run("Нет заказов с вышедшим временем примерки", func(t *testing.T) {
+		mockModule.EXPECT().PickupPoints(gomock.Any()).Return(points[:1], nil)
+		mockModule.EXPECT().ReleaseTryOns(gomock.Any(), 2, timeoutOperator).Return(nil, nil)
+
+		released, err := sweeper.ReleaseTimedOut(context.Background())
+		require.NoError(t, err)
+		assert.Zero(t, released)
+	})
+
+	t.Run("Ошибка в одном пункте не мешает обработать остальные", func(t *testing.T) {
+		errStorage := errors.New("storage is unavailable")
+		mockModule.EXPECT().PickupPoints(gomock.Any()).Return(points, nil)
+		mockModule.EXPECT().ReleaseTryOns(pointMatcher("msk-1"), 2, timeoutOperator).Return(nil, errStorage)
+		mockModule.EXPECT().ReleaseTryOns(pointMatcher("spb-1"), 2, timeoutOperator).Return([]models.Order{
+			{OrderID: 5, CustomerID: 20},
+		}, nil)
+		mockCache.EXPECT().Delete(gomock.Any(), cache.OrdersKey("spb-1", 20)).Return(nil)
+
+		released, err := sweeper.ReleaseTimedOut(context.Background())
+		assert.ErrorIs(t, err, errStorage)
+		assert.Equal(t, 1, released)
+	})
+
+	t.Run("Ошибка справочника пунктов", func(t *testing.T) {
+		errStorage := errors.New("storage is unavailable")
+		mockModule.EXPECT().PickupPoints(gomock.Any()).Return(nil, errStorage)
+
+		_, err := sweeper.ReleaseTimedOut(context.Background())
+		assert.ErrorIs(t, err, errStorage)
+	})
+}
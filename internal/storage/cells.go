@@ -0,0 +1,217 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"homework-1/internal/models"
+	"homework-1/internal/storage/schema"
+	"strings"
+	"time"
+)
+
+var ErrCellNotFound = errors.New("storage cell not found")
+
+var (
+	cellColumns = []string{
+		"c.point_id", "c.code", "c.zone", "c.rack", "c.shelf", "c.capacity",
+		"c.max_weight", "c.length_cm", "c.width_cm", "c.height_cm"}
+	cellTable = "cells"
+)
+
+// MoveOrder Блокирует заказ и передает его в move вместе с заполненностью ячеек пункта. Заказ из события
+// сохраняется в той же транзакции, ячейка назначения отмечается занятой, как при приеме заказа.
+func (s *PostgresDB) MoveOrder(ctx context.Context, orderId models.ID, move func(order models.Order, cells []models.CellLoad) (models.OrderEvent, error)) (models.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.MoveOrder")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return models.Order{}, fmt.Errorf("storage.MoveOrder error: %w", errScope)
+	}
+
+	var moved models.Order
+
+	f := func(ctxTX context.Context) error {
+		queryEngine := s.tr.GetQueryEngine(ctxTX)
+
+		sql, args, errSql := sq.
+			Select(orderColumns...).
+			From(orderTable).
+			Where(sq.Eq{"point_id": point, "order_id": orderId}).
+			Suffix("FOR UPDATE").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if errSql != nil {
+			return fmt.Errorf("storage.MoveOrder error: %w", errSql)
+		}
+
+		var ordRecord schema.OrderRecord
+		if errScan := scanOrder(queryEngine.QueryRow(ctxTX, sql, args...), &ordRecord); errScan != nil {
+			if errors.Is(errScan, pgx.ErrNoRows) {
+				return fmt.Errorf("storage.MoveOrder error: %w", ErrOrderNotFound)
+			}
+			return fmt.Errorf("storage.MoveOrder error: %w", errScan)
+		}
+		orders := []models.Order{ordRecord.ToDomain()}
+		if errItems := s.loadItems(ctxTX, orders); errItems != nil {
+			return errItems
+		}
+
+		cells, errCells := s.cellLoads(ctxTX, point, orders[0].CustomerID)
+		if errCells != nil {
+			return fmt.Errorf("storage.MoveOrder error: %w", errCells)
+		}
+
+		event, errMove := move(orders[0], cells)
+		if errMove != nil {
+			return errMove
+		}
+
+		if errTouch := s.touchCell(ctxTX, point, event.Order.Cell); errTouch != nil {
+			return errTouch
+		}
+		if errUpdate := s.updateOrder(ctxTX, event.Order); errUpdate != nil {
+			return errUpdate
+		}
+		moved = event.Order
+
+		return s.addEvent(ctxTX, event)
+	}
+
+	if err := s.tr.RunRepeatableRead(ctx, f); err != nil {
+		return models.Order{}, fmt.Errorf("storage.MoveOrder error: %w", err)
+	}
+
+	return moved, nil
+}
+
+// placeOrder Ячейка для нового заказа клиента customerId. У пункта без ячеек заказ принимается без ячейки.
+func (s *PostgresDB) placeOrder(ctx context.Context, point models.PointID, customerId models.ID, place func(cells []models.CellLoad) (models.CellCode, error)) (models.CellCode, error) {
+	if place == nil {
+		return "", nil
+	}
+
+	cells, errCells := s.cellLoads(ctx, point, customerId)
+	if errCells != nil {
+		return "", fmt.Errorf("storage.placeOrder error: %w", errCells)
+	}
+	if len(cells) == 0 {
+		return "", nil
+	}
+
+	cell, errPlace := place(cells)
+	if errPlace != nil {
+		return "", errPlace
+	}
+
+	if errTouch := s.touchCell(ctx, point, cell); errTouch != nil {
+		return "", errTouch
+	}
+
+	return cell, nil
+}
+
+// cellLoads Ячейки пункта point с числом лежащих в них заказов и отметкой, есть ли среди них заказы клиента customerId.
+func (s *PostgresDB) cellLoads(ctx context.Context, point models.PointID, customerId models.ID) ([]models.CellLoad, error) {
+	sql, args, errSql := sq.
+		Select(cellColumns...).
+		Column("COUNT(o.order_id)").
+		Column(sq.Expr("COALESCE(BOOL_OR(o.customer_id = ?), FALSE)", customerId)).
+		From(cellTable+" c").
+		LeftJoin(orderTable+" o ON o.point_id = c.point_id AND o.cell = c.code").
+		Where(sq.Eq{"c.point_id": string(point)}).
+		GroupBy("c.point_id", "c.code").
+		OrderBy("c.code").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return nil, errSql
+	}
+
+	rows, errQuery := s.tr.GetQueryEngine(ctx).Query(ctx, sql, args...)
+	if errQuery != nil {
+		return nil, errQuery
+	}
+	defer rows.Close()
+
+	var cells []models.CellLoad
+	for rows.Next() {
+		var record schema.CellLoadRecord
+		if errScan := rows.Scan(&record.PointID, &record.Code, &record.Zone, &record.Rack, &record.Shelf, &record.Capacity,
+			&record.MaxWeight, &record.LengthCm, &record.WidthCm, &record.HeightCm,
+			&record.Occupied, &record.HasCustomer); errScan != nil {
+			return nil, errScan
+		}
+		cells = append(cells, record.ToDomain())
+	}
+
+	return cells, rows.Err()
+}
+
+// touchCell Отмечает, что в ячейку кладется заказ. Заполненность ячейки считается по заказам, поэтому без отметки
+// две параллельные транзакции могли бы положить последний заказ в одну ячейку: теперь вторая из них
+// завершится ошибкой сериализации, транзактор повторит ее, и ячейка выберется заново по новой заполненности.
+func (s *PostgresDB) touchCell(ctx context.Context, point models.PointID, cell models.CellCode) error {
+	if cell == "" {
+		return nil
+	}
+
+	sql, args, errSql := sq.
+		Update(cellTable).
+		Set("assigned_at", time.Now()).
+		Where(sq.Eq{"point_id": string(point), "code": string(cell)}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return fmt.Errorf("storage.touchCell error: %w", errSql)
+	}
+
+	tag, errExec := s.tr.GetQueryEngine(ctx).Exec(ctx, sql, args...)
+	if errExec != nil {
+		return fmt.Errorf("storage.touchCell error: %w", errExec)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("storage.touchCell error: %w: %s", ErrCellNotFound, cell)
+	}
+
+	return nil
+}
+
+// seedCells Добавляет ячейки схемы пункта и обновляет ограничения уже существующих: так изменения схемы
+// вступают в силу после перезапуска. Ячейки, которых нет в схеме, не удаляются, ведь в них могут лежать заказы.
+func (s *PostgresDB) seedCells(ctx context.Context, point models.PointID, cells []models.Cell) error {
+	if len(cells) == 0 {
+		return nil
+	}
+
+	columns := make([]string, 0, len(cellColumns))
+	for _, column := range cellColumns {
+		columns = append(columns, strings.TrimPrefix(column, "c."))
+	}
+
+	builder := sq.
+		Insert(cellTable).
+		Columns(columns...).
+		Suffix("ON CONFLICT (point_id, code) DO UPDATE SET capacity = EXCLUDED.capacity, max_weight = EXCLUDED.max_weight, " +
+			"length_cm = EXCLUDED.length_cm, width_cm = EXCLUDED.width_cm, height_cm = EXCLUDED.height_cm")
+	for _, cell := range cells {
+		record := schema.TransformCell(point, cell)
+		builder = builder.Values(record.PointID, record.Code, record.Zone, record.Rack, record.Shelf, record.Capacity,
+			record.MaxWeight, record.LengthCm, record.WidthCm, record.HeightCm)
+	}
+
+	sql, args, errSql := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if errSql != nil {
+		return fmt.Errorf("storage.seedCells error: %w", errSql)
+	}
+
+	if _, errExec := s.tr.GetQueryEngine(ctx).Exec(ctx, sql, args...); errExec != nil {
+		return fmt.Errorf("storage.seedCells error: %w", errExec)
+	}
+
+	return nil
+}
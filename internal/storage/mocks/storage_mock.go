@@ -5,8 +5,10 @@
 package storage_mock
 
 import (
+	context "context"
 	models "homework-1/internal/models"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,104 +37,489 @@ func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
 }
 
 // AddOrder mocks base method.
-func (m *MockStorage) AddOrder(order models.Order) error {
+func (m *MockStorage) AddOrder(ctx context.Context, order models.Order, event models.OrderEvent, code models.PickupCode, place func([]models.CellLoad) (models.CellCode, error)) (models.ID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddOrder", order)
+	ret := m.ctrl.Call(m, "AddOrder", ctx, order, event, code, place)
+	ret0, _ := ret[0].(models.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOrder indicates an expected call of AddOrder.
+func (mr *MockStorageMockRecorder) AddOrder(ctx, order, event, code, place interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrder", reflect.TypeOf((*MockStorage)(nil).AddOrder), ctx, order, event, code, place)
+}
+
+// AddPackage mocks base method.
+func (m *MockStorage) AddPackage(ctx context.Context, spec models.PackageSpec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPackage", ctx, spec)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// AddOrder indicates an expected call of AddOrder.
-func (mr *MockStorageMockRecorder) AddOrder(order interface{}) *gomock.Call {
+// AddPackage indicates an expected call of AddPackage.
+func (mr *MockStorageMockRecorder) AddPackage(ctx, spec interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrder", reflect.TypeOf((*MockStorage)(nil).AddOrder), order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPackage", reflect.TypeOf((*MockStorage)(nil).AddPackage), ctx, spec)
 }
 
 // ChangeOrder mocks base method.
-func (m *MockStorage) ChangeOrder(order models.Order) error {
+func (m *MockStorage) ChangeOrder(ctx context.Context, order models.Order) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangeOrder", order)
+	ret := m.ctrl.Call(m, "ChangeOrder", ctx, order)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ChangeOrder indicates an expected call of ChangeOrder.
-func (mr *MockStorageMockRecorder) ChangeOrder(order interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) ChangeOrder(ctx, order interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeOrder", reflect.TypeOf((*MockStorage)(nil).ChangeOrder), order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeOrder", reflect.TypeOf((*MockStorage)(nil).ChangeOrder), ctx, order)
+}
+
+// ChangeRefund mocks base method.
+func (m *MockStorage) ChangeRefund(ctx context.Context, refundId models.ID, change func(models.Refund, models.Order) (models.Refund, models.RefundChange, []models.OrderEvent, error)) (models.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeRefund", ctx, refundId, change)
+	ret0, _ := ret[0].(models.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeRefund indicates an expected call of ChangeRefund.
+func (mr *MockStorageMockRecorder) ChangeRefund(ctx, refundId, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeRefund", reflect.TypeOf((*MockStorage)(nil).ChangeRefund), ctx, refundId, change)
+}
+
+// ChangeStatus mocks base method.
+func (m *MockStorage) ChangeStatus(ctx context.Context, order models.Order, event models.OrderEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStatus", ctx, order, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeStatus indicates an expected call of ChangeStatus.
+func (mr *MockStorageMockRecorder) ChangeStatus(ctx, order, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStatus", reflect.TypeOf((*MockStorage)(nil).ChangeStatus), ctx, order, event)
+}
+
+// ChangeStatuses mocks base method.
+func (m *MockStorage) ChangeStatuses(ctx context.Context, orderIds []models.ID, change func([]models.Order) ([]models.OrderEvent, error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStatuses", ctx, orderIds, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeStatuses indicates an expected call of ChangeStatuses.
+func (mr *MockStorageMockRecorder) ChangeStatuses(ctx, orderIds, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStatuses", reflect.TypeOf((*MockStorage)(nil).ChangeStatuses), ctx, orderIds, change)
+}
+
+// CreateRefund mocks base method.
+func (m *MockStorage) CreateRefund(ctx context.Context, refund models.Refund, change models.RefundChange, event models.OrderEvent) (models.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefund", ctx, refund, change, event)
+	ret0, _ := ret[0].(models.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRefund indicates an expected call of CreateRefund.
+func (mr *MockStorageMockRecorder) CreateRefund(ctx, refund, change, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefund", reflect.TypeOf((*MockStorage)(nil).CreateRefund), ctx, refund, change, event)
 }
 
 // GetCustomersOrders mocks base method.
-func (m *MockStorage) GetCustomersOrders(customerId models.ID) ([]models.Order, error) {
+func (m *MockStorage) GetCustomersOrders(ctx context.Context, query models.OrdersQuery) ([]models.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCustomersOrders", customerId)
+	ret := m.ctrl.Call(m, "GetCustomersOrders", ctx, query)
 	ret0, _ := ret[0].([]models.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCustomersOrders indicates an expected call of GetCustomersOrders.
-func (mr *MockStorageMockRecorder) GetCustomersOrders(customerId interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) GetCustomersOrders(ctx, query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomersOrders", reflect.TypeOf((*MockStorage)(nil).GetCustomersOrders), customerId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomersOrders", reflect.TypeOf((*MockStorage)(nil).GetCustomersOrders), ctx, query)
+}
+
+// GetExpiredOrders mocks base method.
+func (m *MockStorage) GetExpiredOrders(ctx context.Context, now time.Time, limit int) ([]models.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredOrders", ctx, now, limit)
+	ret0, _ := ret[0].([]models.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredOrders indicates an expected call of GetExpiredOrders.
+func (mr *MockStorageMockRecorder) GetExpiredOrders(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredOrders", reflect.TypeOf((*MockStorage)(nil).GetExpiredOrders), ctx, now, limit)
+}
+
+// GetOperatorPoint mocks base method.
+func (m *MockStorage) GetOperatorPoint(ctx context.Context, operator models.Operator) (models.PointID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOperatorPoint", ctx, operator)
+	ret0, _ := ret[0].(models.PointID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOperatorPoint indicates an expected call of GetOperatorPoint.
+func (mr *MockStorageMockRecorder) GetOperatorPoint(ctx, operator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOperatorPoint", reflect.TypeOf((*MockStorage)(nil).GetOperatorPoint), ctx, operator)
 }
 
 // GetOrder mocks base method.
-func (m *MockStorage) GetOrder(orderId models.ID) (models.Order, error) {
+func (m *MockStorage) GetOrder(ctx context.Context, orderId models.ID) (models.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrder", orderId)
+	ret := m.ctrl.Call(m, "GetOrder", ctx, orderId)
 	ret0, _ := ret[0].(models.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOrder indicates an expected call of GetOrder.
-func (mr *MockStorageMockRecorder) GetOrder(orderId interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) GetOrder(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockStorage)(nil).GetOrder), ctx, orderId)
+}
+
+// GetOrderRefunds mocks base method.
+func (m *MockStorage) GetOrderRefunds(ctx context.Context, orderId models.ID, state models.RefundState) ([]models.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderRefunds", ctx, orderId, state)
+	ret0, _ := ret[0].([]models.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderRefunds indicates an expected call of GetOrderRefunds.
+func (mr *MockStorageMockRecorder) GetOrderRefunds(ctx, orderId, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderRefunds", reflect.TypeOf((*MockStorage)(nil).GetOrderRefunds), ctx, orderId, state)
+}
+
+// GetPackage mocks base method.
+func (m *MockStorage) GetPackage(ctx context.Context, pack models.PackageType) (models.PackageSpec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackage", ctx, pack)
+	ret0, _ := ret[0].(models.PackageSpec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackage indicates an expected call of GetPackage.
+func (mr *MockStorageMockRecorder) GetPackage(ctx, pack interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackage", reflect.TypeOf((*MockStorage)(nil).GetPackage), ctx, pack)
+}
+
+// GetPackages mocks base method.
+func (m *MockStorage) GetPackages(ctx context.Context, includeRetired bool) ([]models.PackageSpec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPackages", ctx, includeRetired)
+	ret0, _ := ret[0].([]models.PackageSpec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPackages indicates an expected call of GetPackages.
+func (mr *MockStorageMockRecorder) GetPackages(ctx, includeRetired interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPackages", reflect.TypeOf((*MockStorage)(nil).GetPackages), ctx, includeRetired)
+}
+
+// GetPickupCode mocks base method.
+func (m *MockStorage) GetPickupCode(ctx context.Context, customerId models.ID) (models.PickupCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickupCode", ctx, customerId)
+	ret0, _ := ret[0].(models.PickupCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickupCode indicates an expected call of GetPickupCode.
+func (mr *MockStorageMockRecorder) GetPickupCode(ctx, customerId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickupCode", reflect.TypeOf((*MockStorage)(nil).GetPickupCode), ctx, customerId)
+}
+
+// GetPickupPoint mocks base method.
+func (m *MockStorage) GetPickupPoint(ctx context.Context, pointId models.PointID) (models.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickupPoint", ctx, pointId)
+	ret0, _ := ret[0].(models.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickupPoint indicates an expected call of GetPickupPoint.
+func (mr *MockStorageMockRecorder) GetPickupPoint(ctx, pointId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickupPoint", reflect.TypeOf((*MockStorage)(nil).GetPickupPoint), ctx, pointId)
+}
+
+// GetPickupPoints mocks base method.
+func (m *MockStorage) GetPickupPoints(ctx context.Context) ([]models.PickupPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickupPoints", ctx)
+	ret0, _ := ret[0].([]models.PickupPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickupPoints indicates an expected call of GetPickupPoints.
+func (mr *MockStorageMockRecorder) GetPickupPoints(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickupPoints", reflect.TypeOf((*MockStorage)(nil).GetPickupPoints), ctx)
+}
+
+// GetRefund mocks base method.
+func (m *MockStorage) GetRefund(ctx context.Context, refundId models.ID) (models.Refund, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefund", ctx, refundId)
+	ret0, _ := ret[0].(models.Refund)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefund indicates an expected call of GetRefund.
+func (mr *MockStorageMockRecorder) GetRefund(ctx, refundId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockStorage)(nil).GetOrder), orderId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefund", reflect.TypeOf((*MockStorage)(nil).GetRefund), ctx, refundId)
+}
+
+// GetRefundHistory mocks base method.
+func (m *MockStorage) GetRefundHistory(ctx context.Context, refundId models.ID) ([]models.RefundChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefundHistory", ctx, refundId)
+	ret0, _ := ret[0].([]models.RefundChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefundHistory indicates an expected call of GetRefundHistory.
+func (mr *MockStorageMockRecorder) GetRefundHistory(ctx, refundId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefundHistory", reflect.TypeOf((*MockStorage)(nil).GetRefundHistory), ctx, refundId)
 }
 
 // GetRefunds mocks base method.
-func (m *MockStorage) GetRefunds() ([]models.Order, error) {
+func (m *MockStorage) GetRefunds(ctx context.Context, query models.RefundsQuery) ([]models.Order, int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRefunds")
+	ret := m.ctrl.Call(m, "GetRefunds", ctx, query)
 	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRefunds indicates an expected call of GetRefunds.
+func (mr *MockStorageMockRecorder) GetRefunds(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefunds", reflect.TypeOf((*MockStorage)(nil).GetRefunds), ctx, query)
+}
+
+// GetStatusHistory mocks base method.
+func (m *MockStorage) GetStatusHistory(ctx context.Context, orderId models.ID) ([]models.StatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusHistory", ctx, orderId)
+	ret0, _ := ret[0].([]models.StatusChange)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetRefunds indicates an expected call of GetRefunds.
-func (mr *MockStorageMockRecorder) GetRefunds() *gomock.Call {
+// GetStatusHistory indicates an expected call of GetStatusHistory.
+func (mr *MockStorageMockRecorder) GetStatusHistory(ctx, orderId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefunds", reflect.TypeOf((*MockStorage)(nil).GetRefunds))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusHistory", reflect.TypeOf((*MockStorage)(nil).GetStatusHistory), ctx, orderId)
 }
 
-// ReceiveOrder mocks base method.
-func (m *MockStorage) ReceiveOrder(orderId models.ID) (models.Order, error) {
+// GetTimedOutTryOns mocks base method.
+func (m *MockStorage) GetTimedOutTryOns(ctx context.Context, now time.Time, limit int) ([]models.ID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReceiveOrder", orderId)
+	ret := m.ctrl.Call(m, "GetTimedOutTryOns", ctx, now, limit)
+	ret0, _ := ret[0].([]models.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimedOutTryOns indicates an expected call of GetTimedOutTryOns.
+func (mr *MockStorageMockRecorder) GetTimedOutTryOns(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimedOutTryOns", reflect.TypeOf((*MockStorage)(nil).GetTimedOutTryOns), ctx, now, limit)
+}
+
+// HandOverOrders mocks base method.
+func (m *MockStorage) HandOverOrders(ctx context.Context, orderIds []models.ID, used, next models.PickupCode, change func([]models.Order) ([]models.OrderEvent, error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandOverOrders", ctx, orderIds, used, next, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandOverOrders indicates an expected call of HandOverOrders.
+func (mr *MockStorageMockRecorder) HandOverOrders(ctx, orderIds, used, next, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandOverOrders", reflect.TypeOf((*MockStorage)(nil).HandOverOrders), ctx, orderIds, used, next, change)
+}
+
+// MoveOrder mocks base method.
+func (m *MockStorage) MoveOrder(ctx context.Context, orderId models.ID, move func(models.Order, []models.CellLoad) (models.OrderEvent, error)) (models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveOrder", ctx, orderId, move)
 	ret0, _ := ret[0].(models.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ReceiveOrder indicates an expected call of ReceiveOrder.
-func (mr *MockStorageMockRecorder) ReceiveOrder(orderId interface{}) *gomock.Call {
+// MoveOrder indicates an expected call of MoveOrder.
+func (mr *MockStorageMockRecorder) MoveOrder(ctx, orderId, move interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveOrder", reflect.TypeOf((*MockStorage)(nil).ReceiveOrder), orderId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveOrder", reflect.TypeOf((*MockStorage)(nil).MoveOrder), ctx, orderId, move)
+}
+
+// PublishEvents mocks base method.
+func (m *MockStorage) PublishEvents(ctx context.Context, limit int, publish func(models.OrderEvent) error) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishEvents", ctx, limit, publish)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishEvents indicates an expected call of PublishEvents.
+func (mr *MockStorageMockRecorder) PublishEvents(ctx, limit, publish interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishEvents", reflect.TypeOf((*MockStorage)(nil).PublishEvents), ctx, limit, publish)
+}
+
+// RecordPickupFailure mocks base method.
+func (m *MockStorage) RecordPickupFailure(ctx context.Context, customerId models.ID, maxAttempts int, lockedUntil time.Time) (models.PickupCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPickupFailure", ctx, customerId, maxAttempts, lockedUntil)
+	ret0, _ := ret[0].(models.PickupCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordPickupFailure indicates an expected call of RecordPickupFailure.
+func (mr *MockStorageMockRecorder) RecordPickupFailure(ctx, customerId, maxAttempts, lockedUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPickupFailure", reflect.TypeOf((*MockStorage)(nil).RecordPickupFailure), ctx, customerId, maxAttempts, lockedUntil)
+}
+
+// ResolveOrderID mocks base method.
+func (m *MockStorage) ResolveOrderID(ctx context.Context, ref models.ExternalRef) (models.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveOrderID", ctx, ref)
+	ret0, _ := ret[0].(models.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveOrderID indicates an expected call of ResolveOrderID.
+func (mr *MockStorageMockRecorder) ResolveOrderID(ctx, ref interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveOrderID", reflect.TypeOf((*MockStorage)(nil).ResolveOrderID), ctx, ref)
+}
+
+// RetirePackage mocks base method.
+func (m *MockStorage) RetirePackage(ctx context.Context, pack models.PackageType, retiredAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetirePackage", ctx, pack, retiredAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetirePackage indicates an expected call of RetirePackage.
+func (mr *MockStorageMockRecorder) RetirePackage(ctx, pack, retiredAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetirePackage", reflect.TypeOf((*MockStorage)(nil).RetirePackage), ctx, pack, retiredAt)
+}
+
+// ReturnItems mocks base method.
+func (m *MockStorage) ReturnItems(ctx context.Context, event models.OrderEvent, refunds []models.RefundChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReturnItems", ctx, event, refunds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReturnItems indicates an expected call of ReturnItems.
+func (mr *MockStorageMockRecorder) ReturnItems(ctx, event, refunds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnItems", reflect.TypeOf((*MockStorage)(nil).ReturnItems), ctx, event, refunds)
 }
 
 // ReturnOrder mocks base method.
-func (m *MockStorage) ReturnOrder(orderId models.ID) (models.Order, error) {
+func (m *MockStorage) ReturnOrder(ctx context.Context, event models.OrderEvent, refunds []models.RefundChange) (models.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReturnOrder", orderId)
+	ret := m.ctrl.Call(m, "ReturnOrder", ctx, event, refunds)
 	ret0, _ := ret[0].(models.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ReturnOrder indicates an expected call of ReturnOrder.
-func (mr *MockStorageMockRecorder) ReturnOrder(orderId interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) ReturnOrder(ctx, event, refunds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrder", reflect.TypeOf((*MockStorage)(nil).ReturnOrder), ctx, event, refunds)
+}
+
+// SeedPackages mocks base method.
+func (m *MockStorage) SeedPackages(ctx context.Context, specs []models.PackageSpec) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeedPackages", ctx, specs)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeedPackages indicates an expected call of SeedPackages.
+func (mr *MockStorageMockRecorder) SeedPackages(ctx, specs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeedPackages", reflect.TypeOf((*MockStorage)(nil).SeedPackages), ctx, specs)
+}
+
+// SeedPickupPoints mocks base method.
+func (m *MockStorage) SeedPickupPoints(ctx context.Context, points []models.PickupPoint) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SeedPickupPoints", ctx, points)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SeedPickupPoints indicates an expected call of SeedPickupPoints.
+func (mr *MockStorageMockRecorder) SeedPickupPoints(ctx, points interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeedPickupPoints", reflect.TypeOf((*MockStorage)(nil).SeedPickupPoints), ctx, points)
+}
+
+// UpdatePackage mocks base method.
+func (m *MockStorage) UpdatePackage(ctx context.Context, spec models.PackageSpec) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePackage", ctx, spec)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePackage indicates an expected call of UpdatePackage.
+func (mr *MockStorageMockRecorder) UpdatePackage(ctx, spec interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReturnOrder", reflect.TypeOf((*MockStorage)(nil).ReturnOrder), orderId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePackage", reflect.TypeOf((*MockStorage)(nil).UpdatePackage), ctx, spec)
 }
@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"homework-1/internal/models"
+	"homework-1/internal/storage/schema"
+)
+
+var (
+	orderItemColumns = []string{
+		"item_id", "order_id", "sku", "name",
+		"price_minor", "currency", "status"}
+	orderItemTable = "order_items"
+)
+
+// addItems Сохраняет товары нового заказа и возвращает их с назначенными идентификаторами.
+// Должен вызываться внутри транзакции приема заказа.
+func (s *PostgresDB) addItems(ctx context.Context, orderId models.ID, items []models.OrderItem) ([]models.OrderItem, error) {
+	queryEngine := s.tr.GetQueryEngine(ctx)
+
+	added := make([]models.OrderItem, 0, len(items))
+	for _, item := range items {
+		item.OrderID = orderId
+		record := schema.TransformOrderItem(item)
+
+		sql, args, errSql := sq.
+			Insert(orderItemTable).
+			Columns(orderItemColumns[1:]...).
+			Values(record.OrderID, record.SKU, record.Name,
+				record.PriceMinor, record.Currency, record.Status).
+			Suffix("RETURNING item_id").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if errSql != nil {
+			return nil, fmt.Errorf("storage.addItems error: %w", errSql)
+		}
+
+		if errScan := queryEngine.QueryRow(ctx, sql, args...).Scan(&item.ID); errScan != nil {
+			return nil, fmt.Errorf("storage.addItems error: %w", errScan)
+		}
+		added = append(added, item)
+	}
+
+	return added, nil
+}
+
+// loadItems Заполняет товары заказов одним запросом. Заказы без товаров остаются без изменений.
+func (s *PostgresDB) loadItems(ctx context.Context, orders []models.Order) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	index := make(map[models.ID]int, len(orders))
+	ids := make([]models.ID, 0, len(orders))
+	for i, order := range orders {
+		index[order.OrderID] = i
+		ids = append(ids, order.OrderID)
+	}
+
+	sql, args, errSql := sq.
+		Select(orderItemColumns...).
+		From(orderItemTable).
+		Where(sq.Eq{"order_id": ids}).
+		OrderBy("order_id", "item_id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return fmt.Errorf("storage.loadItems error: %w", errSql)
+	}
+
+	rows, errQuery := s.tr.GetQueryEngine(ctx).Query(ctx, sql, args...)
+	if errQuery != nil {
+		return fmt.Errorf("storage.loadItems error: %w", errQuery)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record schema.OrderItemRecord
+		if errScan := rows.Scan(&record.ItemID, &record.OrderID, &record.SKU, &record.Name,
+			&record.PriceMinor, &record.Currency, &record.Status); errScan != nil {
+			return fmt.Errorf("storage.loadItems error: %w", errScan)
+		}
+		item := record.ToDomain()
+		i := index[item.OrderID]
+		orders[i].Items = append(orders[i].Items, item)
+	}
+
+	return rows.Err()
+}
+
+// updateItems Сохраняет состояния товаров заказа. Остальные поля товара после приема не меняются.
+func (s *PostgresDB) updateItems(ctx context.Context, items []models.OrderItem) error {
+	queryEngine := s.tr.GetQueryEngine(ctx)
+
+	for _, item := range items {
+		sql, args, errSql := sq.
+			Update(orderItemTable).
+			Set("status", string(item.Status)).
+			Where(sq.Eq{"item_id": item.ID, "order_id": item.OrderID}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if errSql != nil {
+			return fmt.Errorf("storage.updateItems error: %w", errSql)
+		}
+
+		if _, errExec := queryEngine.Exec(ctx, sql, args...); errExec != nil {
+			return fmt.Errorf("storage.updateItems error: %w", errExec)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/opentracing/opentracing-go"
+	"homework-1/internal/models"
+	"homework-1/internal/storage/schema"
+	"log"
+	"time"
+)
+
+var (
+	outboxColumns = []string{
+		"event_type", "order_id",
+		"payload", "created_at"}
+	outboxTable = "outbox"
+)
+
+// PublishEvents Блокирует пачку неопубликованных событий outbox и по очереди передает их в publish.
+// Успешно отправленные события помечаются опубликованными в той же транзакции. При первой ошибке отправки
+// обработка пачки прекращается, а оставшиеся события будут отправлены при следующем вызове.
+// Событие, которое не удалось прочитать или которое publish отклонил с ErrUndeliverable, повтор не исправит:
+// оно откладывается с причиной (failed_at, failure), а пачка обрабатывается дальше.
+// Блокировка FOR UPDATE SKIP LOCKED позволяет запускать несколько экземпляров ретранслятора одновременно.
+// Ретранслятор публикует события всех пунктов, пункт заказа передается в самом событии.
+func (s *PostgresDB) PublishEvents(ctx context.Context, limit int, publish func(event models.OrderEvent) error) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.PublishEvents")
+	defer span.Finish()
+
+	var (
+		published  int
+		errPublish error
+	)
+
+	f := func(ctxTX context.Context) error {
+		queryEngine := s.tr.GetQueryEngine(ctxTX)
+		published, errPublish = 0, nil
+
+		sql, args, errSql := sq.
+			Select("id", "payload").
+			From(outboxTable).
+			Where(sq.Eq{"published_at": nil, "failed_at": nil}).
+			OrderBy("id").
+			Limit(uint64(limit)).
+			Suffix("FOR UPDATE SKIP LOCKED").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if errSql != nil {
+			return fmt.Errorf("storage.PublishEvents error: %w", errSql)
+		}
+
+		rows, errQuery := queryEngine.Query(ctxTX, sql, args...)
+		if errQuery != nil {
+			return fmt.Errorf("storage.PublishEvents error: %w", errQuery)
+		}
+
+		var events []models.OrderEvent
+		failed := make(map[int64]error)
+		for rows.Next() {
+			var outboxRecord schema.OutboxRecord
+			if errScan := rows.Scan(&outboxRecord.ID, &outboxRecord.Payload); errScan != nil {
+				rows.Close()
+				return fmt.Errorf("storage.PublishEvents error: %w", errScan)
+			}
+
+			event, errDomain := outboxRecord.ToDomain()
+			if errDomain != nil {
+				failed[outboxRecord.ID] = errDomain
+				continue
+			}
+			events = append(events, event)
+		}
+		rows.Close()
+		if errRows := rows.Err(); errRows != nil {
+			return fmt.Errorf("storage.PublishEvents error: %w", errRows)
+		}
+
+		var ids []int64
+		for _, event := range events {
+			errEvent := publish(event)
+			if errors.Is(errEvent, ErrUndeliverable) {
+				failed[event.ID] = errEvent
+				continue
+			}
+			if errEvent != nil {
+				errPublish = errEvent
+				break
+			}
+			ids = append(ids, event.ID)
+		}
+
+		if errFail := s.failEvents(ctxTX, failed); errFail != nil {
+			return errFail
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		sql, args, errSql = sq.
+			Update(outboxTable).
+			Set("published_at", time.Now()).
+			Set("payload", sq.Expr("payload - 'pickupCode' - 'PickupCode'")).
+			Where(sq.Eq{"id": ids}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if errSql != nil {
+			return fmt.Errorf("storage.PublishEvents error: %w", errSql)
+		}
+
+		if _, errExec := queryEngine.Exec(ctxTX, sql, args...); errExec != nil {
+			return fmt.Errorf("storage.PublishEvents error: %w", errExec)
+		}
+		published = len(ids)
+
+		return nil
+	}
+
+	if err := s.tr.RunRepeatableRead(ctx, f); err != nil {
+		return 0, fmt.Errorf("storage.PublishEvents error: %w", err)
+	}
+
+	if errPublish != nil {
+		return published, fmt.Errorf("storage.PublishEvents error: %w", errors.Join(ErrPublish, errPublish))
+	}
+
+	return published, nil
+}
+
+// failEvents Откладывает события, которые нельзя отправить, чтобы они не останавливали outbox.
+func (s *PostgresDB) failEvents(ctx context.Context, failed map[int64]error) error {
+	now := time.Now()
+	for eventId, errEvent := range failed {
+		log.Printf("storage.PublishEvents: outbox event %d is set aside: %s\n", eventId, errEvent)
+
+		sql, args, errSql := sq.
+			Update(outboxTable).
+			Set("failed_at", now).
+			Set("failure", errEvent.Error()).
+			Where(sq.Eq{"id": eventId}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if errSql != nil {
+			return fmt.Errorf("storage.failEvents error: %w", errSql)
+		}
+
+		if _, errExec := s.tr.GetQueryEngine(ctx).Exec(ctx, sql, args...); errExec != nil {
+			return fmt.Errorf("storage.failEvents error: %w", errExec)
+		}
+	}
+
+	return nil
+}
+
+// addEvent Записывает переход статуса из события в историю заказа, а само событие - в outbox.
+// Должен вызываться внутри транзакции, изменяющей заказ.
+func (s *PostgresDB) addEvent(ctx context.Context, event models.OrderEvent) error {
+	if errHistory := s.addStatusChange(ctx, event.Change); errHistory != nil {
+		return errHistory
+	}
+
+	queryEngine := s.tr.GetQueryEngine(ctx)
+
+	outboxRecord, errTransform := schema.TransformEvent(event)
+	if errTransform != nil {
+		return fmt.Errorf("storage.addEvent error: %w", errTransform)
+	}
+
+	sql, args, errSql := sq.
+		Insert(outboxTable).
+		Columns(outboxColumns...).
+		Values(outboxRecord.EventType, outboxRecord.OrderID,
+			outboxRecord.Payload, outboxRecord.CreatedAt).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return fmt.Errorf("storage.addEvent error: %w", errSql)
+	}
+
+	if _, errExec := queryEngine.Exec(ctx, sql, args...); errExec != nil {
+		return fmt.Errorf("storage.addEvent error: %w", errExec)
+	}
+
+	return nil
+}
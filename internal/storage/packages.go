@@ -0,0 +1,234 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/opentracing/opentracing-go"
+	"homework-1/internal/models"
+	"homework-1/internal/storage/schema"
+	"time"
+)
+
+var (
+	ErrPackageNotFound = errors.New("package type not found")
+	ErrPackageExists   = errors.New("package type already exists")
+)
+
+var (
+	packageColumns = []string{
+		"package_type", "kind", "price_minor", "currency",
+		"min_weight", "max_weight",
+		"length_cm", "width_cm", "height_cm",
+		"allow_wrap", "retired_at", "created_at", "updated_at"}
+	packageTable = "packages"
+)
+
+// AddPackage Регистрирует новый тип упаковки. Повторная регистрация того же имени возвращает ErrPackageExists,
+// в том числе если тип уже выведен из оборота.
+func (s *PostgresDB) AddPackage(ctx context.Context, spec models.PackageSpec) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.AddPackage")
+	defer span.Finish()
+
+	sql, args, errSql := insertPackage(spec).PlaceholderFormat(sq.Dollar).ToSql()
+	if errSql != nil {
+		return fmt.Errorf("storage.AddPackage error: %w", errSql)
+	}
+
+	if _, errExec := s.tr.GetQueryEngine(ctx).Exec(ctx, sql, args...); errExec != nil {
+		var errPg *pgconn.PgError
+		if errors.As(errExec, &errPg) && errPg.Code == uniqueViolationCode {
+			return fmt.Errorf("storage.AddPackage error: %w", ErrPackageExists)
+		}
+		return fmt.Errorf("storage.AddPackage error: %w", errExec)
+	}
+
+	return nil
+}
+
+// SeedPackages Добавляет в каталог типы из конфигурации, которых в нем еще нет. Уже зарегистрированные типы
+// не перезаписываются, чтобы перезапуск сервера не откатывал изменения, сделанные через UpdatePackage.
+func (s *PostgresDB) SeedPackages(ctx context.Context, specs []models.PackageSpec) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.SeedPackages")
+	defer span.Finish()
+
+	var added int
+
+	f := func(ctxTX context.Context) error {
+		queryEngine := s.tr.GetQueryEngine(ctxTX)
+		added = 0
+
+		for _, spec := range specs {
+			sql, args, errSql := insertPackage(spec).
+				Suffix("ON CONFLICT (package_type) DO NOTHING").
+				PlaceholderFormat(sq.Dollar).
+				ToSql()
+			if errSql != nil {
+				return fmt.Errorf("storage.SeedPackages error: %w", errSql)
+			}
+
+			tag, errExec := queryEngine.Exec(ctxTX, sql, args...)
+			if errExec != nil {
+				return fmt.Errorf("storage.SeedPackages error: %w", errExec)
+			}
+			added += int(tag.RowsAffected())
+		}
+
+		return nil
+	}
+
+	if err := s.tr.RunRepeatableRead(ctx, f); err != nil {
+		return 0, fmt.Errorf("storage.SeedPackages error: %w", err)
+	}
+
+	return added, nil
+}
+
+// UpdatePackage Заменяет цену, ограничения и размеры типа упаковки. Стоимость упаковки уже принятых заказов
+// не меняется: она сохранена в самом заказе.
+func (s *PostgresDB) UpdatePackage(ctx context.Context, spec models.PackageSpec) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.UpdatePackage")
+	defer span.Finish()
+
+	record := schema.TransformPackage(spec)
+
+	sql, args, errSql := sq.
+		Update(packageTable).
+		Set("kind", record.Kind).
+		Set("price_minor", record.PriceMinor).
+		Set("currency", record.Currency).
+		Set("min_weight", record.MinWeight).
+		Set("max_weight", record.MaxWeight).
+		Set("length_cm", record.LengthCm).
+		Set("width_cm", record.WidthCm).
+		Set("height_cm", record.HeightCm).
+		Set("allow_wrap", record.AllowWrap).
+		Set("updated_at", record.UpdatedAt).
+		Where(sq.Eq{"package_type": record.PackageType}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return fmt.Errorf("storage.UpdatePackage error: %w", errSql)
+	}
+
+	tag, errExec := s.tr.GetQueryEngine(ctx).Exec(ctx, sql, args...)
+	if errExec != nil {
+		return fmt.Errorf("storage.UpdatePackage error: %w", errExec)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("storage.UpdatePackage error: %w", ErrPackageNotFound)
+	}
+
+	return nil
+}
+
+// RetirePackage Выводит тип упаковки из оборота. Повторный вызов не меняет время вывода.
+func (s *PostgresDB) RetirePackage(ctx context.Context, pack models.PackageType, retiredAt time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.RetirePackage")
+	defer span.Finish()
+
+	sql, args, errSql := sq.
+		Update(packageTable).
+		Set("retired_at", sq.Expr("CASE WHEN retired_at = '0001-01-01' THEN ? ELSE retired_at END", retiredAt)).
+		Set("updated_at", retiredAt).
+		Where(sq.Eq{"package_type": pack}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return fmt.Errorf("storage.RetirePackage error: %w", errSql)
+	}
+
+	tag, errExec := s.tr.GetQueryEngine(ctx).Exec(ctx, sql, args...)
+	if errExec != nil {
+		return fmt.Errorf("storage.RetirePackage error: %w", errExec)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("storage.RetirePackage error: %w", ErrPackageNotFound)
+	}
+
+	return nil
+}
+
+func (s *PostgresDB) GetPackage(ctx context.Context, pack models.PackageType) (models.PackageSpec, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetPackage")
+	defer span.Finish()
+
+	sql, args, errSql := sq.
+		Select(packageColumns...).
+		From(packageTable).
+		Where(sq.Eq{"package_type": pack}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return models.PackageSpec{}, fmt.Errorf("storage.GetPackage error: %w", errSql)
+	}
+
+	var record schema.PackageRecord
+	if errScan := scanPackage(s.tr.GetQueryEngine(ctx).QueryRow(ctx, sql, args...), &record); errScan != nil {
+		if errors.Is(errScan, pgx.ErrNoRows) {
+			return models.PackageSpec{}, fmt.Errorf("storage.GetPackage error: %w", ErrPackageNotFound)
+		}
+		return models.PackageSpec{}, fmt.Errorf("storage.GetPackage error: %w", errScan)
+	}
+
+	return record.ToDomain(), nil
+}
+
+// GetPackages Возвращает каталог упаковок по имени. Выведенные из оборота типы попадают в список, только если includeRetired.
+func (s *PostgresDB) GetPackages(ctx context.Context, includeRetired bool) ([]models.PackageSpec, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetPackages")
+	defer span.Finish()
+
+	query := sq.
+		Select(packageColumns...).
+		From(packageTable).
+		OrderBy("package_type")
+	if !includeRetired {
+		query = query.Where("retired_at = '0001-01-01'")
+	}
+
+	sql, args, errSql := query.PlaceholderFormat(sq.Dollar).ToSql()
+	if errSql != nil {
+		return nil, fmt.Errorf("storage.GetPackages error: %w", errSql)
+	}
+
+	rows, errQuery := s.tr.GetQueryEngine(ctx).Query(ctx, sql, args...)
+	if errQuery != nil {
+		return nil, fmt.Errorf("storage.GetPackages error: %w", errQuery)
+	}
+	defer rows.Close()
+
+	var specs []models.PackageSpec
+	for rows.Next() {
+		var record schema.PackageRecord
+		if errScan := scanPackage(rows, &record); errScan != nil {
+			return nil, fmt.Errorf("storage.GetPackages error: %w", errScan)
+		}
+		specs = append(specs, record.ToDomain())
+	}
+
+	return specs, nil
+}
+
+// scanPackage Читает строку, выбранную по packageColumns.
+func scanPackage(row pgx.Row, record *schema.PackageRecord) error {
+	return row.Scan(&record.PackageType, &record.Kind, &record.PriceMinor, &record.Currency,
+		&record.MinWeight, &record.MaxWeight,
+		&record.LengthCm, &record.WidthCm, &record.HeightCm,
+		&record.AllowWrap, &record.RetiredAt, &record.CreatedAt, &record.UpdatedAt)
+}
+
+func insertPackage(spec models.PackageSpec) sq.InsertBuilder {
+	record := schema.TransformPackage(spec)
+
+	return sq.
+		Insert(packageTable).
+		Columns(packageColumns...).
+		Values(record.PackageType, record.Kind, record.PriceMinor, record.Currency,
+			record.MinWeight, record.MaxWeight,
+			record.LengthCm, record.WidthCm, record.HeightCm,
+			record.AllowWrap, record.RetiredAt, record.CreatedAt, record.UpdatedAt)
+}
@@ -0,0 +1,246 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"homework-1/internal/models"
+	"homework-1/internal/storage/schema"
+	"strings"
+	"time"
+)
+
+var (
+	ErrPickupCodeNotFound = errors.New("customer has no active pickup code")
+	ErrPickupCodeUsed     = errors.New("pickup code has already been used")
+)
+
+var (
+	pickupCodeColumns = []string{
+		"customer_id", "code_hash", "salt",
+		"failed_attempts", "locked_until", "created_at"}
+	pickupCodeTable = "pickup_codes"
+)
+
+// waitingStatuses Статусы заказов, которые клиент еще может забрать по коду. Заказ на примерке
+// может вернуться на полку, поэтому код действует, пока примерка не завершится.
+var waitingStatuses = []string{string(models.StatusAccepted), string(models.StatusReadyForPickup), string(models.StatusTryingOn)}
+
+// GetPickupCode Действующий код выдачи клиента в пункте запроса. В каждом пункте у клиента свой код.
+func (s *PostgresDB) GetPickupCode(ctx context.Context, customerId models.ID) (models.PickupCode, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetPickupCode")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return models.PickupCode{}, fmt.Errorf("storage.GetPickupCode error: %w", errScope)
+	}
+
+	sql, args, errSql := sq.
+		Select(pickupCodeColumns...).
+		From(pickupCodeTable).
+		Where(sq.Eq{"point_id": point, "customer_id": customerId}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return models.PickupCode{}, fmt.Errorf("storage.GetPickupCode error: %w", errSql)
+	}
+
+	var record schema.PickupCodeRecord
+	if errScan := scanPickupCode(s.tr.GetQueryEngine(ctx).QueryRow(ctx, sql, args...), &record); errScan != nil {
+		if errors.Is(errScan, pgx.ErrNoRows) {
+			return models.PickupCode{}, fmt.Errorf("storage.GetPickupCode error: %w", ErrPickupCodeNotFound)
+		}
+		return models.PickupCode{}, fmt.Errorf("storage.GetPickupCode error: %w", errScan)
+	}
+
+	return record.ToDomain(), nil
+}
+
+// RecordPickupFailure Засчитывает неверный код. Счетчик увеличивается в одном запросе, поэтому параллельные попытки
+// не теряются. На maxAttempts-й ошибке выдача блокируется до lockedUntil, а счетчик начинается заново.
+func (s *PostgresDB) RecordPickupFailure(ctx context.Context, customerId models.ID, maxAttempts int, lockedUntil time.Time) (models.PickupCode, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.RecordPickupFailure")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return models.PickupCode{}, fmt.Errorf("storage.RecordPickupFailure error: %w", errScope)
+	}
+
+	sql, args, errSql := sq.
+		Update(pickupCodeTable).
+		Set("failed_attempts", sq.Expr("CASE WHEN failed_attempts + 1 >= ? THEN 0 ELSE failed_attempts + 1 END", maxAttempts)).
+		Set("locked_until", sq.Expr("CASE WHEN failed_attempts + 1 >= ? THEN ? ELSE locked_until END", maxAttempts, lockedUntil)).
+		Where(sq.Eq{"point_id": point, "customer_id": customerId}).
+		Suffix("RETURNING " + strings.Join(pickupCodeColumns, ", ")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return models.PickupCode{}, fmt.Errorf("storage.RecordPickupFailure error: %w", errSql)
+	}
+
+	var record schema.PickupCodeRecord
+	if errScan := scanPickupCode(s.tr.GetQueryEngine(ctx).QueryRow(ctx, sql, args...), &record); errScan != nil {
+		if errors.Is(errScan, pgx.ErrNoRows) {
+			return models.PickupCode{}, fmt.Errorf("storage.RecordPickupFailure error: %w", ErrPickupCodeNotFound)
+		}
+		return models.PickupCode{}, fmt.Errorf("storage.RecordPickupFailure error: %w", errScan)
+	}
+
+	return record.ToDomain(), nil
+}
+
+// HandOverOrders Выдает клиенту заказы по коду used так же, как ChangeStatuses, и в той же транзакции гасит код:
+// код одноразовый и удаляется, только если это все еще код used. Если код уже погасила параллельная выдача,
+// возвращается ErrPickupCodeUsed и заказы не выдаются. Если у клиента остались заказы, которые ждут выдачи,
+// сохраняется следующий код next, иначе PickupCode в событиях очищается: уведомлять клиента не о чем.
+func (s *PostgresDB) HandOverOrders(ctx context.Context, orderIds []models.ID, used models.PickupCode, next models.PickupCode, change func(orders []models.Order) ([]models.OrderEvent, error)) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.HandOverOrders")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return fmt.Errorf("storage.HandOverOrders error: %w", errScope)
+	}
+
+	f := func(ctxTX context.Context) error {
+		events, errApply := s.applyStatuses(ctxTX, point, orderIds, change)
+		if errApply != nil {
+			return errApply
+		}
+
+		issued, errCode := s.rotatePickupCode(ctxTX, point, used, next)
+		if errCode != nil {
+			return errCode
+		}
+		if !issued {
+			for i := range events {
+				events[i].PickupCode = ""
+			}
+		}
+
+		return s.addEvents(ctxTX, events)
+	}
+
+	if err := s.tr.RunRepeatableRead(ctx, f); err != nil {
+		return fmt.Errorf("storage.HandOverOrders error: %w", err)
+	}
+
+	return nil
+}
+
+// rotatePickupCode Гасит код used и, если клиенту еще есть что забирать, сохраняет вместо него next.
+// Должен вызываться внутри транзакции выдачи после изменения статусов заказов.
+func (s *PostgresDB) rotatePickupCode(ctx context.Context, point models.PointID, used models.PickupCode, next models.PickupCode) (bool, error) {
+	queryEngine := s.tr.GetQueryEngine(ctx)
+
+	sql, args, errSql := sq.
+		Delete(pickupCodeTable).
+		Where(sq.Eq{"point_id": point, "customer_id": used.CustomerID, "code_hash": used.Hash}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return false, fmt.Errorf("storage.rotatePickupCode error: %w", errSql)
+	}
+
+	tag, errExec := queryEngine.Exec(ctx, sql, args...)
+	if errExec != nil {
+		return false, fmt.Errorf("storage.rotatePickupCode error: %w", errExec)
+	}
+	if tag.RowsAffected() == 0 {
+		return false, fmt.Errorf("storage.rotatePickupCode error: %w", ErrPickupCodeUsed)
+	}
+
+	sql, args, errSql = sq.
+		Select("1").
+		From(orderTable).
+		Where(sq.Eq{"point_id": point, "customer_id": used.CustomerID, "status": waitingStatuses}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return false, fmt.Errorf("storage.rotatePickupCode error: %w", errSql)
+	}
+
+	var waiting bool
+	if errScan := queryEngine.QueryRow(ctx, sql, args...).Scan(&waiting); errScan != nil {
+		return false, fmt.Errorf("storage.rotatePickupCode error: %w", errScan)
+	}
+	if !waiting || len(next.Hash) == 0 {
+		return false, nil
+	}
+
+	return s.issuePickupCode(ctx, next)
+}
+
+// releasePickupCodes Удаляет коды клиентов customerIds, которым больше нечего забирать в пункте point.
+// Вызывается в транзакции, которая выводит заказы из ожидания выдачи, поэтому код не переживает последний заказ.
+func (s *PostgresDB) releasePickupCodes(ctx context.Context, point models.PointID, customerIds []models.ID) error {
+	if len(customerIds) == 0 {
+		return nil
+	}
+
+	waiting := sq.
+		Select("1").
+		From(orderTable + " o").
+		Where("o.point_id = " + pickupCodeTable + ".point_id AND o.customer_id = " + pickupCodeTable + ".customer_id").
+		Where(sq.Eq{"o.status": waitingStatuses})
+
+	sql, args, errSql := sq.
+		Delete(pickupCodeTable).
+		Where(sq.Eq{"point_id": point, "customer_id": customerIds}).
+		Where(sq.Expr("NOT EXISTS (?)", waiting)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return fmt.Errorf("storage.releasePickupCodes error: %w", errSql)
+	}
+
+	if _, errExec := s.tr.GetQueryEngine(ctx).Exec(ctx, sql, args...); errExec != nil {
+		return fmt.Errorf("storage.releasePickupCodes error: %w", errExec)
+	}
+
+	return nil
+}
+
+// issuePickupCode Сохраняет новый код, если у клиента еще нет действующего, и сообщает, сохранен ли он.
+// Должен вызываться внутри транзакции приема или выдачи заказов.
+func (s *PostgresDB) issuePickupCode(ctx context.Context, code models.PickupCode) (bool, error) {
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return false, fmt.Errorf("storage.issuePickupCode error: %w", errScope)
+	}
+
+	record := schema.TransformPickupCode(code)
+
+	sql, args, errSql := sq.
+		Insert(pickupCodeTable).
+		Columns(pickupCodeColumns...).
+		Columns("point_id").
+		Values(record.CustomerID, record.CodeHash, record.Salt,
+			record.FailedAttempts, record.LockedUntil, record.CreatedAt, string(point)).
+		Suffix("ON CONFLICT (point_id, customer_id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return false, fmt.Errorf("storage.issuePickupCode error: %w", errSql)
+	}
+
+	tag, errExec := s.tr.GetQueryEngine(ctx).Exec(ctx, sql, args...)
+	if errExec != nil {
+		return false, fmt.Errorf("storage.issuePickupCode error: %w", errExec)
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// scanPickupCode Читает строку, выбранную по pickupCodeColumns.
+func scanPickupCode(row pgx.Row, record *schema.PickupCodeRecord) error {
+	return row.Scan(&record.CustomerID, &record.CodeHash, &record.Salt,
+		&record.FailedAttempts, &record.LockedUntil, &record.CreatedAt)
+}
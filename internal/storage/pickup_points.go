@@ -0,0 +1,191 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"homework-1/internal/models"
+	"homework-1/internal/storage/schema"
+)
+
+var (
+	ErrNoPoint             = errors.New("pickup point is not set for the request")
+	ErrPickupPointNotFound = errors.New("pickup point not found")
+	ErrOperatorNotAssigned = errors.New("operator is not assigned to any pickup point")
+)
+
+var (
+	pickupPointColumns       = []string{"p.point_id", "p.name", "p.time_zone", "p.closing_time"}
+	pickupPointTable         = "pickup_points"
+	pickupPointOperatorTable = "pickup_point_operators"
+)
+
+// scope Пункт выдачи, в границах которого выполняется запрос. Заказы, возвраты, история и коды выдачи
+// читаются и меняются только в этих границах, поэтому запрос без пункта не выполняется.
+func scope(ctx context.Context) (models.PointID, error) {
+	point := models.PointFromContext(ctx)
+	if point == "" {
+		return "", ErrNoPoint
+	}
+	return point, nil
+}
+
+// GetPickupPoint Пункт выдачи вместе с его сотрудниками, без схемы ячеек.
+func (s *PostgresDB) GetPickupPoint(ctx context.Context, pointId models.PointID) (models.PickupPoint, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetPickupPoint")
+	defer span.Finish()
+
+	points, err := s.selectPickupPoints(ctx, sq.Eq{"p.point_id": string(pointId)})
+	if err != nil {
+		return models.PickupPoint{}, fmt.Errorf("storage.GetPickupPoint error: %w", err)
+	}
+	if len(points) == 0 {
+		return models.PickupPoint{}, fmt.Errorf("storage.GetPickupPoint error: %w", ErrPickupPointNotFound)
+	}
+
+	return points[0], nil
+}
+
+// GetPickupPoints Все пункты выдачи в порядке идентификаторов. Нужны фоновым задачам, которые обходят пункты по очереди.
+func (s *PostgresDB) GetPickupPoints(ctx context.Context) ([]models.PickupPoint, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetPickupPoints")
+	defer span.Finish()
+
+	points, err := s.selectPickupPoints(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("storage.GetPickupPoints error: %w", err)
+	}
+
+	return points, nil
+}
+
+// GetOperatorPoint Пункт, в котором работает сотрудник operator.
+func (s *PostgresDB) GetOperatorPoint(ctx context.Context, operator models.Operator) (models.PointID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetOperatorPoint")
+	defer span.Finish()
+
+	sql, args, errSql := sq.
+		Select("point_id").
+		From(pickupPointOperatorTable).
+		Where(sq.Eq{"operator": string(operator)}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return "", fmt.Errorf("storage.GetOperatorPoint error: %w", errSql)
+	}
+
+	var point string
+	if errScan := s.tr.GetQueryEngine(ctx).QueryRow(ctx, sql, args...).Scan(&point); errScan != nil {
+		if errors.Is(errScan, pgx.ErrNoRows) {
+			return "", fmt.Errorf("storage.GetOperatorPoint error: %w", ErrOperatorNotAssigned)
+		}
+		return "", fmt.Errorf("storage.GetOperatorPoint error: %w", errScan)
+	}
+
+	return models.PointID(point), nil
+}
+
+// SeedPickupPoints Добавляет пункты из конфигурации, которых еще нет, и возвращает число добавленных.
+// Настройки существующих пунктов не перезаписываются, а сотрудники закрепляются за пунктом из конфигурации,
+// даже если раньше работали в другом: так перевод сотрудника вступает в силу после перезапуска.
+// Схема ячеек пункта дополняется и обновляется по конфигурации.
+func (s *PostgresDB) SeedPickupPoints(ctx context.Context, points []models.PickupPoint) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.SeedPickupPoints")
+	defer span.Finish()
+
+	var added int
+
+	f := func(ctxTX context.Context) error {
+		queryEngine := s.tr.GetQueryEngine(ctxTX)
+		added = 0
+
+		for _, point := range points {
+			record := schema.TransformPickupPoint(point)
+
+			sql, args, errSql := sq.
+				Insert(pickupPointTable).
+				Columns("point_id", "name", "time_zone", "closing_time").
+				Values(record.PointID, record.Name, record.TimeZone, record.ClosingTime).
+				Suffix("ON CONFLICT (point_id) DO NOTHING").
+				PlaceholderFormat(sq.Dollar).
+				ToSql()
+			if errSql != nil {
+				return fmt.Errorf("storage.SeedPickupPoints error: %w", errSql)
+			}
+
+			tag, errExec := queryEngine.Exec(ctxTX, sql, args...)
+			if errExec != nil {
+				return fmt.Errorf("storage.SeedPickupPoints error: %w", errExec)
+			}
+			added += int(tag.RowsAffected())
+
+			if errCells := s.seedCells(ctxTX, point.ID, point.Cells); errCells != nil {
+				return errCells
+			}
+
+			for _, operator := range point.Operators {
+				sql, args, errSql = sq.
+					Insert(pickupPointOperatorTable).
+					Columns("operator", "point_id").
+					Values(string(operator), record.PointID).
+					Suffix("ON CONFLICT (operator) DO UPDATE SET point_id = EXCLUDED.point_id").
+					PlaceholderFormat(sq.Dollar).
+					ToSql()
+				if errSql != nil {
+					return fmt.Errorf("storage.SeedPickupPoints error: %w", errSql)
+				}
+
+				if _, errExec = queryEngine.Exec(ctxTX, sql, args...); errExec != nil {
+					return fmt.Errorf("storage.SeedPickupPoints error: %w", errExec)
+				}
+			}
+		}
+
+		return nil
+	}
+
+	if err := s.tr.RunRepeatableRead(ctx, f); err != nil {
+		return 0, fmt.Errorf("storage.SeedPickupPoints error: %w", err)
+	}
+
+	return added, nil
+}
+
+// selectPickupPoints Пункты по фильтру where вместе с их сотрудниками, nil выбирает все пункты.
+func (s *PostgresDB) selectPickupPoints(ctx context.Context, where sq.Sqlizer) ([]models.PickupPoint, error) {
+	builder := sq.
+		Select(pickupPointColumns...).
+		Column("COALESCE(ARRAY_AGG(o.operator ORDER BY o.operator) FILTER (WHERE o.operator IS NOT NULL), '{}')").
+		From(pickupPointTable + " p").
+		LeftJoin(pickupPointOperatorTable + " o ON o.point_id = p.point_id").
+		GroupBy(pickupPointColumns...).
+		OrderBy("p.point_id")
+	if where != nil {
+		builder = builder.Where(where)
+	}
+
+	sql, args, errSql := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if errSql != nil {
+		return nil, errSql
+	}
+
+	rows, errQuery := s.tr.GetQueryEngine(ctx).Query(ctx, sql, args...)
+	if errQuery != nil {
+		return nil, errQuery
+	}
+	defer rows.Close()
+
+	var points []models.PickupPoint
+	for rows.Next() {
+		var record schema.PickupPointRecord
+		if errScan := rows.Scan(&record.PointID, &record.Name, &record.TimeZone, &record.ClosingTime, &record.Operators); errScan != nil {
+			return nil, errScan
+		}
+		points = append(points, record.ToDomain())
+	}
+
+	return points, rows.Err()
+}
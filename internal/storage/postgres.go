@@ -6,25 +6,49 @@ import (
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/opentracing/opentracing-go"
 	"homework-1/internal/models"
 	"homework-1/internal/storage/schema"
 	"homework-1/internal/storage/transactor"
+	"strings"
 	"time"
 )
 
 var (
 	ErrOrderNotFound = errors.New("order not found")
 	ErrOrderExists   = errors.New("order already exists")
+	ErrPublish       = errors.New("failed to publish outbox event")
+	// ErrUndeliverable Событие не может быть отправлено ни при каком повторе. publish в PublishEvents
+	// оборачивает им такие ошибки, и событие откладывается вместо того, чтобы останавливать outbox.
+	ErrUndeliverable = errors.New("outbox event can not be delivered")
+	ErrUnknownSort   = errors.New("unknown orders sort")
+	// ErrConflict Транзакция не прошла из-за конкурирующей транзакции даже после повторов, запрос можно повторить.
+	ErrConflict = transactor.ErrConflict
 )
 
 var (
 	orderColumns = []string{
-		"order_id", "customer_id",
+		"order_id", "external_source", "external_number", "customer_id",
 		"expiration_time", "received_time",
-		"received_by_customer", "refunded",
-		"package", "weight", "cost", "package_cost"}
+		"received_by_customer", "refunded", "refunded_time", "status",
+		"package", "weight", "cost_minor", "package_cost_minor", "currency", "try_on_until", "point_id", "cell"}
 	orderTable = "orders"
+
+	// orderSortColumns Сортировка задается только из этого списка, имя колонки не приходит от клиента.
+	orderSortColumns = map[models.OrderSort]string{
+		models.SortByExpiration: "expiration_time",
+		models.SortByReceived:   "received_time",
+	}
+
+	// uniqueViolationCode Код ошибки PostgreSQL при нарушении уникального индекса.
+	uniqueViolationCode = "23505"
+
+	statusHistoryColumns = []string{
+		"order_id", "status_from", "status_to",
+		"reason", "operator", "changed_at"}
+	statusHistoryTable = "order_status_history"
 )
 
 type PostgresDB struct {
@@ -32,8 +56,8 @@ type PostgresDB struct {
 	tr *transactor.Transactor
 }
 
-func NewStorage(connUrl string) (*PostgresDB, error) {
-	db, err := pgxpool.New(context.Background(), connUrl)
+func NewStorage(ctx context.Context, connUrl string) (*PostgresDB, error) {
+	db, err := pgxpool.New(ctx, connUrl)
 	if err != nil {
 		return nil, fmt.Errorf("storage.NewStorage error: %w", err)
 
@@ -45,45 +69,144 @@ func NewStorage(connUrl string) (*PostgresDB, error) {
 	}, nil
 }
 
-func (s *PostgresDB) AddOrder(order models.Order) error {
-	ordRecord := schema.Transform(order)
+// AddOrder Сохраняет заказ с товарами, первую запись в истории его статусов и событие в outbox в одной транзакции.
+// Идентификаторы заказа и товаров назначает база, они проставляются в событие перед записью.
+// Повторный прием заказа с той же парой (источник, внешний номер) в тот же пункт возвращает ErrOrderExists.
+// Новый код выдачи code сохраняется, только если у клиента нет действующего: тогда заказ войдет в уже объявленную
+// клиенту поставку, а код в открытом виде из события убирается.
+// Заказ принимается в пункт запроса, пункт проставляется и в событие.
+// Если у пункта есть ячейки хранения, place выбирает ячейку для заказа по их заполненности в той же транзакции.
+func (s *PostgresDB) AddOrder(ctx context.Context, order models.Order, event models.OrderEvent, code models.PickupCode, place func(cells []models.CellLoad) (models.CellCode, error)) (models.ID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.AddOrder")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return 0, fmt.Errorf("storage.AddOrder error: %w", errScope)
+	}
+
+	order.PointID = point
+	event.Order.PointID = point
+	var orderId models.ID
+
+	f := func(ctxTX context.Context) error {
+		queryEngine := s.tr.GetQueryEngine(ctxTX)
+		// Транзакция может повториться, поэтому каждая попытка начинается с исходного события.
+		event := event
+
+		cell, errPlace := s.placeOrder(ctxTX, point, order.CustomerID, place)
+		if errPlace != nil {
+			return errPlace
+		}
+		order.Cell = cell
+		event.Order.Cell = cell
+		ordRecord := schema.Transform(order)
+
+		sql, args, errSql := sq.
+			Insert(orderTable).
+			Columns(orderColumns[1:]...).
+			Values(ordRecord.ExternalSource, ordRecord.ExternalNumber, ordRecord.CustomerID,
+				ordRecord.ExpirationTime, ordRecord.ReceivedTime,
+				ordRecord.ReceivedByCustomer, ordRecord.Refunded, ordRecord.RefundedTime, ordRecord.Status,
+				ordRecord.Package, ordRecord.Weight, ordRecord.CostMinor, ordRecord.PackageCostMinor, ordRecord.Currency,
+				ordRecord.TryOnUntil, ordRecord.PointID, ordRecord.Cell).
+			Suffix("RETURNING order_id").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if errSql != nil {
+			return fmt.Errorf("storage.AddOrder error: %w", errSql)
+		}
+
+		if errScan := queryEngine.QueryRow(ctxTX, sql, args...).Scan(&orderId); errScan != nil {
+			var errPg *pgconn.PgError
+			if errors.As(errScan, &errPg) && errPg.Code == uniqueViolationCode {
+				return fmt.Errorf("storage.AddOrder error: %w", ErrOrderExists)
+			}
+			return fmt.Errorf("storage.AddOrder error: %w", errScan)
+		}
+
+		event.Order.OrderID = orderId
+		event.Change.OrderID = orderId
+
+		items, errItems := s.addItems(ctxTX, orderId, order.Items)
+		if errItems != nil {
+			return errItems
+		}
+		if len(items) > 0 {
+			event.Order.Items = items
+		}
+
+		if len(code.Hash) > 0 {
+			issued, errCode := s.issuePickupCode(ctxTX, code)
+			if errCode != nil {
+				return errCode
+			}
+			if !issued {
+				event.PickupCode = ""
+			}
+		}
+
+		return s.addEvent(ctxTX, event)
+	}
+
+	if err := s.tr.RunRepeatableRead(ctx, f); err != nil {
+		return 0, fmt.Errorf("storage.AddOrder error: %w", err)
+	}
+
+	return orderId, nil
+}
+
+// ResolveOrderID Находит идентификатор заказа по его внешнему номеру.
+func (s *PostgresDB) ResolveOrderID(ctx context.Context, ref models.ExternalRef) (models.ID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.ResolveOrderID")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return 0, fmt.Errorf("storage.ResolveOrderID error: %w", errScope)
+	}
 
 	sql, args, errSql := sq.
-		Insert(orderTable).
-		Columns(orderColumns...).
-		Values(ordRecord.OrderID, ordRecord.CustomerID,
-			ordRecord.ExpirationTime, ordRecord.ReceivedTime,
-			ordRecord.ReceivedByCustomer, ordRecord.Refunded,
-			ordRecord.Package, ordRecord.Weight, ordRecord.Cost, ordRecord.PackageCost).
+		Select("order_id").
+		From(orderTable).
+		Where(sq.Eq{"point_id": point, "external_source": ref.Source, "external_number": ref.Number}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if errSql != nil {
-		return fmt.Errorf("storage.AddOrder error: %w", errSql)
+		return 0, fmt.Errorf("storage.ResolveOrderID error: %w", errSql)
 	}
 
-	_, errExec := s.db.Exec(context.Background(), sql, args...)
-	if errExec != nil {
-		if errors.Is(errExec, pgx.ErrNoRows) {
-			return fmt.Errorf("storage.AddOrder error: %w", ErrOrderExists)
+	var orderId models.ID
+	if errScan := s.tr.GetQueryEngine(ctx).QueryRow(ctx, sql, args...).Scan(&orderId); errScan != nil {
+		if errors.Is(errScan, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("storage.ResolveOrderID error: %w", ErrOrderNotFound)
 		}
-		return fmt.Errorf("storage.AddOrder error: %w", errExec)
+		return 0, fmt.Errorf("storage.ResolveOrderID error: %w", errScan)
 	}
 
-	return nil
+	return orderId, nil
 }
 
-func (s *PostgresDB) GetOrder(orderId models.ID) (models.Order, error) {
+func (s *PostgresDB) GetOrder(ctx context.Context, orderId models.ID) (models.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetOrder")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return models.Order{}, fmt.Errorf("storage.GetOrder error: %w", errScope)
+	}
+
 	sql, args, errSql := sq.
 		Select(orderColumns...).
 		From(orderTable).
-		Where(sq.Eq{"order_id": orderId}).
+		Where(sq.Eq{"point_id": point, "order_id": orderId}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if errSql != nil {
 		return models.Order{}, fmt.Errorf("storage.GetOrder error: %w", errSql)
 	}
 
-	rows, errQuery := s.db.Query(context.Background(), sql, args...)
+	rows, errQuery := s.tr.GetQueryEngine(ctx).Query(ctx, sql, args...)
 	if errQuery != nil {
 		if errors.Is(errQuery, pgx.ErrNoRows) {
 			return models.Order{}, fmt.Errorf("storage.GetOrder error: %w", ErrOrderNotFound)
@@ -94,33 +217,80 @@ func (s *PostgresDB) GetOrder(orderId models.ID) (models.Order, error) {
 
 	var ordRecord schema.OrderRecord
 	for rows.Next() {
-		if errScan := rows.Scan(&ordRecord.OrderID, &ordRecord.CustomerID,
-			&ordRecord.ExpirationTime, &ordRecord.ReceivedTime,
-			&ordRecord.ReceivedByCustomer, &ordRecord.Refunded,
-			&ordRecord.Package, &ordRecord.Weight, &ordRecord.Cost, &ordRecord.PackageCost); errScan != nil {
+		if errScan := scanOrder(rows, &ordRecord); errScan != nil {
 			return models.Order{}, fmt.Errorf("storage.GetOrder error: %w", errScan)
 		}
 	}
+	rows.Close()
+
+	orders := []models.Order{ordRecord.ToDomain()}
+	if ordRecord.OrderID != 0 {
+		if errItems := s.loadItems(ctx, orders); errItems != nil {
+			return models.Order{}, fmt.Errorf("storage.GetOrder error: %w", errItems)
+		}
+	}
 
-	return ordRecord.ToDomain(), nil
+	return orders[0], nil
 }
 
-func (s *PostgresDB) GetCustomersOrders(customerId models.ID) ([]models.Order, error) {
-	sql, args, errSql := sq.
+// GetCustomersOrders Фильтры, сортировка и постраничная выборка выполняются в запросе. Страница продолжается
+// по ключу (поле сортировки, order_id) после query.After, поэтому заказы, добавленные между запросами страниц,
+// не сдвигают выдачу. Запрос обслуживается индексами по (point_id, customer_id, поле сортировки, order_id).
+func (s *PostgresDB) GetCustomersOrders(ctx context.Context, query models.OrdersQuery) ([]models.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetCustomersOrders")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return nil, fmt.Errorf("storage.GetCustomersOrders error: %w", errScope)
+	}
+
+	sortColumn, ok := orderSortColumns[query.Sort]
+	if !ok {
+		return nil, fmt.Errorf("storage.GetCustomersOrders error: %w: %q", ErrUnknownSort, query.Sort)
+	}
+
+	direction, keysetOperator := "ASC", ">"
+	if query.Descending {
+		direction, keysetOperator = "DESC", "<"
+	}
+
+	builder := sq.
 		Select(orderColumns...).
 		From(orderTable).
-		Where(sq.Eq{"customer_id": customerId}).
-		PlaceholderFormat(sq.Dollar).
-		ToSql()
+		Where(sq.Eq{"point_id": point, "customer_id": query.CustomerID}).
+		OrderBy(sortColumn+" "+direction, "order_id "+direction)
+
+	switch query.State {
+	case models.StateAtPoint:
+		builder = builder.Where(sq.Eq{"received_by_customer": false, "refunded": false})
+	case models.StateReceived:
+		builder = builder.Where(sq.Eq{"received_by_customer": true, "refunded": false})
+	case models.StateRefunded:
+		builder = builder.Where(sq.Eq{"refunded": true})
+	}
+
+	if query.Package != "" {
+		builder = builder.Where(sq.Expr("? = ANY(package)", string(query.Package)))
+	}
+
+	if query.After != nil {
+		builder = builder.Where(sq.Expr(
+			fmt.Sprintf("(%s, order_id) %s (?, ?)", sortColumn, keysetOperator),
+			query.After.Value, query.After.OrderID))
+	}
+
+	if query.Limit > 0 {
+		builder = builder.Limit(uint64(query.Limit))
+	}
+
+	sql, args, errSql := builder.PlaceholderFormat(sq.Dollar).ToSql()
 	if errSql != nil {
 		return nil, fmt.Errorf("storage.GetCustomersOrders error: %w", errSql)
 	}
 
-	rows, errQuery := s.db.Query(context.Background(), sql, args...)
+	rows, errQuery := s.tr.GetQueryEngine(ctx).Query(ctx, sql, args...)
 	if errQuery != nil {
-		if errors.Is(errQuery, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("storage.GetCustomersOrders error: %w", ErrOrderNotFound)
-		}
 		return nil, fmt.Errorf("storage.GetCustomersOrders error: %w", errQuery)
 	}
 	defer rows.Close()
@@ -128,162 +298,545 @@ func (s *PostgresDB) GetCustomersOrders(customerId models.ID) ([]models.Order, e
 	var orders []models.Order
 	for rows.Next() {
 		var ordRecord schema.OrderRecord
-		if errScan := rows.Scan(&ordRecord.OrderID, &ordRecord.CustomerID,
-			&ordRecord.ExpirationTime, &ordRecord.ReceivedTime,
-			&ordRecord.ReceivedByCustomer, &ordRecord.Refunded,
-			&ordRecord.Package, &ordRecord.Weight, &ordRecord.Cost, &ordRecord.PackageCost); errScan != nil {
+		if errScan := scanOrder(rows, &ordRecord); errScan != nil {
 			return nil, fmt.Errorf("storage.GetCustomersOrders error: %w", errScan)
 		}
 		orders = append(orders, ordRecord.ToDomain())
 	}
+	if errRows := rows.Err(); errRows != nil {
+		return nil, fmt.Errorf("storage.GetCustomersOrders error: %w", errRows)
+	}
+	rows.Close()
+
+	if errItems := s.loadItems(ctx, orders); errItems != nil {
+		return nil, fmt.Errorf("storage.GetCustomersOrders error: %w", errItems)
+	}
 
 	return orders, nil
 }
 
-func (s *PostgresDB) GetRefunds() ([]models.Order, error) {
-	sql, args, errSql := sq.
+// GetRefunds Возвращает страницу возвратов по возрастанию времени возврата и общее число возвратов в диапазоне дат.
+// Новые возвраты попадают в конец списка, поэтому уже выданные курсоры не сдвигаются.
+// Страница и количество читаются в одной транзакции, чтобы Total соответствовал странице.
+// С курсором After страница начинается сразу после него, Offset при этом не учитывается.
+func (s *PostgresDB) GetRefunds(ctx context.Context, query models.RefundsQuery) ([]models.Order, int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetRefunds")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return nil, 0, fmt.Errorf("storage.GetRefunds error: %w", errScope)
+	}
+
+	filter := sq.And{sq.Eq{"point_id": point, "refunded": true}}
+	if !query.From.IsZero() {
+		filter = append(filter, sq.GtOrEq{"refunded_time": query.From})
+	}
+	if !query.To.IsZero() {
+		filter = append(filter, sq.Lt{"refunded_time": query.To})
+	}
+
+	pageBuilder := sq.
 		Select(orderColumns...).
 		From(orderTable).
-		Where(sq.Eq{"refunded": true}).
+		Where(filter).
+		OrderBy("refunded_time ASC", "order_id ASC")
+
+	if query.After != nil {
+		pageBuilder = pageBuilder.Where(sq.Expr("(refunded_time, order_id) > (?, ?)", query.After.RefundedTime, query.After.OrderID))
+	} else if query.Offset > 0 {
+		pageBuilder = pageBuilder.Offset(uint64(query.Offset))
+	}
+	if query.Limit > 0 {
+		pageBuilder = pageBuilder.Limit(uint64(query.Limit))
+	}
+
+	pageSql, pageArgs, errPageSql := pageBuilder.PlaceholderFormat(sq.Dollar).ToSql()
+	if errPageSql != nil {
+		return nil, 0, fmt.Errorf("storage.GetRefunds error: %w", errPageSql)
+	}
+
+	countSql, countArgs, errCountSql := sq.
+		Select("COUNT(*)").
+		From(orderTable).
+		Where(filter).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errCountSql != nil {
+		return nil, 0, fmt.Errorf("storage.GetRefunds error: %w", errCountSql)
+	}
+
+	var (
+		orders []models.Order
+		total  int
+	)
+
+	f := func(ctxTX context.Context) error {
+		queryEngine := s.tr.GetQueryEngine(ctxTX)
+		orders = nil
+
+		if errCount := queryEngine.QueryRow(ctxTX, countSql, countArgs...).Scan(&total); errCount != nil {
+			return fmt.Errorf("storage.GetRefunds error: %w", errCount)
+		}
+
+		rows, errQuery := queryEngine.Query(ctxTX, pageSql, pageArgs...)
+		if errQuery != nil {
+			return fmt.Errorf("storage.GetRefunds error: %w", errQuery)
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var ordRecord schema.OrderRecord
+			if errScan := scanOrder(rows, &ordRecord); errScan != nil {
+				return fmt.Errorf("storage.GetRefunds error: %w", errScan)
+			}
+			orders = append(orders, ordRecord.ToDomain())
+		}
+		rows.Close()
+		if errRows := rows.Err(); errRows != nil {
+			return errRows
+		}
+
+		return s.loadItems(ctxTX, orders)
+	}
+
+	if err := s.tr.RunRepeatableRead(ctx, f); err != nil {
+		return nil, 0, fmt.Errorf("storage.GetRefunds error: %w", err)
+	}
+
+	return orders, total, nil
+}
+
+// GetStatusHistory Возвращает историю статусов заказа в хронологическом порядке.
+func (s *PostgresDB) GetStatusHistory(ctx context.Context, orderId models.ID) ([]models.StatusChange, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetStatusHistory")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return nil, fmt.Errorf("storage.GetStatusHistory error: %w", errScope)
+	}
+
+	sql, args, errSql := sq.
+		Select(statusHistoryColumns...).
+		From(statusHistoryTable).
+		Where(sq.Eq{"point_id": point, "order_id": orderId}).
+		OrderBy("changed_at", "id").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if errSql != nil {
-		return nil, fmt.Errorf("storage.GetRefunds error: %w", errSql)
+		return nil, fmt.Errorf("storage.GetStatusHistory error: %w", errSql)
 	}
 
-	rows, errQuery := s.db.Query(context.Background(), sql, args...)
+	rows, errQuery := s.tr.GetQueryEngine(ctx).Query(ctx, sql, args...)
 	if errQuery != nil {
-		if errors.Is(errQuery, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("storage.GetRefunds error: %w", ErrOrderNotFound)
-		}
-		return nil, fmt.Errorf("storage.GetRefunds error: %w", errQuery)
+		return nil, fmt.Errorf("storage.GetStatusHistory error: %w", errQuery)
 	}
 	defer rows.Close()
 
-	var orders []models.Order
+	var history []models.StatusChange
 	for rows.Next() {
-		var ordRecord schema.OrderRecord
-		if errScan := rows.Scan(&ordRecord.OrderID, &ordRecord.CustomerID,
-			&ordRecord.ExpirationTime, &ordRecord.ReceivedTime,
-			&ordRecord.ReceivedByCustomer, &ordRecord.Refunded,
-			&ordRecord.Package, &ordRecord.Weight, &ordRecord.Cost, &ordRecord.PackageCost); errScan != nil {
-			return nil, fmt.Errorf("storage.GetRefunds error: %w", errScan)
+		var changeRecord schema.StatusChangeRecord
+		if errScan := rows.Scan(&changeRecord.OrderID, &changeRecord.StatusFrom, &changeRecord.StatusTo,
+			&changeRecord.Reason, &changeRecord.Operator, &changeRecord.ChangedAt); errScan != nil {
+			return nil, fmt.Errorf("storage.GetStatusHistory error: %w", errScan)
 		}
-		orders = append(orders, ordRecord.ToDomain())
+		history = append(history, changeRecord.ToDomain())
 	}
 
-	return orders, nil
+	return history, nil
 }
 
-func (s *PostgresDB) ChangeOrder(order models.Order) error {
-	ordRecord := schema.Transform(order)
+func (s *PostgresDB) ChangeOrder(ctx context.Context, order models.Order) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.ChangeOrder")
+	defer span.Finish()
 
 	f := func(ctxTX context.Context) error {
-		queryEngine := s.tr.GetQueryEngine(ctxTX)
+		return s.updateOrder(ctxTX, order)
+	}
 
-		sql, args, errSql := sq.
-			Update(orderTable).
-			Set("customer_id", ordRecord.CustomerID).
-			Set("expiration_time", ordRecord.ExpirationTime).
-			Set("received_time", ordRecord.ReceivedTime).
-			Set("received_by_customer", ordRecord.ReceivedByCustomer).
-			Set("refunded", ordRecord.Refunded).
-			Set("package", ordRecord.Package).
-			Set("weight", ordRecord.Weight).
-			Set("cost", ordRecord.Cost).
-			Set("package_cost", ordRecord.PackageCost).
-			Where(sq.Eq{"order_id": ordRecord.OrderID}).
-			PlaceholderFormat(sq.Dollar).
-			ToSql()
-		if errSql != nil {
-			return fmt.Errorf("storage.ChangeOrder error: %w", errSql)
+	if err := s.tr.RunRepeatableRead(ctx, f); err != nil {
+		return fmt.Errorf("storage.ChangeOrder error: %w", err)
+	}
+
+	return nil
+}
+
+// ChangeStatus Обновляет заказ, записывает переход статуса в историю и событие в outbox в одной транзакции.
+func (s *PostgresDB) ChangeStatus(ctx context.Context, order models.Order, event models.OrderEvent) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.ChangeStatus")
+	defer span.Finish()
+
+	f := func(ctxTX context.Context) error {
+		if errUpdate := s.updateOrder(ctxTX, order); errUpdate != nil {
+			return errUpdate
 		}
 
-		_, errExec := queryEngine.Exec(context.Background(), sql, args...)
-		if errExec != nil {
-			if errors.Is(errExec, pgx.ErrNoRows) {
-				return fmt.Errorf("storage.ChangeOrder error: %w", ErrOrderNotFound)
-			}
-			return fmt.Errorf("storage.ChangeOrder error: %w", errExec)
+		return s.addEvent(ctxTX, event)
+	}
+
+	if err := s.tr.RunRepeatableRead(ctx, f); err != nil {
+		return fmt.Errorf("storage.ChangeStatus error: %w", err)
+	}
+
+	return nil
+}
+
+// ChangeStatuses Блокирует заказы через SELECT ... FOR UPDATE и передает их в change. Возвращенные события
+// сохраняются вместе с обновленными заказами (event.Order) в той же транзакции. Если change возвращает ошибку,
+// транзакция откатывается и ни один заказ не изменяется. Строки блокируются в порядке ID, чтобы параллельные
+// пачки с пересекающимися заказами не приводили к взаимной блокировке.
+// Код выдачи клиента, у которого после изменения не осталось заказов в ожидании выдачи, удаляется в той же транзакции.
+func (s *PostgresDB) ChangeStatuses(ctx context.Context, orderIds []models.ID, change func(orders []models.Order) ([]models.OrderEvent, error)) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.ChangeStatuses")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return fmt.Errorf("storage.ChangeStatuses error: %w", errScope)
+	}
+
+	f := func(ctxTX context.Context) error {
+		events, errApply := s.applyStatuses(ctxTX, point, orderIds, change)
+		if errApply != nil {
+			return errApply
 		}
 
-		return nil
+		customerIds := make([]models.ID, 0, len(events))
+		for _, event := range events {
+			customerIds = append(customerIds, event.Order.CustomerID)
+		}
+		if errRelease := s.releasePickupCodes(ctxTX, point, customerIds); errRelease != nil {
+			return errRelease
+		}
 
+		return s.addEvents(ctxTX, events)
 	}
 
-	if err := s.tr.RunRepeatableRead(context.Background(), f); err != nil {
-		return fmt.Errorf("storage.ChangeOrder error: %w", err)
+	if err := s.tr.RunRepeatableRead(ctx, f); err != nil {
+		return fmt.Errorf("storage.ChangeStatuses error: %w", err)
 	}
 
 	return nil
 }
 
-func (s *PostgresDB) ReceiveOrder(orderId models.ID) (models.Order, error) {
+// applyStatuses Блокирует заказы пункта point, передает их в change и сохраняет измененные заказы из событий.
+// Сами события не сохраняются: вызывающий дописывает их в outbox после остальных изменений транзакции.
+func (s *PostgresDB) applyStatuses(ctx context.Context, point models.PointID, orderIds []models.ID, change func(orders []models.Order) ([]models.OrderEvent, error)) ([]models.OrderEvent, error) {
+	queryEngine := s.tr.GetQueryEngine(ctx)
+
 	sql, args, errSql := sq.
-		Update(orderTable).
-		Set("received_time", time.Now()).
-		Set("received_by_customer", true).
-		Where(sq.Eq{"order_id": orderId}).
+		Select(orderColumns...).
+		From(orderTable).
+		Where(sq.Eq{"point_id": point, "order_id": orderIds}).
+		OrderBy("order_id").
+		Suffix("FOR UPDATE").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if errSql != nil {
-		return models.Order{}, fmt.Errorf("storage.ReceiveOrder error: %w", errSql)
+		return nil, fmt.Errorf("storage.applyStatuses error: %w", errSql)
 	}
 
-	rows, errQuery := s.db.Query(context.Background(), sql, args...)
+	rows, errQuery := queryEngine.Query(ctx, sql, args...)
 	if errQuery != nil {
-		if errors.Is(errQuery, pgx.ErrNoRows) {
-			return models.Order{}, fmt.Errorf("storage.ReceiveOrder error: %w", ErrOrderNotFound)
-		}
-		return models.Order{}, fmt.Errorf("storage.ReceiveOrder error: %w", errQuery)
+		return nil, fmt.Errorf("storage.applyStatuses error: %w", errQuery)
 	}
-	defer rows.Close()
 
-	var order models.Order
+	var orders []models.Order
 	for rows.Next() {
 		var ordRecord schema.OrderRecord
-		if errScan := rows.Scan(&ordRecord.OrderID, &ordRecord.CustomerID,
-			&ordRecord.ExpirationTime, &ordRecord.ReceivedTime,
-			&ordRecord.ReceivedByCustomer, &ordRecord.Refunded,
-			&ordRecord.Package, &ordRecord.Weight, &ordRecord.Cost, &ordRecord.PackageCost); errScan != nil {
-			return models.Order{}, fmt.Errorf("storage.ReceiveOrder error: %w", errScan)
+		if errScan := scanOrder(rows, &ordRecord); errScan != nil {
+			rows.Close()
+			return nil, fmt.Errorf("storage.applyStatuses error: %w", errScan)
 		}
-		order = ordRecord.ToDomain()
+		orders = append(orders, ordRecord.ToDomain())
+	}
+	rows.Close()
+	if errRows := rows.Err(); errRows != nil {
+		return nil, fmt.Errorf("storage.applyStatuses error: %w", errRows)
+	}
+	if errItems := s.loadItems(ctx, orders); errItems != nil {
+		return nil, errItems
 	}
 
-	return order, nil
+	events, errChange := change(orders)
+	if errChange != nil {
+		return nil, errChange
+	}
+
+	for _, event := range events {
+		if errUpdate := s.updateOrder(ctx, event.Order); errUpdate != nil {
+			return nil, errUpdate
+		}
+	}
+
+	return events, nil
+}
+
+func (s *PostgresDB) addEvents(ctx context.Context, events []models.OrderEvent) error {
+	for _, event := range events {
+		if errEvent := s.addEvent(ctx, event); errEvent != nil {
+			return errEvent
+		}
+	}
+	return nil
 }
 
-func (s *PostgresDB) ReturnOrder(orderId models.ID) (models.Order, error) {
+// GetTimedOutTryOns Идентификаторы не более limit заказов на примерке, время которой вышло к моменту now,
+// начиная с самых давних.
+func (s *PostgresDB) GetTimedOutTryOns(ctx context.Context, now time.Time, limit int) ([]models.ID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetTimedOutTryOns")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return nil, fmt.Errorf("storage.GetTimedOutTryOns error: %w", errScope)
+	}
+
 	sql, args, errSql := sq.
-		Delete(orderTable).
-		Where(sq.Eq{"order_id": orderId}).
+		Select("order_id").
+		From(orderTable).
+		Where(sq.Eq{"point_id": point, "status": string(models.StatusTryingOn)}).
+		Where(sq.Lt{"try_on_until": now}).
+		OrderBy("try_on_until", "order_id").
+		Limit(uint64(limit)).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if errSql != nil {
-		return models.Order{}, fmt.Errorf("storage.ReturnOrder error: %w", errSql)
+		return nil, fmt.Errorf("storage.GetTimedOutTryOns error: %w", errSql)
 	}
 
-	rows, errQuery := s.db.Query(context.Background(), sql, args...)
+	rows, errQuery := s.tr.GetQueryEngine(ctx).Query(ctx, sql, args...)
 	if errQuery != nil {
-		if errors.Is(errQuery, pgx.ErrNoRows) {
-			return models.Order{}, fmt.Errorf("storage.ReceiveOrder error: %w", ErrOrderNotFound)
+		return nil, fmt.Errorf("storage.GetTimedOutTryOns error: %w", errQuery)
+	}
+	defer rows.Close()
+
+	var ids []models.ID
+	for rows.Next() {
+		var orderId models.ID
+		if errScan := rows.Scan(&orderId); errScan != nil {
+			return nil, fmt.Errorf("storage.GetTimedOutTryOns error: %w", errScan)
 		}
-		return models.Order{}, fmt.Errorf("storage.ReceiveOrder error: %w", errQuery)
+		ids = append(ids, orderId)
+	}
+	if errRows := rows.Err(); errRows != nil {
+		return nil, fmt.Errorf("storage.GetTimedOutTryOns error: %w", errRows)
+	}
+
+	return ids, nil
+}
+
+// GetExpiredOrders Идентификаторы не более limit заказов, срок хранения которых истек к моменту now, а статус
+// просрочки еще не записан, начиная с самых давних.
+func (s *PostgresDB) GetExpiredOrders(ctx context.Context, now time.Time, limit int) ([]models.ID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetExpiredOrders")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return nil, fmt.Errorf("storage.GetExpiredOrders error: %w", errScope)
+	}
+
+	sql, args, errSql := sq.
+		Select("order_id").
+		From(orderTable).
+		Where(sq.Eq{"point_id": point, "status": []string{string(models.StatusAccepted), string(models.StatusReadyForPickup)}}).
+		Where(sq.Lt{"expiration_time": now}).
+		OrderBy("expiration_time", "order_id").
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return nil, fmt.Errorf("storage.GetExpiredOrders error: %w", errSql)
+	}
+
+	rows, errQuery := s.tr.GetQueryEngine(ctx).Query(ctx, sql, args...)
+	if errQuery != nil {
+		return nil, fmt.Errorf("storage.GetExpiredOrders error: %w", errQuery)
 	}
 	defer rows.Close()
 
-	var order models.Order
+	var ids []models.ID
 	for rows.Next() {
+		var orderId models.ID
+		if errScan := rows.Scan(&orderId); errScan != nil {
+			return nil, fmt.Errorf("storage.GetExpiredOrders error: %w", errScan)
+		}
+		ids = append(ids, orderId)
+	}
+	if errRows := rows.Err(); errRows != nil {
+		return nil, fmt.Errorf("storage.GetExpiredOrders error: %w", errRows)
+	}
+
+	return ids, nil
+}
+
+// ReturnOrder Удаляет заказ вместе с товарами, записав в историю переход в статус возврата курьеру и событие в outbox.
+// Записи истории не ссылаются на таблицу заказов, поэтому переживают удаление.
+// Если вместе с заказом курьеру уходят принятые возвраты, в той же транзакции сохраняются их переходы refunds.
+// Если клиенту больше нечего забирать, его код выдачи удаляется в той же транзакции.
+func (s *PostgresDB) ReturnOrder(ctx context.Context, event models.OrderEvent, refunds []models.RefundChange) (models.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.ReturnOrder")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return models.Order{}, fmt.Errorf("storage.ReturnOrder error: %w", errScope)
+	}
+
+	var order models.Order
+
+	f := func(ctxTX context.Context) error {
+		queryEngine := s.tr.GetQueryEngine(ctxTX)
+
+		for _, refund := range refunds {
+			if errRefund := s.changeRefundState(ctxTX, refund); errRefund != nil {
+				return errRefund
+			}
+		}
+
+		sql, args, errSql := sq.
+			Delete(orderTable).
+			Where(sq.Eq{"point_id": point, "order_id": event.Change.OrderID}).
+			Suffix("RETURNING " + strings.Join(orderColumns, ", ")).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if errSql != nil {
+			return fmt.Errorf("storage.ReturnOrder error: %w", errSql)
+		}
+
 		var ordRecord schema.OrderRecord
-		if errScan := rows.Scan(&ordRecord.OrderID, &ordRecord.CustomerID,
-			&ordRecord.ExpirationTime, &ordRecord.ReceivedTime,
-			&ordRecord.ReceivedByCustomer, &ordRecord.Refunded,
-			&ordRecord.Package, &ordRecord.Weight, &ordRecord.Cost, &ordRecord.PackageCost); errScan != nil {
-			return models.Order{}, fmt.Errorf("storage.ReceiveOrder error: %w", errScan)
+		errScan := scanOrder(queryEngine.QueryRow(ctxTX, sql, args...), &ordRecord)
+		if errScan != nil {
+			if errors.Is(errScan, pgx.ErrNoRows) {
+				return fmt.Errorf("storage.ReturnOrder error: %w", ErrOrderNotFound)
+			}
+			return fmt.Errorf("storage.ReturnOrder error: %w", errScan)
 		}
 		order = ordRecord.ToDomain()
+		// Товары удалены вместе с заказом, их последние состояния есть только в событии.
+		order.Items = event.Order.Items
+
+		if errRelease := s.releasePickupCodes(ctxTX, point, []models.ID{order.CustomerID}); errRelease != nil {
+			return errRelease
+		}
+
+		return s.addEvent(ctxTX, event)
+	}
+
+	if err := s.tr.RunRepeatableRead(ctx, f); err != nil {
+		return models.Order{}, fmt.Errorf("storage.ReturnOrder error: %w", err)
 	}
 
 	return order, nil
 }
+
+// ReturnItems Передает курьеру отказные и возвращенные товары заказа, который остается у клиента.
+// Заказ с новыми состояниями товаров (event.Order), событие и переходы принятых возвратов refunds
+// сохраняются в одной транзакции.
+func (s *PostgresDB) ReturnItems(ctx context.Context, event models.OrderEvent, refunds []models.RefundChange) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.ReturnItems")
+	defer span.Finish()
+
+	f := func(ctxTX context.Context) error {
+		for _, refund := range refunds {
+			if errRefund := s.changeRefundState(ctxTX, refund); errRefund != nil {
+				return errRefund
+			}
+		}
+
+		if errUpdate := s.updateOrder(ctxTX, event.Order); errUpdate != nil {
+			return errUpdate
+		}
+
+		return s.addEvent(ctxTX, event)
+	}
+
+	if err := s.tr.RunRepeatableRead(ctx, f); err != nil {
+		return fmt.Errorf("storage.ReturnItems error: %w", err)
+	}
+
+	return nil
+}
+
+// scanOrder Читает строку, выбранную по orderColumns.
+func scanOrder(row pgx.Row, ordRecord *schema.OrderRecord) error {
+	return row.Scan(&ordRecord.OrderID, &ordRecord.ExternalSource, &ordRecord.ExternalNumber, &ordRecord.CustomerID,
+		&ordRecord.ExpirationTime, &ordRecord.ReceivedTime,
+		&ordRecord.ReceivedByCustomer, &ordRecord.Refunded, &ordRecord.RefundedTime, &ordRecord.Status,
+		&ordRecord.Package, &ordRecord.Weight, &ordRecord.CostMinor, &ordRecord.PackageCostMinor, &ordRecord.Currency,
+		&ordRecord.TryOnUntil, &ordRecord.PointID, &ordRecord.Cell)
+}
+
+// updateOrder Меняет заказ пункта запроса, заказ другого пункта считается ненайденным.
+func (s *PostgresDB) updateOrder(ctx context.Context, order models.Order) error {
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return fmt.Errorf("storage.updateOrder error: %w", errScope)
+	}
+
+	queryEngine := s.tr.GetQueryEngine(ctx)
+	ordRecord := schema.Transform(order)
+
+	sql, args, errSql := sq.
+		Update(orderTable).
+		Set("customer_id", ordRecord.CustomerID).
+		Set("expiration_time", ordRecord.ExpirationTime).
+		Set("received_time", ordRecord.ReceivedTime).
+		Set("received_by_customer", ordRecord.ReceivedByCustomer).
+		Set("refunded", ordRecord.Refunded).
+		Set("refunded_time", ordRecord.RefundedTime).
+		Set("status", ordRecord.Status).
+		Set("package", ordRecord.Package).
+		Set("weight", ordRecord.Weight).
+		Set("cost_minor", ordRecord.CostMinor).
+		Set("package_cost_minor", ordRecord.PackageCostMinor).
+		Set("currency", ordRecord.Currency).
+		Set("try_on_until", ordRecord.TryOnUntil).
+		Set("cell", ordRecord.Cell).
+		Where(sq.Eq{"point_id": point, "order_id": ordRecord.OrderID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return fmt.Errorf("storage.updateOrder error: %w", errSql)
+	}
+
+	tag, errExec := queryEngine.Exec(ctx, sql, args...)
+	if errExec != nil {
+		return fmt.Errorf("storage.updateOrder error: %w", errExec)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("storage.updateOrder error: %w", ErrOrderNotFound)
+	}
+
+	return s.updateItems(ctx, order.Items)
+}
+
+func (s *PostgresDB) addStatusChange(ctx context.Context, change models.StatusChange) error {
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return fmt.Errorf("storage.addStatusChange error: %w", errScope)
+	}
+
+	queryEngine := s.tr.GetQueryEngine(ctx)
+	changeRecord := schema.TransformStatusChange(change)
+
+	sql, args, errSql := sq.
+		Insert(statusHistoryTable).
+		Columns(statusHistoryColumns...).
+		Columns("point_id").
+		Values(changeRecord.OrderID, changeRecord.StatusFrom, changeRecord.StatusTo,
+			changeRecord.Reason, changeRecord.Operator, changeRecord.ChangedAt, string(point)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return fmt.Errorf("storage.addStatusChange error: %w", errSql)
+	}
+
+	if _, errExec := queryEngine.Exec(ctx, sql, args...); errExec != nil {
+		return fmt.Errorf("storage.addStatusChange error: %w", errExec)
+	}
+
+	return nil
+}
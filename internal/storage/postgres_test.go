@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"homework-1/internal/config"
 	"homework-1/internal/models"
+	"sync"
 	"testing"
 	"time"
 )
@@ -19,6 +20,9 @@ const (
 	path = "../../config/config.yaml"
 )
 
+// testCtx Тесты работают с пунктом default, который создается миграцией.
+var testCtx = models.WithPoint(context.Background(), models.DefaultPoint)
+
 func getConnUrl(path string) (string, error) {
 	cfg, errCfg := config.LoadConfig(path)
 	if errCfg != nil {
@@ -40,7 +44,7 @@ func clearDB(connURL string) error {
 	}
 	defer db.Close()
 
-	_, err = db.Exec(context.Background(), "TRUNCATE TABLE orders CASCADE;")
+	_, err = db.Exec(context.Background(), "TRUNCATE TABLE orders, order_status_history, outbox, refunds, refund_history, packages, pickup_codes, order_items RESTART IDENTITY CASCADE;")
 	return err
 }
 
@@ -48,20 +52,30 @@ func setupDB(t *testing.T, connURL string) {
 	err := clearDB(connURL)
 	require.NoError(t, err)
 
-	db, err := NewStorage(connURL)
+	db, err := NewStorage(testCtx, connURL)
 	require.NoError(t, err)
 
+	// После RESTART IDENTITY первый заказ получает ID 1, на него опираются тесты.
 	initialOrder := models.Order{
-		OrderID:        models.ID(1),
+		External:       models.ExternalRef{Source: "marketplace", Number: "1"},
 		CustomerID:     models.ID(1),
 		ExpirationTime: time.Now().Add(time.Hour),
-		Package:        "box",
+		Status:         models.StatusAccepted,
+		Package:        models.Packaging{"box"},
 		Weight:         10,
-		Cost:           100,
-		PackageCost:    10,
+		Cost:           models.Rubles(100),
+		PackageCost:    models.Rubles(10),
 	}
-	err = db.AddOrder(initialOrder)
+	orderID, err := db.AddOrder(testCtx, initialOrder, models.OrderEvent{
+		Type:  models.EventOrderAdded,
+		Order: initialOrder,
+		Change: models.StatusChange{
+			To:        models.StatusAccepted,
+			ChangedAt: time.Now(),
+		},
+	}, models.PickupCode{}, nil)
 	require.NoError(t, err)
+	require.Equal(t, models.ID(1), orderID)
 }
 
 func TestPostgresDB_AddOrder(t *testing.T) {
@@ -72,21 +86,41 @@ func TestPostgresDB_AddOrder(t *testing.T) {
 		require.NoError(t, err)
 		err = clearDB(connURL)
 		require.NoError(t, err)
-		db, err := NewStorage(connURL)
+		db, err := NewStorage(testCtx, connURL)
 		require.NoError(t, err)
 
 		order := models.Order{
-			OrderID:        models.ID(2),
+			External:       models.ExternalRef{Source: "marketplace", Number: "2"},
 			CustomerID:     models.ID(2),
 			ExpirationTime: time.Now().Add(time.Hour),
-			Package:        "box",
+			Package:        models.Packaging{"box", "wrap"},
 			Weight:         10,
-			Cost:           100,
-			PackageCost:    10,
+			Cost:           models.Rubles(100),
+			PackageCost:    models.Rubles(10),
+		}
+		event := models.OrderEvent{
+			Type:  models.EventOrderAdded,
+			Order: order,
+			Change: models.StatusChange{
+				To:        models.StatusAccepted,
+				ChangedAt: time.Now(),
+			},
 		}
 
-		err = db.AddOrder(order)
-		assert.NoError(t, err)
+		orderID, err := db.AddOrder(testCtx, order, event, models.PickupCode{}, nil)
+		require.NoError(t, err)
+		assert.NotZero(t, orderID)
+
+		resolved, err := db.ResolveOrderID(testCtx, order.External)
+		require.NoError(t, err)
+		assert.Equal(t, orderID, resolved)
+
+		stored, err := db.GetOrder(testCtx, orderID)
+		require.NoError(t, err)
+		assert.Equal(t, order.Package, stored.Package)
+
+		_, err = db.AddOrder(testCtx, order, event, models.PickupCode{}, nil)
+		assert.ErrorIs(t, err, ErrOrderExists)
 	})
 }
 
@@ -97,12 +131,12 @@ func TestPostgresDB_GetOrder(t *testing.T) {
 		connURL, err := getConnUrl(path)
 		require.NoError(t, err)
 		setupDB(t, connURL)
-		db, err := NewStorage(connURL)
+		db, err := NewStorage(testCtx, connURL)
 		require.NoError(t, err)
 
 		orderID := models.ID(1)
 
-		order, err := db.GetOrder(orderID)
+		order, err := db.GetOrder(testCtx, orderID)
 		assert.NoError(t, err)
 		assert.Equal(t, orderID, order.OrderID)
 	})
@@ -115,15 +149,40 @@ func TestPostgresDB_GetCustomersOrders(t *testing.T) {
 		connURL, err := getConnUrl(path)
 		require.NoError(t, err)
 		setupDB(t, connURL)
-		db, err := NewStorage(connURL)
+		db, err := NewStorage(testCtx, connURL)
 		require.NoError(t, err)
 
 		customerID := models.ID(1)
 
-		orders, err := db.GetCustomersOrders(customerID)
+		orders, err := db.GetCustomersOrders(testCtx, models.OrdersQuery{CustomerID: customerID, Sort: models.SortByExpiration})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(orders))
 	})
+
+	t.Run("Фильтр и курсор страницы применяются в запросе", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		query := models.OrdersQuery{CustomerID: models.ID(1), Limit: 10, Sort: models.SortByReceived, State: models.StateReceived}
+		orders, err := db.GetCustomersOrders(testCtx, query)
+		require.NoError(t, err)
+		assert.Empty(t, orders)
+
+		query = models.OrdersQuery{CustomerID: models.ID(1), Limit: 10, Sort: models.SortByExpiration, State: models.StateAtPoint}
+		orders, err = db.GetCustomersOrders(testCtx, query)
+		require.NoError(t, err)
+		require.Len(t, orders, 1)
+
+		query.After = &models.OrdersCursor{Sort: models.SortByExpiration, Value: orders[0].ExpirationTime, OrderID: orders[0].OrderID}
+		orders, err = db.GetCustomersOrders(testCtx, query)
+		require.NoError(t, err)
+		assert.Empty(t, orders)
+	})
 }
 
 func TestPostgresDB_GetRefunds(t *testing.T) {
@@ -133,16 +192,59 @@ func TestPostgresDB_GetRefunds(t *testing.T) {
 		connURL, err := getConnUrl(path)
 		require.NoError(t, err)
 		setupDB(t, connURL)
-		db, err := NewStorage(connURL)
+		db, err := NewStorage(testCtx, connURL)
 		require.NoError(t, err)
 
-		order, _ := db.GetOrder(models.ID(1))
+		order, _ := db.GetOrder(testCtx, models.ID(1))
 		order.Refunded = true
-		err = db.ChangeOrder(order)
+		order.RefundedTime = time.Now().UTC().Truncate(time.Second)
+		err = db.ChangeOrder(testCtx, order)
+		require.NoError(t, err)
 
-		refunds, err := db.GetRefunds()
+		refunds, total, err := db.GetRefunds(testCtx, models.RefundsQuery{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, len(refunds))
+		assert.Equal(t, 1, total)
+	})
+
+	t.Run("Диапазон дат и курсор применяются в запросе, количество считается без курсора", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		refunded := time.Now().UTC().Truncate(time.Second)
+		order, _ := db.GetOrder(testCtx, models.ID(1))
+		order.Refunded = true
+		order.RefundedTime = refunded
+		err = db.ChangeOrder(testCtx, order)
+		require.NoError(t, err)
+
+		refunds, total, err := db.GetRefunds(testCtx, models.RefundsQuery{To: refunded})
+		require.NoError(t, err)
+		assert.Empty(t, refunds)
+		assert.Equal(t, 0, total)
+
+		query := models.RefundsQuery{From: refunded, Limit: 10}
+		refunds, total, err = db.GetRefunds(testCtx, query)
+		require.NoError(t, err)
+		require.Len(t, refunds, 1)
+		assert.Equal(t, 1, total)
+
+		query.After = &models.RefundsCursor{RefundedTime: refunds[0].RefundedTime, OrderID: refunds[0].OrderID}
+		refunds, total, err = db.GetRefunds(testCtx, query)
+		require.NoError(t, err)
+		assert.Empty(t, refunds)
+		assert.Equal(t, 1, total)
+
+		query.After = &models.RefundsCursor{RefundedTime: refunded.Add(-time.Second)}
+		query.Offset = 1
+		refunds, _, err = db.GetRefunds(testCtx, query)
+		require.NoError(t, err)
+		assert.Len(t, refunds, 1, "offset is ignored with a cursor")
 	})
 }
 
@@ -153,7 +255,7 @@ func TestPostgresDB_ChangeOrder(t *testing.T) {
 		connURL, err := getConnUrl(path)
 		require.NoError(t, err)
 		setupDB(t, connURL)
-		db, err := NewStorage(connURL)
+		db, err := NewStorage(testCtx, connURL)
 		require.NoError(t, err)
 
 		order := models.Order{
@@ -163,17 +265,17 @@ func TestPostgresDB_ChangeOrder(t *testing.T) {
 			Refunded:           true,
 			ReceivedTime:       time.Now().Add(-time.Hour),
 		}
-		err = db.ChangeOrder(order)
+		err = db.ChangeOrder(testCtx, order)
 		assert.NoError(t, err)
 
-		order, _ = db.GetOrder(models.ID(1))
+		order, _ = db.GetOrder(testCtx, models.ID(1))
 		assert.Equal(t, true, order.ReceivedByCustomer)
 		assert.Equal(t, true, order.Refunded)
 	})
 }
 
-func TestPostgresDB_ReceiveOrder(t *testing.T) {
-	t.Run("Успешное получение заказа из таблицы в БД", func(t *testing.T) {
+func TestPostgresDB_ChangeStatus(t *testing.T) {
+	t.Run("Успешное изменение статуса заказа в таблице БД", func(t *testing.T) {
 		t.Parallel()
 
 		connURL, err := getConnUrl(path)
@@ -181,16 +283,84 @@ func TestPostgresDB_ReceiveOrder(t *testing.T) {
 
 		setupDB(t, connURL)
 
-		db, err := NewStorage(connURL)
+		db, err := NewStorage(testCtx, connURL)
 		require.NoError(t, err)
 
 		orderID := models.ID(1)
+		order, err := db.GetOrder(testCtx, orderID)
+		require.NoError(t, err)
 
-		order, err := db.ReceiveOrder(orderID)
+		order.Status = models.StatusIssued
+		order.ReceivedByCustomer = true
+		order.ReceivedTime = time.Now()
+		err = db.ChangeStatus(testCtx, order, models.OrderEvent{
+			Type:  models.EventOrderReceived,
+			Order: order,
+			Change: models.StatusChange{
+				OrderID:   orderID,
+				From:      models.StatusAccepted,
+				To:        models.StatusIssued,
+				ChangedAt: time.Now(),
+			},
+		})
 		assert.NoError(t, err)
 
-		order, _ = db.GetOrder(orderID)
+		order, _ = db.GetOrder(testCtx, orderID)
 		assert.Equal(t, true, order.ReceivedByCustomer)
+		assert.Equal(t, models.StatusIssued, order.Status)
+	})
+}
+
+func TestPostgresDB_ChangeStatuses(t *testing.T) {
+	t.Run("Ошибка при обработке пачки откатывает все изменения", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		orderID := models.ID(1)
+		err = db.ChangeStatuses(testCtx, []models.ID{orderID}, func(orders []models.Order) ([]models.OrderEvent, error) {
+			require.Equal(t, 1, len(orders))
+			return nil, assert.AnError
+		})
+		assert.ErrorIs(t, err, assert.AnError)
+
+		order, _ := db.GetOrder(testCtx, orderID)
+		assert.Equal(t, models.StatusAccepted, order.Status)
+	})
+
+	t.Run("Успешная выдача пачки заказов в одной транзакции", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		orderID := models.ID(1)
+		err = db.ChangeStatuses(testCtx, []models.ID{orderID}, func(orders []models.Order) ([]models.OrderEvent, error) {
+			order := orders[0]
+			order.Status = models.StatusIssued
+			order.ReceivedByCustomer = true
+			return []models.OrderEvent{{
+				Type:  models.EventOrderReceived,
+				Order: order,
+				Change: models.StatusChange{
+					OrderID:   orderID,
+					From:      models.StatusAccepted,
+					To:        models.StatusIssued,
+					ChangedAt: time.Now(),
+				},
+			}}, nil
+		})
+		assert.NoError(t, err)
+
+		order, _ := db.GetOrder(testCtx, orderID)
+		assert.Equal(t, models.StatusIssued, order.Status)
 	})
 }
 
@@ -201,14 +371,811 @@ func TestPostgresDB_ReturnOrder(t *testing.T) {
 		connURL, err := getConnUrl(path)
 		require.NoError(t, err)
 		setupDB(t, connURL)
-		db, err := NewStorage(connURL)
+		db, err := NewStorage(testCtx, connURL)
 		require.NoError(t, err)
 
 		orderID := models.ID(1)
-		err = db.ReturnOrder(orderID)
+		returned, err := db.ReturnOrder(testCtx, models.OrderEvent{
+			Type: models.EventOrderReturned,
+			Change: models.StatusChange{
+				OrderID:   orderID,
+				From:      models.StatusExpired,
+				To:        models.StatusReturnedToCourier,
+				ChangedAt: time.Now(),
+			},
+		}, nil)
 		assert.NoError(t, err)
+		assert.Equal(t, orderID, returned.OrderID)
 
-		order, _ := db.GetOrder(orderID)
+		order, _ := db.GetOrder(testCtx, orderID)
 		assert.Equal(t, models.Order{}, order)
 	})
 }
+
+func TestPostgresDB_GetStatusHistory(t *testing.T) {
+	t.Run("История заказа сохраняется после его возврата курьеру", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		orderID := models.ID(1)
+		_, err = db.ReturnOrder(testCtx, models.OrderEvent{
+			Type: models.EventOrderReturned,
+			Change: models.StatusChange{
+				OrderID:   orderID,
+				From:      models.StatusExpired,
+				To:        models.StatusReturnedToCourier,
+				Operator:  "operator",
+				ChangedAt: time.Now(),
+			},
+		}, nil)
+		require.NoError(t, err)
+
+		history, err := db.GetStatusHistory(testCtx, orderID)
+		assert.NoError(t, err)
+		require.Equal(t, 2, len(history))
+		assert.Equal(t, models.StatusAccepted, history[0].To)
+		assert.Equal(t, models.StatusReturnedToCourier, history[1].To)
+		assert.Equal(t, models.Operator("operator"), history[1].Operator)
+	})
+}
+
+func TestPostgresDB_PublishEvents(t *testing.T) {
+	t.Run("Событие добавления заказа публикуется из outbox ровно один раз", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		var events []models.OrderEvent
+		published, err := db.PublishEvents(testCtx, 10, func(event models.OrderEvent) error {
+			events = append(events, event)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, published)
+		require.Equal(t, 1, len(events))
+		assert.Equal(t, models.EventOrderAdded, events[0].Type)
+		assert.Equal(t, models.ID(1), events[0].Order.OrderID)
+
+		published, err = db.PublishEvents(testCtx, 10, func(event models.OrderEvent) error {
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 0, published)
+	})
+
+	t.Run("Нечитаемое событие откладывается, остальные публикуются", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		pool, err := pgxpool.New(context.Background(), connURL)
+		require.NoError(t, err)
+		defer pool.Close()
+
+		// События в формате без версии, как до появления версий payload, в том числе с одиночной упаковкой
+		// строкой, как до появления составной упаковки, и событие в неизвестном формате.
+		_, err = pool.Exec(context.Background(), `INSERT INTO outbox (event_type, order_id, payload, created_at) VALUES
+			('order_added', 1, '{"Type":"order_added","Order":{"OrderID":1,"Package":["box"]}}', NOW()),
+			('order_added', 1, '{"Type":"order_added","Order":{"OrderID":1,"Package":"bag"}}', NOW()),
+			('order_added', 1, '{"version":99}', NOW())`)
+		require.NoError(t, err)
+
+		var events []models.OrderEvent
+		published, err := db.PublishEvents(testCtx, 10, func(event models.OrderEvent) error {
+			events = append(events, event)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, published)
+		require.Len(t, events, 3)
+		assert.Equal(t, models.Packaging{"box"}, events[0].Order.Package)
+		assert.Equal(t, models.Packaging{"box"}, events[1].Order.Package)
+		assert.Equal(t, models.Packaging{"bag"}, events[2].Order.Package)
+
+		var failure string
+		err = pool.QueryRow(context.Background(), "SELECT failure FROM outbox WHERE failed_at IS NOT NULL").Scan(&failure)
+		require.NoError(t, err)
+		assert.Contains(t, failure, "unknown payload version")
+	})
+}
+
+func TestPostgresDB_Refunds(t *testing.T) {
+	t.Run("Возврат проходит от оформления до передачи курьеру", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		now := time.Now().UTC().Truncate(time.Second)
+		order, err := db.GetOrder(testCtx, models.ID(1))
+		require.NoError(t, err)
+		order.Status = models.StatusRefundRequested
+
+		refund := models.Refund{
+			OrderID:    order.OrderID,
+			CustomerID: order.CustomerID,
+			Reason:     models.RefundReasonDamaged,
+			Comment:    "разбит экран",
+			State:      models.RefundRequested,
+			Amount:     order.Cost,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		}
+		event := models.OrderEvent{
+			Type:   models.EventOrderRefundRequested,
+			Order:  order,
+			Change: models.StatusChange{OrderID: order.OrderID, From: models.StatusIssued, To: models.StatusRefundRequested, ChangedAt: now},
+		}
+		change := models.RefundChange{To: models.RefundRequested, Comment: refund.Comment, ChangedAt: now}
+
+		created, err := db.CreateRefund(testCtx, refund, change, event)
+		require.NoError(t, err)
+		assert.Equal(t, models.ID(1), created.ID)
+
+		_, err = db.CreateRefund(testCtx, refund, change, event)
+		assert.ErrorIs(t, err, ErrRefundExists)
+
+		approved, err := db.ChangeRefund(testCtx, created.ID,
+			func(refund models.Refund, order models.Order) (models.Refund, models.RefundChange, []models.OrderEvent, error) {
+				assert.Equal(t, models.StatusRefundRequested, order.Status)
+				refundChange := models.RefundChange{RefundID: refund.ID, From: refund.State, To: models.RefundApproved, Comment: "брак", ChangedAt: now}
+				refund.State = models.RefundApproved
+				return refund, refundChange, nil, nil
+			})
+		require.NoError(t, err)
+		assert.Equal(t, models.RefundApproved, approved.State)
+
+		handed := models.RefundChange{RefundID: created.ID, From: models.RefundApproved, To: models.RefundHandedToCourier, ChangedAt: now}
+		_, err = db.ReturnOrder(testCtx, models.OrderEvent{
+			Type:   models.EventOrderReturned,
+			Change: models.StatusChange{OrderID: order.OrderID, From: models.StatusRefunded, To: models.StatusReturnedToCourier, ChangedAt: now},
+		}, []models.RefundChange{handed})
+		require.NoError(t, err)
+
+		stored, err := db.GetRefund(testCtx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, models.RefundHandedToCourier, stored.State)
+		assert.Equal(t, order.Cost, stored.Amount)
+
+		history, err := db.GetRefundHistory(testCtx, created.ID)
+		require.NoError(t, err)
+		require.Len(t, history, 3)
+		assert.Equal(t, "брак", history[1].Comment)
+	})
+
+	t.Run("Возврат не найден", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		_, err = db.GetRefund(testCtx, models.ID(100))
+		assert.ErrorIs(t, err, ErrRefundNotFound)
+	})
+}
+
+func TestPostgresDB_Packages(t *testing.T) {
+	t.Run("Каталог заполняется, меняется и выводит типы из оборота", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		now := time.Now().UTC().Truncate(time.Second)
+		bag := models.PackageSpec{Type: "bag", Kind: models.PackageKindContainer, Price: models.Rubles(5), MaxWeight: 10, AllowWrap: true, CreatedAt: now, UpdatedAt: now}
+		box := models.PackageSpec{Type: "box", Kind: models.PackageKindContainer, Price: models.Rubles(20), MaxWeight: 30, AllowWrap: true, CreatedAt: now, UpdatedAt: now}
+
+		added, err := db.SeedPackages(testCtx, []models.PackageSpec{bag, box})
+		require.NoError(t, err)
+		assert.Equal(t, 2, added)
+
+		// Повторное заполнение не перезаписывает цену, измененную администратором.
+		box.Price = models.Rubles(25)
+		require.NoError(t, db.UpdatePackage(testCtx, box))
+		box.Price = models.Rubles(20)
+		added, err = db.SeedPackages(testCtx, []models.PackageSpec{bag, box})
+		require.NoError(t, err)
+		assert.Zero(t, added)
+
+		stored, err := db.GetPackage(testCtx, "box")
+		require.NoError(t, err)
+		assert.Equal(t, models.Rubles(25), stored.Price)
+
+		assert.ErrorIs(t, db.AddPackage(testCtx, bag), ErrPackageExists)
+
+		require.NoError(t, db.RetirePackage(testCtx, "bag", now))
+		require.NoError(t, db.RetirePackage(testCtx, "bag", now.Add(time.Hour)))
+		retired, err := db.GetPackage(testCtx, "bag")
+		require.NoError(t, err)
+		assert.True(t, now.Equal(retired.RetiredAt))
+
+		active, err := db.GetPackages(testCtx, false)
+		require.NoError(t, err)
+		require.Len(t, active, 1)
+		assert.Equal(t, models.PackageType("box"), active[0].Type)
+
+		all, err := db.GetPackages(testCtx, true)
+		require.NoError(t, err)
+		assert.Len(t, all, 2)
+	})
+
+	t.Run("Тип не зарегистрирован", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		_, err = db.GetPackage(testCtx, "crate")
+		assert.ErrorIs(t, err, ErrPackageNotFound)
+		assert.ErrorIs(t, db.RetirePackage(testCtx, "crate", time.Now()), ErrPackageNotFound)
+	})
+}
+
+func TestPostgresDB_PickupCodes(t *testing.T) {
+	t.Run("Код выпускается один на клиента и удаляется, когда забирать больше нечего", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		customerID := models.ID(5)
+		addOrder := func(number string, hash string) models.OrderEvent {
+			order := models.Order{
+				External:       models.ExternalRef{Source: "marketplace", Number: number},
+				CustomerID:     customerID,
+				ExpirationTime: time.Now().Add(time.Hour),
+				Status:         models.StatusAccepted,
+				Package:        models.Packaging{"box"},
+				Cost:           models.Rubles(100),
+			}
+			event := models.OrderEvent{Type: models.EventOrderAdded, Order: order, PickupCode: "1234",
+				Change: models.StatusChange{To: models.StatusAccepted, ChangedAt: time.Now()}}
+			code := models.PickupCode{CustomerID: customerID, Hash: []byte(hash), Salt: []byte("salt"), CreatedAt: time.Now()}
+
+			orderID, errAdd := db.AddOrder(testCtx, order, event, code, nil)
+			require.NoError(t, errAdd)
+			event.Order.OrderID = orderID
+			return event
+		}
+
+		first := addOrder("51", "first")
+		second := addOrder("52", "second")
+
+		stored, err := db.GetPickupCode(testCtx, customerID)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("first"), stored.Hash)
+
+		lockedUntil := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+		failed, err := db.RecordPickupFailure(testCtx, customerID, 2, lockedUntil)
+		require.NoError(t, err)
+		assert.Equal(t, 1, failed.FailedAttempts)
+		assert.False(t, failed.Locked(time.Now()))
+
+		failed, err = db.RecordPickupFailure(testCtx, customerID, 2, lockedUntil)
+		require.NoError(t, err)
+		assert.Zero(t, failed.FailedAttempts)
+		assert.True(t, failed.Locked(time.Now()))
+
+		third := addOrder("53", "ignored")
+
+		handOver := func(orderID models.ID, used models.PickupCode, next models.PickupCode) error {
+			return db.HandOverOrders(testCtx, []models.ID{orderID}, used, next, func(orders []models.Order) ([]models.OrderEvent, error) {
+				order := orders[0]
+				order.Status = models.StatusIssued
+				order.ReceivedByCustomer = true
+				order.ReceivedTime = time.Now()
+				return []models.OrderEvent{{
+					Type:       models.EventOrderReceived,
+					Order:      order,
+					Change:     models.StatusChange{OrderID: orderID, From: models.StatusAccepted, To: models.StatusIssued, ChangedAt: time.Now()},
+					PickupCode: "654321",
+				}}, nil
+			})
+		}
+
+		// Код одноразовый: после выдачи вместо него действует следующий, со сброшенными ошибками.
+		next := models.PickupCode{CustomerID: customerID, Hash: []byte("next"), Salt: []byte("salt"), CreatedAt: time.Now()}
+		require.NoError(t, handOver(first.Order.OrderID, stored, next))
+		rotated, err := db.GetPickupCode(testCtx, customerID)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("next"), rotated.Hash)
+		assert.Zero(t, rotated.FailedAttempts)
+		assert.False(t, rotated.Locked(time.Now()))
+
+		err = handOver(second.Order.OrderID, stored, next)
+		assert.ErrorIs(t, err, ErrPickupCodeUsed)
+		order, err := db.GetOrder(testCtx, second.Order.OrderID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StatusAccepted, order.Status, "used code does not hand over orders")
+
+		require.NoError(t, handOver(second.Order.OrderID, rotated, models.PickupCode{CustomerID: customerID, Hash: []byte("last"), Salt: []byte("salt")}))
+		_, err = db.GetPickupCode(testCtx, customerID)
+		require.NoError(t, err)
+
+		// Последний ожидавший заказ ушел из ожидания не через выдачу: код удаляется в той же транзакции.
+		err = db.ChangeStatuses(testCtx, []models.ID{third.Order.OrderID}, func(orders []models.Order) ([]models.OrderEvent, error) {
+			order := orders[0]
+			order.Status = models.StatusExpired
+			return []models.OrderEvent{{Type: models.EventOrderExpired, Order: order,
+				Change: models.StatusChange{OrderID: order.OrderID, From: models.StatusAccepted, To: models.StatusExpired, ChangedAt: time.Now()}}}, nil
+		})
+		require.NoError(t, err)
+		_, err = db.GetPickupCode(testCtx, customerID)
+		assert.ErrorIs(t, err, ErrPickupCodeNotFound)
+	})
+}
+
+func TestPostgresDB_OrderItems(t *testing.T) {
+	t.Run("Товары заказа сохраняются вместе с заказом и меняют состояние", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		order := models.Order{
+			External:       models.ExternalRef{Source: "marketplace", Number: "61"},
+			CustomerID:     models.ID(6),
+			ExpirationTime: time.Now().Add(time.Hour),
+			Status:         models.StatusAccepted,
+			Package:        models.Packaging{"box"},
+			Cost:           models.Rubles(300),
+			Items: []models.OrderItem{
+				{SKU: "sku-1", Name: "Кружка", Price: models.Rubles(100), Status: models.ItemAtPoint},
+				{SKU: "sku-2", Name: "Чайник", Price: models.Rubles(200), Status: models.ItemAtPoint},
+			},
+		}
+		event := models.OrderEvent{Type: models.EventOrderAdded, Order: order,
+			Change: models.StatusChange{To: models.StatusAccepted, ChangedAt: time.Now()}}
+
+		orderID, err := db.AddOrder(testCtx, order, event, models.PickupCode{}, nil)
+		require.NoError(t, err)
+
+		stored, err := db.GetOrder(testCtx, orderID)
+		require.NoError(t, err)
+		require.Len(t, stored.Items, 2)
+		assert.NotZero(t, stored.Items[0].ID)
+		assert.Equal(t, orderID, stored.Items[0].OrderID)
+		assert.Equal(t, "Кружка", stored.Items[0].Name)
+		assert.Equal(t, models.Rubles(200), stored.Items[1].Price)
+
+		stored.Status = models.StatusIssued
+		stored.ReceivedByCustomer = true
+		stored.ReceivedTime = time.Now()
+		stored.Items[0].Status = models.ItemIssued
+		stored.Items[1].Status = models.ItemDeclined
+		require.NoError(t, db.ChangeStatus(testCtx, stored, models.OrderEvent{
+			Type:   models.EventOrderReceived,
+			Order:  stored,
+			Change: models.StatusChange{OrderID: orderID, From: models.StatusAccepted, To: models.StatusIssued, ChangedAt: time.Now()},
+		}))
+
+		issued, err := db.GetOrder(testCtx, orderID)
+		require.NoError(t, err)
+		assert.Equal(t, models.ItemIssued, issued.Items[0].Status)
+		assert.Equal(t, models.ItemDeclined, issued.Items[1].Status)
+
+		issued.Items[1].Status = models.ItemReturnedToCourier
+		require.NoError(t, db.ReturnItems(testCtx, models.OrderEvent{
+			Type:   models.EventItemsReturned,
+			Order:  issued,
+			Change: models.StatusChange{OrderID: orderID, From: models.StatusIssued, To: models.StatusIssued, ChangedAt: time.Now()},
+		}, nil))
+
+		returned, err := db.GetOrder(testCtx, orderID)
+		require.NoError(t, err)
+		assert.Equal(t, models.StatusIssued, returned.Status)
+		assert.Equal(t, models.ItemReturnedToCourier, returned.Items[1].Status)
+	})
+}
+
+func TestPostgresDB_GetTimedOutTryOns(t *testing.T) {
+	t.Run("Выбираются только заказы на примерке с истекшим временем", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		add := func(number string, status models.Status, until time.Time) models.ID {
+			order := models.Order{
+				External:       models.ExternalRef{Source: "marketplace", Number: number},
+				CustomerID:     models.ID(7),
+				ExpirationTime: time.Now().Add(time.Hour),
+				Status:         status,
+				Package:        models.Packaging{"box"},
+				TryOnUntil:     until,
+			}
+			event := models.OrderEvent{Type: models.EventOrderAdded, Order: order,
+				Change: models.StatusChange{To: status, ChangedAt: time.Now()}}
+
+			orderID, errAdd := db.AddOrder(testCtx, order, event, models.PickupCode{}, nil)
+			require.NoError(t, errAdd)
+			return orderID
+		}
+
+		now := time.Now()
+		timedOut := add("71", models.StatusTryingOn, now.Add(-time.Minute))
+		add("72", models.StatusTryingOn, now.Add(time.Minute))
+		add("73", models.StatusAccepted, time.Time{})
+
+		ids, err := db.GetTimedOutTryOns(testCtx, now, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []models.ID{timedOut}, ids)
+
+		stored, err := db.GetOrder(testCtx, timedOut)
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(-time.Minute).Unix(), stored.TryOnUntil.Unix())
+	})
+}
+
+func TestPostgresDB_GetExpiredOrders(t *testing.T) {
+	t.Run("Выбираются только ожидающие выдачи заказы с истекшим сроком хранения", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		add := func(number string, status models.Status, expiration time.Time) models.ID {
+			order := models.Order{
+				External:       models.ExternalRef{Source: "marketplace", Number: number},
+				CustomerID:     models.ID(9),
+				ExpirationTime: expiration,
+				Status:         status,
+				Package:        models.Packaging{"box"},
+			}
+			event := models.OrderEvent{Type: models.EventOrderAdded, Order: order,
+				Change: models.StatusChange{To: status, ChangedAt: time.Now()}}
+
+			orderID, errAdd := db.AddOrder(testCtx, order, event, models.PickupCode{}, nil)
+			require.NoError(t, errAdd)
+			return orderID
+		}
+
+		now := time.Now()
+		overdue := add("91", models.StatusAccepted, now.Add(-time.Minute))
+		add("92", models.StatusExpired, now.Add(-time.Minute))
+		add("93", models.StatusIssued, now.Add(-time.Minute))
+		add("94", models.StatusAccepted, now.Add(time.Hour))
+
+		ids, err := db.GetExpiredOrders(testCtx, now, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []models.ID{overdue}, ids)
+	})
+
+	t.Run("Срок хранения по часам пункта не сдвигается при записи", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		_, err = db.SeedPickupPoints(testCtx, []models.PickupPoint{
+			{ID: "test-vladivostok", Name: "test", TimeZone: "Asia/Vladivostok", ClosingTime: "21:00"},
+		})
+		require.NoError(t, err)
+		pointCtx := models.WithPoint(context.Background(), "test-vladivostok")
+
+		loc, err := time.LoadLocation("Asia/Vladivostok")
+		require.NoError(t, err)
+		// 21:00 во Владивостоке - 11:00 UTC.
+		expiration := time.Date(2024, time.September, 9, 21, 0, 0, 0, loc)
+		order := models.Order{
+			External:       models.ExternalRef{Source: "marketplace", Number: "tz-1"},
+			CustomerID:     models.ID(9),
+			ExpirationTime: expiration,
+			Status:         models.StatusAccepted,
+			Package:        models.Packaging{"box"},
+		}
+		event := models.OrderEvent{Type: models.EventOrderAdded, Order: order,
+			Change: models.StatusChange{To: models.StatusAccepted, ChangedAt: time.Now()}}
+
+		orderID, err := db.AddOrder(pointCtx, order, event, models.PickupCode{}, nil)
+		require.NoError(t, err)
+
+		stored, err := db.GetOrder(pointCtx, orderID)
+		require.NoError(t, err)
+		assert.True(t, expiration.Equal(stored.ExpirationTime), "stored %s", stored.ExpirationTime)
+
+		ids, err := db.GetExpiredOrders(pointCtx, expiration.UTC().Add(time.Hour), 10)
+		require.NoError(t, err)
+		assert.Equal(t, []models.ID{orderID}, ids)
+
+		ids, err = db.GetExpiredOrders(pointCtx, expiration.UTC().Add(-time.Hour), 10)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+}
+
+func TestPostgresDB_PickupPoints(t *testing.T) {
+	t.Run("Заказы пункта не видны из другого пункта", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		_, err = db.SeedPickupPoints(testCtx, []models.PickupPoint{
+			{ID: "test-2", Name: "test", TimeZone: "Asia/Yekaterinburg", ClosingTime: "20:00", Operators: []models.Operator{"test-operator"}},
+		})
+		require.NoError(t, err)
+
+		point, err := db.GetOperatorPoint(testCtx, "test-operator")
+		require.NoError(t, err)
+		assert.Equal(t, models.PointID("test-2"), point)
+
+		stored, err := db.GetPickupPoint(testCtx, "test-2")
+		require.NoError(t, err)
+		assert.Equal(t, "Asia/Yekaterinburg", stored.TimeZone)
+		assert.Equal(t, []models.Operator{"test-operator"}, stored.Operators)
+
+		otherCtx := models.WithPoint(context.Background(), "test-2")
+		_, err = db.GetOrder(otherCtx, models.ID(1))
+		assert.ErrorIs(t, err, ErrOrderNotFound)
+
+		orders, err := db.GetCustomersOrders(otherCtx, models.OrdersQuery{CustomerID: models.ID(1), Sort: models.SortByExpiration})
+		require.NoError(t, err)
+		assert.Empty(t, orders)
+
+		order, err := db.GetOrder(testCtx, models.ID(1))
+		require.NoError(t, err)
+		assert.Equal(t, models.DefaultPoint, order.PointID)
+	})
+
+	t.Run("Один внешний номер принимается в разных пунктах", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		_, err = db.SeedPickupPoints(testCtx, []models.PickupPoint{
+			{ID: "test-external", Name: "test", TimeZone: "Europe/Moscow", ClosingTime: "21:00"},
+		})
+		require.NoError(t, err)
+
+		order := models.Order{
+			External:       models.ExternalRef{Source: "marketplace", Number: "shared-1"},
+			CustomerID:     models.ID(3),
+			ExpirationTime: time.Now().Add(time.Hour),
+			Package:        models.Packaging{"box"},
+			Cost:           models.Rubles(100),
+			PackageCost:    models.Rubles(10),
+		}
+		event := models.OrderEvent{Type: models.EventOrderAdded, Order: order,
+			Change: models.StatusChange{To: models.StatusAccepted, ChangedAt: time.Now()}}
+		otherCtx := models.WithPoint(context.Background(), "test-external")
+
+		first, err := db.AddOrder(testCtx, order, event, models.PickupCode{}, nil)
+		require.NoError(t, err)
+		second, err := db.AddOrder(otherCtx, order, event, models.PickupCode{}, nil)
+		require.NoError(t, err)
+		assert.NotEqual(t, first, second)
+
+		resolved, err := db.ResolveOrderID(otherCtx, order.External)
+		require.NoError(t, err)
+		assert.Equal(t, second, resolved)
+
+		_, err = db.AddOrder(otherCtx, order, event, models.PickupCode{}, nil)
+		assert.ErrorIs(t, err, ErrOrderExists)
+	})
+
+	t.Run("Запрос без пункта не выполняется", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		_, err = db.GetOrder(context.Background(), models.ID(1))
+		assert.ErrorIs(t, err, ErrNoPoint)
+	})
+
+	t.Run("Сотрудник без пункта", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		_, err = db.GetOperatorPoint(testCtx, "nobody")
+		assert.ErrorIs(t, err, ErrOperatorNotAssigned)
+	})
+}
+
+func TestPostgresDB_Cells(t *testing.T) {
+	t.Run("Ячейка назначается при приеме и освобождается при выдаче", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		_, err = db.SeedPickupPoints(testCtx, []models.PickupPoint{
+			{ID: "test-cells", Name: "test", TimeZone: "Europe/Moscow", Cells: []models.Cell{
+				{Code: "A-01-01", Zone: "A", Rack: 1, Shelf: 1, Capacity: 1, MaxWeight: 10},
+				{Code: "L-01-01", Zone: "L", Rack: 1, Shelf: 1, Capacity: 2},
+			}},
+		})
+		require.NoError(t, err)
+		ctx := models.WithPoint(context.Background(), "test-cells")
+
+		add := func(number string, place func(cells []models.CellLoad) (models.CellCode, error)) models.ID {
+			order := models.Order{
+				External:       models.ExternalRef{Source: "marketplace", Number: number},
+				CustomerID:     models.ID(8),
+				ExpirationTime: time.Now().Add(time.Hour),
+				Status:         models.StatusAccepted,
+				Package:        models.Packaging{"box"},
+			}
+			event := models.OrderEvent{Type: models.EventOrderAdded, Order: order,
+				Change: models.StatusChange{To: models.StatusAccepted, ChangedAt: time.Now()}}
+
+			orderID, errAdd := db.AddOrder(ctx, order, event, models.PickupCode{}, place)
+			require.NoError(t, errAdd)
+			return orderID
+		}
+
+		first := add("81", func(cells []models.CellLoad) (models.CellCode, error) {
+			require.Len(t, cells, 2)
+			assert.Equal(t, models.CellCode("A-01-01"), cells[0].Code)
+			assert.Equal(t, 1, cells[0].Capacity)
+			assert.Equal(t, models.Kilo(10), cells[0].MaxWeight)
+			assert.Zero(t, cells[0].Occupied)
+			return cells[0].Code, nil
+		})
+		second := add("82", func(cells []models.CellLoad) (models.CellCode, error) {
+			assert.Equal(t, 1, cells[0].Occupied)
+			assert.True(t, cells[0].HasCustomer)
+			assert.Zero(t, cells[1].Occupied)
+			return cells[1].Code, nil
+		})
+
+		stored, err := db.GetOrder(ctx, first)
+		require.NoError(t, err)
+		assert.Equal(t, models.CellCode("A-01-01"), stored.Cell)
+
+		moved, err := db.MoveOrder(ctx, second, func(order models.Order, cells []models.CellLoad) (models.OrderEvent, error) {
+			assert.Equal(t, models.CellCode("L-01-01"), order.Cell)
+			assert.Equal(t, 1, cells[1].Occupied)
+			order.Cell = "A-01-01"
+			return models.OrderEvent{Type: models.EventOrderMoved, Order: order,
+				Change: models.StatusChange{OrderID: order.OrderID, From: order.Status, To: order.Status, ChangedAt: time.Now()}}, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, models.CellCode("A-01-01"), moved.Cell)
+
+		_, err = db.MoveOrder(ctx, second, func(order models.Order, cells []models.CellLoad) (models.OrderEvent, error) {
+			order.Cell = "Z-01-01"
+			return models.OrderEvent{Type: models.EventOrderMoved, Order: order,
+				Change: models.StatusChange{OrderID: order.OrderID, From: order.Status, To: order.Status, ChangedAt: time.Now()}}, nil
+		})
+		assert.ErrorIs(t, err, ErrCellNotFound)
+
+		stored.Status = models.StatusIssued
+		stored.Cell = ""
+		err = db.ChangeStatus(ctx, stored, models.OrderEvent{Type: models.EventOrderReceived, Order: stored,
+			Change: models.StatusChange{OrderID: first, From: models.StatusAccepted, To: models.StatusIssued, ChangedAt: time.Now()}})
+		require.NoError(t, err)
+
+		add("83", func(cells []models.CellLoad) (models.CellCode, error) {
+			assert.Equal(t, 1, cells[0].Occupied, "issued order frees its cell")
+			assert.Zero(t, cells[1].Occupied)
+			return cells[1].Code, nil
+		})
+	})
+
+	t.Run("Параллельный прием не переполняет ячейку", func(t *testing.T) {
+		t.Parallel()
+
+		connURL, err := getConnUrl(path)
+		require.NoError(t, err)
+		setupDB(t, connURL)
+		db, err := NewStorage(testCtx, connURL)
+		require.NoError(t, err)
+
+		_, err = db.SeedPickupPoints(testCtx, []models.PickupPoint{
+			{ID: "test-cells-race", Name: "test", TimeZone: "Europe/Moscow", Cells: []models.Cell{
+				{Code: "A-01-01", Zone: "A", Rack: 1, Shelf: 1, Capacity: 1},
+				{Code: "L-01-01", Zone: "L", Rack: 1, Shelf: 1, Capacity: 1},
+			}},
+		})
+		require.NoError(t, err)
+		ctx := models.WithPoint(context.Background(), "test-cells-race")
+
+		// Обе транзакции видят обе ячейки свободными и выбирают первую: вторая получает ошибку сериализации,
+		// повторяется и кладет заказ во вторую ячейку.
+		var seen sync.WaitGroup
+		seen.Add(2)
+		add := func(number string, customerId models.ID) (models.ID, error) {
+			order := models.Order{
+				External:       models.ExternalRef{Source: "marketplace", Number: number},
+				CustomerID:     customerId,
+				ExpirationTime: time.Now().Add(time.Hour),
+				Status:         models.StatusAccepted,
+			}
+			event := models.OrderEvent{Type: models.EventOrderAdded, Order: order,
+				Change: models.StatusChange{To: models.StatusAccepted, ChangedAt: time.Now()}}
+
+			attempts := 0
+			return db.AddOrder(ctx, order, event, models.PickupCode{}, func(cells []models.CellLoad) (models.CellCode, error) {
+				attempts++
+				if attempts == 1 {
+					seen.Done()
+					seen.Wait()
+				}
+				for _, cell := range cells {
+					if cell.Occupied < cell.Capacity {
+						return cell.Code, nil
+					}
+				}
+				return "", fmt.Errorf("no free cell for order %s", number)
+			})
+		}
+
+		ids := make([]models.ID, 2)
+		errs := make([]error, 2)
+		var done sync.WaitGroup
+		for i, number := range []string{"91", "92"} {
+			done.Add(1)
+			go func(i int, number string) {
+				defer done.Done()
+				ids[i], errs[i] = add(number, models.ID(90+i))
+			}(i, number)
+		}
+		done.Wait()
+		require.NoError(t, errs[0])
+		require.NoError(t, errs[1])
+
+		first, err := db.GetOrder(ctx, ids[0])
+		require.NoError(t, err)
+		second, err := db.GetOrder(ctx, ids[1])
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []models.CellCode{"A-01-01", "L-01-01"}, []models.CellCode{first.Cell, second.Cell})
+	})
+}
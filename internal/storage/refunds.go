@@ -0,0 +1,362 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/opentracing/opentracing-go"
+	"homework-1/internal/models"
+	"homework-1/internal/storage/schema"
+)
+
+var (
+	ErrRefundNotFound = errors.New("refund not found")
+	ErrRefundExists   = errors.New("order already has a refund under review")
+)
+
+var (
+	refundColumns = []string{
+		"refund_id", "order_id", "customer_id",
+		"reason", "comment", "state",
+		"amount_minor", "currency", "item_ids", "created_at", "updated_at"}
+	refundTable = "refunds"
+
+	refundHistoryColumns = []string{
+		"refund_id", "state_from", "state_to",
+		"comment", "operator", "changed_at"}
+	refundHistoryTable = "refund_history"
+)
+
+// CreateRefund Сохраняет возврат, первую запись его истории и переход заказа (event.Order) в одной транзакции.
+// У заказа может быть только один рассматриваемый возврат, повторная заявка до решения возвращает ErrRefundExists.
+func (s *PostgresDB) CreateRefund(ctx context.Context, refund models.Refund, change models.RefundChange, event models.OrderEvent) (models.Refund, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.CreateRefund")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return models.Refund{}, fmt.Errorf("storage.CreateRefund error: %w", errScope)
+	}
+
+	refundRecord := schema.TransformRefund(refund)
+
+	f := func(ctxTX context.Context) error {
+		queryEngine := s.tr.GetQueryEngine(ctxTX)
+
+		if errUpdate := s.updateOrder(ctxTX, event.Order); errUpdate != nil {
+			return errUpdate
+		}
+
+		sql, args, errSql := sq.
+			Insert(refundTable).
+			Columns(refundColumns[1:]...).
+			Columns("point_id").
+			Values(refundRecord.OrderID, refundRecord.CustomerID,
+				refundRecord.Reason, refundRecord.Comment, refundRecord.State,
+				refundRecord.AmountMinor, refundRecord.Currency, refundRecord.ItemIDs, refundRecord.CreatedAt, refundRecord.UpdatedAt,
+				string(point)).
+			Suffix("RETURNING refund_id").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if errSql != nil {
+			return fmt.Errorf("storage.CreateRefund error: %w", errSql)
+		}
+
+		if errScan := queryEngine.QueryRow(ctxTX, sql, args...).Scan(&refund.ID); errScan != nil {
+			var errPg *pgconn.PgError
+			if errors.As(errScan, &errPg) && errPg.Code == uniqueViolationCode {
+				return fmt.Errorf("storage.CreateRefund error: %w", ErrRefundExists)
+			}
+			return fmt.Errorf("storage.CreateRefund error: %w", errScan)
+		}
+
+		change.RefundID = refund.ID
+		if errHistory := s.addRefundChange(ctxTX, change); errHistory != nil {
+			return errHistory
+		}
+
+		return s.addEvent(ctxTX, event)
+	}
+
+	if err := s.tr.RunRepeatableRead(ctx, f); err != nil {
+		return models.Refund{}, fmt.Errorf("storage.CreateRefund error: %w", err)
+	}
+
+	return refund, nil
+}
+
+func (s *PostgresDB) GetRefund(ctx context.Context, refundId models.ID) (models.Refund, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetRefund")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return models.Refund{}, fmt.Errorf("storage.GetRefund error: %w", errScope)
+	}
+
+	sql, args, errSql := sq.
+		Select(refundColumns...).
+		From(refundTable).
+		Where(sq.Eq{"point_id": point, "refund_id": refundId}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return models.Refund{}, fmt.Errorf("storage.GetRefund error: %w", errSql)
+	}
+
+	var refundRecord schema.RefundRecord
+	if errScan := scanRefund(s.tr.GetQueryEngine(ctx).QueryRow(ctx, sql, args...), &refundRecord); errScan != nil {
+		if errors.Is(errScan, pgx.ErrNoRows) {
+			return models.Refund{}, fmt.Errorf("storage.GetRefund error: %w", ErrRefundNotFound)
+		}
+		return models.Refund{}, fmt.Errorf("storage.GetRefund error: %w", errScan)
+	}
+
+	return refundRecord.ToDomain(), nil
+}
+
+// GetOrderRefunds Возвращает возвраты заказа на этапе state в порядке оформления.
+func (s *PostgresDB) GetOrderRefunds(ctx context.Context, orderId models.ID, state models.RefundState) ([]models.Refund, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetOrderRefunds")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return nil, fmt.Errorf("storage.GetOrderRefunds error: %w", errScope)
+	}
+
+	sql, args, errSql := sq.
+		Select(refundColumns...).
+		From(refundTable).
+		Where(sq.Eq{"point_id": point, "order_id": orderId, "state": state}).
+		OrderBy("refund_id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return nil, fmt.Errorf("storage.GetOrderRefunds error: %w", errSql)
+	}
+
+	rows, errQuery := s.tr.GetQueryEngine(ctx).Query(ctx, sql, args...)
+	if errQuery != nil {
+		return nil, fmt.Errorf("storage.GetOrderRefunds error: %w", errQuery)
+	}
+	defer rows.Close()
+
+	var refunds []models.Refund
+	for rows.Next() {
+		var refundRecord schema.RefundRecord
+		if errScan := scanRefund(rows, &refundRecord); errScan != nil {
+			return nil, fmt.Errorf("storage.GetOrderRefunds error: %w", errScan)
+		}
+		refunds = append(refunds, refundRecord.ToDomain())
+	}
+	if errRows := rows.Err(); errRows != nil {
+		return nil, fmt.Errorf("storage.GetOrderRefunds error: %w", errRows)
+	}
+
+	return refunds, nil
+}
+
+// GetRefundHistory Возвращает переходы возврата в хронологическом порядке вместе с комментариями сотрудников.
+// История возврата другого пункта не возвращается.
+func (s *PostgresDB) GetRefundHistory(ctx context.Context, refundId models.ID) ([]models.RefundChange, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.GetRefundHistory")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return nil, fmt.Errorf("storage.GetRefundHistory error: %w", errScope)
+	}
+
+	pointRefund := sq.
+		Select("1").
+		From(refundTable).
+		Where(sq.Eq{"point_id": point, "refund_id": refundId})
+
+	sql, args, errSql := sq.
+		Select(refundHistoryColumns...).
+		From(refundHistoryTable).
+		Where(sq.Eq{"refund_id": refundId}).
+		Where(sq.Expr("EXISTS (?)", pointRefund)).
+		OrderBy("changed_at", "id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return nil, fmt.Errorf("storage.GetRefundHistory error: %w", errSql)
+	}
+
+	rows, errQuery := s.tr.GetQueryEngine(ctx).Query(ctx, sql, args...)
+	if errQuery != nil {
+		return nil, fmt.Errorf("storage.GetRefundHistory error: %w", errQuery)
+	}
+	defer rows.Close()
+
+	var history []models.RefundChange
+	for rows.Next() {
+		var changeRecord schema.RefundChangeRecord
+		if errScan := rows.Scan(&changeRecord.RefundID, &changeRecord.StateFrom, &changeRecord.StateTo,
+			&changeRecord.Comment, &changeRecord.Operator, &changeRecord.ChangedAt); errScan != nil {
+			return nil, fmt.Errorf("storage.GetRefundHistory error: %w", errScan)
+		}
+		history = append(history, changeRecord.ToDomain())
+	}
+
+	return history, nil
+}
+
+// ChangeRefund Блокирует возврат и его заказ через SELECT ... FOR UPDATE и передает их в change.
+// Переход возврата сохраняется вместе с событиями заказа (event.Order) в одной транзакции.
+// Возврат блокируется раньше заказа, как и в ReturnOrder, чтобы не было взаимной блокировки.
+func (s *PostgresDB) ChangeRefund(ctx context.Context, refundId models.ID,
+	change func(refund models.Refund, order models.Order) (models.Refund, models.RefundChange, []models.OrderEvent, error)) (models.Refund, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "storage.PostgresDB.ChangeRefund")
+	defer span.Finish()
+
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return models.Refund{}, fmt.Errorf("storage.ChangeRefund error: %w", errScope)
+	}
+
+	var changed models.Refund
+
+	f := func(ctxTX context.Context) error {
+		queryEngine := s.tr.GetQueryEngine(ctxTX)
+
+		refundSql, refundArgs, errSql := sq.
+			Select(refundColumns...).
+			From(refundTable).
+			Where(sq.Eq{"point_id": point, "refund_id": refundId}).
+			Suffix("FOR UPDATE").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if errSql != nil {
+			return fmt.Errorf("storage.ChangeRefund error: %w", errSql)
+		}
+
+		var refundRecord schema.RefundRecord
+		if errScan := scanRefund(queryEngine.QueryRow(ctxTX, refundSql, refundArgs...), &refundRecord); errScan != nil {
+			if errors.Is(errScan, pgx.ErrNoRows) {
+				return fmt.Errorf("storage.ChangeRefund error: %w", ErrRefundNotFound)
+			}
+			return fmt.Errorf("storage.ChangeRefund error: %w", errScan)
+		}
+		refund := refundRecord.ToDomain()
+
+		orderSql, orderArgs, errSql := sq.
+			Select(orderColumns...).
+			From(orderTable).
+			Where(sq.Eq{"point_id": point, "order_id": refund.OrderID}).
+			Suffix("FOR UPDATE").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if errSql != nil {
+			return fmt.Errorf("storage.ChangeRefund error: %w", errSql)
+		}
+
+		var ordRecord schema.OrderRecord
+		if errScan := scanOrder(queryEngine.QueryRow(ctxTX, orderSql, orderArgs...), &ordRecord); errScan != nil {
+			if errors.Is(errScan, pgx.ErrNoRows) {
+				return fmt.Errorf("storage.ChangeRefund error: %w", ErrOrderNotFound)
+			}
+			return fmt.Errorf("storage.ChangeRefund error: %w", errScan)
+		}
+		orders := []models.Order{ordRecord.ToDomain()}
+		if errItems := s.loadItems(ctxTX, orders); errItems != nil {
+			return errItems
+		}
+
+		var (
+			refundChange models.RefundChange
+			events       []models.OrderEvent
+			errChange    error
+		)
+		changed, refundChange, events, errChange = change(refund, orders[0])
+		if errChange != nil {
+			return errChange
+		}
+
+		if errRefund := s.changeRefundState(ctxTX, refundChange); errRefund != nil {
+			return errRefund
+		}
+
+		for _, event := range events {
+			if errUpdate := s.updateOrder(ctxTX, event.Order); errUpdate != nil {
+				return errUpdate
+			}
+			if errEvent := s.addEvent(ctxTX, event); errEvent != nil {
+				return errEvent
+			}
+		}
+
+		return nil
+	}
+
+	if err := s.tr.RunRepeatableRead(ctx, f); err != nil {
+		return models.Refund{}, fmt.Errorf("storage.ChangeRefund error: %w", err)
+	}
+
+	return changed, nil
+}
+
+// scanRefund Читает строку, выбранную по refundColumns.
+func scanRefund(row pgx.Row, refundRecord *schema.RefundRecord) error {
+	return row.Scan(&refundRecord.RefundID, &refundRecord.OrderID, &refundRecord.CustomerID,
+		&refundRecord.Reason, &refundRecord.Comment, &refundRecord.State,
+		&refundRecord.AmountMinor, &refundRecord.Currency, &refundRecord.ItemIDs, &refundRecord.CreatedAt, &refundRecord.UpdatedAt)
+}
+
+// changeRefundState Переводит возврат на новый этап по записи истории change и сохраняет ее.
+func (s *PostgresDB) changeRefundState(ctx context.Context, change models.RefundChange) error {
+	point, errScope := scope(ctx)
+	if errScope != nil {
+		return fmt.Errorf("storage.changeRefundState error: %w", errScope)
+	}
+
+	queryEngine := s.tr.GetQueryEngine(ctx)
+
+	sql, args, errSql := sq.
+		Update(refundTable).
+		Set("state", change.To).
+		Set("updated_at", change.ChangedAt).
+		Where(sq.Eq{"point_id": point, "refund_id": change.RefundID, "state": change.From}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return fmt.Errorf("storage.changeRefundState error: %w", errSql)
+	}
+
+	tag, errExec := queryEngine.Exec(ctx, sql, args...)
+	if errExec != nil {
+		return fmt.Errorf("storage.changeRefundState error: %w", errExec)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("storage.changeRefundState error: %w", ErrRefundNotFound)
+	}
+
+	return s.addRefundChange(ctx, change)
+}
+
+func (s *PostgresDB) addRefundChange(ctx context.Context, change models.RefundChange) error {
+	queryEngine := s.tr.GetQueryEngine(ctx)
+	changeRecord := schema.TransformRefundChange(change)
+
+	sql, args, errSql := sq.
+		Insert(refundHistoryTable).
+		Columns(refundHistoryColumns...).
+		Values(changeRecord.RefundID, changeRecord.StateFrom, changeRecord.StateTo,
+			changeRecord.Comment, changeRecord.Operator, changeRecord.ChangedAt).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if errSql != nil {
+		return fmt.Errorf("storage.addRefundChange error: %w", errSql)
+	}
+
+	if _, errExec := queryEngine.Exec(ctx, sql, args...); errExec != nil {
+		return fmt.Errorf("storage.addRefundChange error: %w", errExec)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package schema
+
+import "homework-1/internal/models"
+
+type CellRecord struct {
+	PointID   string `db:"point_id"`
+	Code      string `db:"code"`
+	Zone      string `db:"zone"`
+	Rack      int    `db:"rack"`
+	Shelf     int    `db:"shelf"`
+	Capacity  int    `db:"capacity"`
+	MaxWeight kilo   `db:"max_weight"`
+	LengthCm  int32  `db:"length_cm"`
+	WidthCm   int32  `db:"width_cm"`
+	HeightCm  int32  `db:"height_cm"`
+}
+
+// CellLoadRecord Ячейка вместе с числом заказов в ней.
+type CellLoadRecord struct {
+	CellRecord
+	Occupied    int  `db:"occupied"`
+	HasCustomer bool `db:"has_customer"`
+}
+
+func (r CellRecord) ToDomain() models.Cell {
+	return models.Cell{
+		Code:      models.CellCode(r.Code),
+		Zone:      r.Zone,
+		Rack:      r.Rack,
+		Shelf:     r.Shelf,
+		Capacity:  r.Capacity,
+		MaxWeight: models.Kilo(r.MaxWeight),
+		Dimensions: models.Dimensions{
+			Length: models.Centimeter(r.LengthCm),
+			Width:  models.Centimeter(r.WidthCm),
+			Height: models.Centimeter(r.HeightCm),
+		},
+	}
+}
+
+func (r CellLoadRecord) ToDomain() models.CellLoad {
+	return models.CellLoad{
+		Cell:        r.CellRecord.ToDomain(),
+		Occupied:    r.Occupied,
+		HasCustomer: r.HasCustomer,
+	}
+}
+
+func TransformCell(point models.PointID, cell models.Cell) CellRecord {
+	return CellRecord{
+		PointID:   string(point),
+		Code:      string(cell.Code),
+		Zone:      cell.Zone,
+		Rack:      cell.Rack,
+		Shelf:     cell.Shelf,
+		Capacity:  cell.Capacity,
+		MaxWeight: kilo(cell.MaxWeight),
+		LengthCm:  int32(cell.Dimensions.Length),
+		WidthCm:   int32(cell.Dimensions.Width),
+		HeightCm:  int32(cell.Dimensions.Height),
+	}
+}
@@ -0,0 +1,36 @@
+package schema
+
+import "homework-1/internal/models"
+
+type OrderItemRecord struct {
+	ItemID     id     `db:"item_id"`
+	OrderID    id     `db:"order_id"`
+	SKU        string `db:"sku"`
+	Name       string `db:"name"`
+	PriceMinor int64  `db:"price_minor"`
+	Currency   string `db:"currency"`
+	Status     string `db:"status"`
+}
+
+func (i OrderItemRecord) ToDomain() models.OrderItem {
+	return models.OrderItem{
+		ID:      models.ID(i.ItemID),
+		OrderID: models.ID(i.OrderID),
+		SKU:     i.SKU,
+		Name:    i.Name,
+		Price:   models.NewMoney(i.PriceMinor, models.Currency(i.Currency)),
+		Status:  models.ItemStatus(i.Status),
+	}
+}
+
+func TransformOrderItem(item models.OrderItem) OrderItemRecord {
+	return OrderItemRecord{
+		ItemID:     id(item.ID),
+		OrderID:    id(item.OrderID),
+		SKU:        item.SKU,
+		Name:       item.Name,
+		PriceMinor: item.Price.Amount,
+		Currency:   string(item.Price.Currency),
+		Status:     string(item.Status),
+	}
+}
@@ -6,49 +6,92 @@ import (
 )
 
 type id int64
-type rub int64
 type kilo float32
 type packageType string
+type status string
 
 type OrderRecord struct {
-	OrderID            id          `db:"order_id"`
-	CustomerID         id          `db:"customer_id"`
-	ExpirationTime     time.Time   `db:"expiration_time"`
-	ReceivedTime       time.Time   `db:"received_time"`
-	ReceivedByCustomer bool        `db:"received_by_customer"`
-	Refunded           bool        `db:"refunded"`
-	Package            packageType `db:"package"`
-	Weight             kilo        `db:"weight"`
-	Cost               rub         `db:"cost"`
-	PackageCost        rub         `db:"package_cost"`
+	OrderID            id        `db:"order_id"`
+	ExternalSource     string    `db:"external_source"`
+	ExternalNumber     string    `db:"external_number"`
+	CustomerID         id        `db:"customer_id"`
+	ExpirationTime     time.Time `db:"expiration_time"`
+	ReceivedTime       time.Time `db:"received_time"`
+	ReceivedByCustomer bool      `db:"received_by_customer"`
+	Refunded           bool      `db:"refunded"`
+	RefundedTime       time.Time `db:"refunded_time"`
+	Status             status    `db:"status"`
+	Package            []string  `db:"package"`
+	Weight             kilo      `db:"weight"`
+	CostMinor          int64     `db:"cost_minor"`
+	PackageCostMinor   int64     `db:"package_cost_minor"`
+	Currency           string    `db:"currency"`
+	TryOnUntil         time.Time `db:"try_on_until"`
+	PointID            string    `db:"point_id"`
+	Cell               string    `db:"cell"`
 }
 
 func (o OrderRecord) ToDomain() models.Order {
 	return models.Order{
 		OrderID:            models.ID(o.OrderID),
+		External:           models.ExternalRef{Source: o.ExternalSource, Number: o.ExternalNumber},
 		CustomerID:         models.ID(o.CustomerID),
 		ExpirationTime:     o.ExpirationTime,
 		ReceivedTime:       o.ReceivedTime,
 		ReceivedByCustomer: o.ReceivedByCustomer,
 		Refunded:           o.Refunded,
-		Package:            models.PackageType(o.Package),
+		RefundedTime:       o.RefundedTime,
+		Status:             models.Status(o.Status),
+		Package:            packagingFromRecord(o.Package),
 		Weight:             models.Kilo(o.Weight),
-		Cost:               models.Rub(o.Cost),
-		PackageCost:        models.Rub(o.PackageCost),
+		Cost:               models.NewMoney(o.CostMinor, models.Currency(o.Currency)),
+		PackageCost:        models.NewMoney(o.PackageCostMinor, models.Currency(o.Currency)),
+		TryOnUntil:         o.TryOnUntil,
+		PointID:            models.PointID(o.PointID),
+		Cell:               models.CellCode(o.Cell),
 	}
 }
 
+// Transform Стоимость заказа и упаковки хранится в одной валюте: модуль не принимает заказ, если валюты различаются.
 func Transform(orderModel models.Order) OrderRecord {
 	return OrderRecord{
 		OrderID:            id(orderModel.OrderID),
+		ExternalSource:     orderModel.External.Source,
+		ExternalNumber:     orderModel.External.Number,
 		CustomerID:         id(orderModel.CustomerID),
 		ExpirationTime:     orderModel.ExpirationTime,
 		ReceivedTime:       orderModel.ReceivedTime,
 		ReceivedByCustomer: orderModel.ReceivedByCustomer,
 		Refunded:           orderModel.Refunded,
-		Package:            packageType(orderModel.Package),
+		RefundedTime:       orderModel.RefundedTime,
+		Status:             status(orderModel.Status),
+		Package:            packagingToRecord(orderModel.Package),
 		Weight:             kilo(orderModel.Weight),
-		Cost:               rub(orderModel.Cost),
-		PackageCost:        rub(orderModel.PackageCost),
+		CostMinor:          orderModel.Cost.Amount,
+		PackageCostMinor:   orderModel.PackageCost.Amount,
+		Currency:           string(orderModel.Cost.Currency),
+		TryOnUntil:         orderModel.TryOnUntil,
+		PointID:            string(orderModel.PointID),
+		Cell:               string(orderModel.Cell),
 	}
 }
+
+func packagingFromRecord(layers []string) models.Packaging {
+	if len(layers) == 0 {
+		return nil
+	}
+	packaging := make(models.Packaging, 0, len(layers))
+	for _, layer := range layers {
+		packaging = append(packaging, models.PackageType(layer))
+	}
+	return packaging
+}
+
+// packagingToRecord Заказ без упаковки хранится пустым массивом, а не NULL.
+func packagingToRecord(packaging models.Packaging) []string {
+	layers := make([]string, 0, len(packaging))
+	for _, layer := range packaging {
+		layers = append(layers, string(layer))
+	}
+	return layers
+}
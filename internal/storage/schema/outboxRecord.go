@@ -0,0 +1,233 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"homework-1/internal/models"
+	"time"
+)
+
+// outboxPayloadVersion Версия формата payload. В событиях без версии, записанных до ее появления, лежит
+// доменная models.OrderEvent без json-тегов, такие события читаются прежним способом.
+const outboxPayloadVersion = 1
+
+type OutboxRecord struct {
+	ID          int64      `db:"id"`
+	EventType   string     `db:"event_type"`
+	OrderID     id         `db:"order_id"`
+	Payload     []byte     `db:"payload"`
+	CreatedAt   time.Time  `db:"created_at"`
+	PublishedAt *time.Time `db:"published_at"`
+}
+
+// outboxPayload Формат события в outbox. Не зависит от доменных структур: переименование поля в models
+// не ломает чтение событий, записанных раньше. Несовместимое изменение формата требует новой версии.
+type outboxPayload struct {
+	Version    int          `json:"version"`
+	Type       string       `json:"type"`
+	Order      outboxOrder  `json:"order"`
+	Change     outboxChange `json:"change"`
+	PickupCode string       `json:"pickupCode,omitempty"`
+}
+
+type outboxOrder struct {
+	OrderID            int64        `json:"orderId"`
+	ExternalSource     string       `json:"externalSource"`
+	ExternalNumber     string       `json:"externalNumber"`
+	CustomerID         int64        `json:"customerId"`
+	ExpirationTime     time.Time    `json:"expirationTime"`
+	ReceivedTime       time.Time    `json:"receivedTime"`
+	ReceivedByCustomer bool         `json:"receivedByCustomer"`
+	Refunded           bool         `json:"refunded"`
+	RefundedTime       time.Time    `json:"refundedTime"`
+	Status             string       `json:"status"`
+	Package            []string     `json:"package"`
+	Weight             float32      `json:"weight"`
+	Cost               outboxMoney  `json:"cost"`
+	PackageCost        outboxMoney  `json:"packageCost"`
+	Items              []outboxItem `json:"items,omitempty"`
+	TryOnUntil         time.Time    `json:"tryOnUntil"`
+	PointID            string       `json:"pointId"`
+	Cell               string       `json:"cell,omitempty"`
+}
+
+type outboxMoney struct {
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+type outboxItem struct {
+	ID      int64       `json:"id"`
+	OrderID int64       `json:"orderId"`
+	SKU     string      `json:"sku"`
+	Name    string      `json:"name"`
+	Price   outboxMoney `json:"price"`
+	Status  string      `json:"status"`
+}
+
+type outboxChange struct {
+	OrderID   int64     `json:"orderId"`
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Reason    string    `json:"reason"`
+	Operator  string    `json:"operator"`
+	ChangedAt time.Time `json:"changedAt"`
+}
+
+func (r OutboxRecord) ToDomain() (models.OrderEvent, error) {
+	var version struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(r.Payload, &version); err != nil {
+		return models.OrderEvent{}, fmt.Errorf("schema.OutboxRecord.ToDomain error: %w", err)
+	}
+
+	var event models.OrderEvent
+	switch version.Version {
+	case 0:
+		if err := json.Unmarshal(r.Payload, &event); err != nil {
+			return models.OrderEvent{}, fmt.Errorf("schema.OutboxRecord.ToDomain error: %w", err)
+		}
+	case outboxPayloadVersion:
+		var payload outboxPayload
+		if err := json.Unmarshal(r.Payload, &payload); err != nil {
+			return models.OrderEvent{}, fmt.Errorf("schema.OutboxRecord.ToDomain error: %w", err)
+		}
+		event = payload.toDomain()
+	default:
+		return models.OrderEvent{}, fmt.Errorf("schema.OutboxRecord.ToDomain error: unknown payload version %d", version.Version)
+	}
+	event.ID = r.ID
+
+	return event, nil
+}
+
+func TransformEvent(event models.OrderEvent) (OutboxRecord, error) {
+	payload, err := json.Marshal(transformPayload(event))
+	if err != nil {
+		return OutboxRecord{}, fmt.Errorf("schema.TransformEvent error: %w", err)
+	}
+
+	return OutboxRecord{
+		EventType: string(event.Type),
+		OrderID:   id(event.Order.OrderID),
+		Payload:   payload,
+		CreatedAt: event.Change.ChangedAt,
+	}, nil
+}
+
+func transformPayload(event models.OrderEvent) outboxPayload {
+	order := event.Order
+
+	pack := make([]string, 0, len(order.Package))
+	for _, layer := range order.Package {
+		pack = append(pack, string(layer))
+	}
+
+	var items []outboxItem
+	for _, item := range order.Items {
+		items = append(items, outboxItem{
+			ID:      int64(item.ID),
+			OrderID: int64(item.OrderID),
+			SKU:     item.SKU,
+			Name:    item.Name,
+			Price:   transformMoney(item.Price),
+			Status:  string(item.Status),
+		})
+	}
+
+	return outboxPayload{
+		Version: outboxPayloadVersion,
+		Type:    string(event.Type),
+		Order: outboxOrder{
+			OrderID:            int64(order.OrderID),
+			ExternalSource:     order.External.Source,
+			ExternalNumber:     order.External.Number,
+			CustomerID:         int64(order.CustomerID),
+			ExpirationTime:     order.ExpirationTime,
+			ReceivedTime:       order.ReceivedTime,
+			ReceivedByCustomer: order.ReceivedByCustomer,
+			Refunded:           order.Refunded,
+			RefundedTime:       order.RefundedTime,
+			Status:             string(order.Status),
+			Package:            pack,
+			Weight:             float32(order.Weight),
+			Cost:               transformMoney(order.Cost),
+			PackageCost:        transformMoney(order.PackageCost),
+			Items:              items,
+			TryOnUntil:         order.TryOnUntil,
+			PointID:            string(order.PointID),
+			Cell:               string(order.Cell),
+		},
+		Change: outboxChange{
+			OrderID:   int64(event.Change.OrderID),
+			From:      string(event.Change.From),
+			To:        string(event.Change.To),
+			Reason:    event.Change.Reason,
+			Operator:  string(event.Change.Operator),
+			ChangedAt: event.Change.ChangedAt,
+		},
+		PickupCode: event.PickupCode,
+	}
+}
+
+func (p outboxPayload) toDomain() models.OrderEvent {
+	order := p.Order
+
+	pack := make(models.Packaging, 0, len(order.Package))
+	for _, layer := range order.Package {
+		pack = append(pack, models.PackageType(layer))
+	}
+
+	var items []models.OrderItem
+	for _, item := range order.Items {
+		items = append(items, models.OrderItem{
+			ID:      models.ID(item.ID),
+			OrderID: models.ID(item.OrderID),
+			SKU:     item.SKU,
+			Name:    item.Name,
+			Price:   item.Price.toDomain(),
+			Status:  models.ItemStatus(item.Status),
+		})
+	}
+
+	return models.OrderEvent{
+		Type: models.EventType(p.Type),
+		Order: models.Order{
+			OrderID:            models.ID(order.OrderID),
+			External:           models.ExternalRef{Source: order.ExternalSource, Number: order.ExternalNumber},
+			CustomerID:         models.ID(order.CustomerID),
+			ExpirationTime:     order.ExpirationTime,
+			ReceivedTime:       order.ReceivedTime,
+			ReceivedByCustomer: order.ReceivedByCustomer,
+			Refunded:           order.Refunded,
+			RefundedTime:       order.RefundedTime,
+			Status:             models.Status(order.Status),
+			Package:            pack,
+			Weight:             models.Kilo(order.Weight),
+			Cost:               order.Cost.toDomain(),
+			PackageCost:        order.PackageCost.toDomain(),
+			Items:              items,
+			TryOnUntil:         order.TryOnUntil,
+			PointID:            models.PointID(order.PointID),
+			Cell:               models.CellCode(order.Cell),
+		},
+		Change: models.StatusChange{
+			OrderID:   models.ID(p.Change.OrderID),
+			From:      models.Status(p.Change.From),
+			To:        models.Status(p.Change.To),
+			Reason:    p.Change.Reason,
+			Operator:  models.Operator(p.Change.Operator),
+			ChangedAt: p.Change.ChangedAt,
+		},
+		PickupCode: p.PickupCode,
+	}
+}
+
+func transformMoney(money models.Money) outboxMoney {
+	return outboxMoney{Amount: money.Amount, Currency: string(money.Currency)}
+}
+
+func (m outboxMoney) toDomain() models.Money {
+	return models.NewMoney(m.Amount, models.Currency(m.Currency))
+}
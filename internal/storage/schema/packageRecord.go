@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"homework-1/internal/models"
+	"time"
+)
+
+type PackageRecord struct {
+	PackageType packageType `db:"package_type"`
+	Kind        string      `db:"kind"`
+	PriceMinor  int64       `db:"price_minor"`
+	Currency    string      `db:"currency"`
+	MinWeight   kilo        `db:"min_weight"`
+	MaxWeight   kilo        `db:"max_weight"`
+	LengthCm    int32       `db:"length_cm"`
+	WidthCm     int32       `db:"width_cm"`
+	HeightCm    int32       `db:"height_cm"`
+	AllowWrap   bool        `db:"allow_wrap"`
+	RetiredAt   time.Time   `db:"retired_at"`
+	CreatedAt   time.Time   `db:"created_at"`
+	UpdatedAt   time.Time   `db:"updated_at"`
+}
+
+func (p PackageRecord) ToDomain() models.PackageSpec {
+	return models.PackageSpec{
+		Type:      models.PackageType(p.PackageType),
+		Kind:      models.PackageKind(p.Kind),
+		Price:     models.NewMoney(p.PriceMinor, models.Currency(p.Currency)),
+		MinWeight: models.Kilo(p.MinWeight),
+		MaxWeight: models.Kilo(p.MaxWeight),
+		Dimensions: models.Dimensions{
+			Length: models.Centimeter(p.LengthCm),
+			Width:  models.Centimeter(p.WidthCm),
+			Height: models.Centimeter(p.HeightCm),
+		},
+		AllowWrap: p.AllowWrap,
+		RetiredAt: p.RetiredAt,
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
+
+func TransformPackage(spec models.PackageSpec) PackageRecord {
+	return PackageRecord{
+		PackageType: packageType(spec.Type),
+		Kind:        string(spec.Kind),
+		PriceMinor:  spec.Price.Amount,
+		Currency:    string(spec.Price.Currency),
+		MinWeight:   kilo(spec.MinWeight),
+		MaxWeight:   kilo(spec.MaxWeight),
+		LengthCm:    int32(spec.Dimensions.Length),
+		WidthCm:     int32(spec.Dimensions.Width),
+		HeightCm:    int32(spec.Dimensions.Height),
+		AllowWrap:   spec.AllowWrap,
+		RetiredAt:   spec.RetiredAt,
+		CreatedAt:   spec.CreatedAt,
+		UpdatedAt:   spec.UpdatedAt,
+	}
+}
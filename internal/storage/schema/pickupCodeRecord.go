@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"homework-1/internal/models"
+	"time"
+)
+
+type PickupCodeRecord struct {
+	CustomerID     id        `db:"customer_id"`
+	CodeHash       []byte    `db:"code_hash"`
+	Salt           []byte    `db:"salt"`
+	FailedAttempts int32     `db:"failed_attempts"`
+	LockedUntil    time.Time `db:"locked_until"`
+	CreatedAt      time.Time `db:"created_at"`
+}
+
+func (r PickupCodeRecord) ToDomain() models.PickupCode {
+	return models.PickupCode{
+		CustomerID:     models.ID(r.CustomerID),
+		Hash:           r.CodeHash,
+		Salt:           r.Salt,
+		FailedAttempts: int(r.FailedAttempts),
+		LockedUntil:    r.LockedUntil,
+		CreatedAt:      r.CreatedAt,
+	}
+}
+
+func TransformPickupCode(code models.PickupCode) PickupCodeRecord {
+	return PickupCodeRecord{
+		CustomerID:     id(code.CustomerID),
+		CodeHash:       code.Hash,
+		Salt:           code.Salt,
+		FailedAttempts: int32(code.FailedAttempts),
+		LockedUntil:    code.LockedUntil,
+		CreatedAt:      code.CreatedAt,
+	}
+}
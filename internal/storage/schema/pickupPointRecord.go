@@ -0,0 +1,41 @@
+package schema
+
+import "homework-1/internal/models"
+
+type PickupPointRecord struct {
+	PointID     string   `db:"point_id"`
+	Name        string   `db:"name"`
+	TimeZone    string   `db:"time_zone"`
+	ClosingTime string   `db:"closing_time"`
+	Operators   []string `db:"operators"`
+}
+
+func (r PickupPointRecord) ToDomain() models.PickupPoint {
+	operators := make([]models.Operator, 0, len(r.Operators))
+	for _, operator := range r.Operators {
+		operators = append(operators, models.Operator(operator))
+	}
+
+	return models.PickupPoint{
+		ID:          models.PointID(r.PointID),
+		Name:        r.Name,
+		TimeZone:    r.TimeZone,
+		ClosingTime: r.ClosingTime,
+		Operators:   operators,
+	}
+}
+
+func TransformPickupPoint(point models.PickupPoint) PickupPointRecord {
+	operators := make([]string, 0, len(point.Operators))
+	for _, operator := range point.Operators {
+		operators = append(operators, string(operator))
+	}
+
+	return PickupPointRecord{
+		PointID:     string(point.ID),
+		Name:        point.Name,
+		TimeZone:    point.TimeZone,
+		ClosingTime: point.ClosingTime,
+		Operators:   operators,
+	}
+}
@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"homework-1/internal/models"
+	"time"
+)
+
+type RefundRecord struct {
+	RefundID    id        `db:"refund_id"`
+	OrderID     id        `db:"order_id"`
+	CustomerID  id        `db:"customer_id"`
+	Reason      string    `db:"reason"`
+	Comment     string    `db:"comment"`
+	State       string    `db:"state"`
+	AmountMinor int64     `db:"amount_minor"`
+	Currency    string    `db:"currency"`
+	ItemIDs     []int64   `db:"item_ids"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+func (r RefundRecord) ToDomain() models.Refund {
+	return models.Refund{
+		ID:         models.ID(r.RefundID),
+		OrderID:    models.ID(r.OrderID),
+		CustomerID: models.ID(r.CustomerID),
+		Reason:     models.RefundReason(r.Reason),
+		Comment:    r.Comment,
+		State:      models.RefundState(r.State),
+		Amount:     models.NewMoney(r.AmountMinor, models.Currency(r.Currency)),
+		Items:      idsFromRecord(r.ItemIDs),
+		CreatedAt:  r.CreatedAt,
+		UpdatedAt:  r.UpdatedAt,
+	}
+}
+
+func TransformRefund(refund models.Refund) RefundRecord {
+	return RefundRecord{
+		RefundID:    id(refund.ID),
+		OrderID:     id(refund.OrderID),
+		CustomerID:  id(refund.CustomerID),
+		Reason:      string(refund.Reason),
+		Comment:     refund.Comment,
+		State:       string(refund.State),
+		AmountMinor: refund.Amount.Amount,
+		Currency:    string(refund.Amount.Currency),
+		ItemIDs:     idsToRecord(refund.Items),
+		CreatedAt:   refund.CreatedAt,
+		UpdatedAt:   refund.UpdatedAt,
+	}
+}
+
+func idsFromRecord(ids []int64) []models.ID {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]models.ID, 0, len(ids))
+	for _, itemId := range ids {
+		result = append(result, models.ID(itemId))
+	}
+	return result
+}
+
+// idsToRecord Возврат заказа целиком хранится пустым массивом, а не NULL.
+func idsToRecord(ids []models.ID) []int64 {
+	result := make([]int64, 0, len(ids))
+	for _, itemId := range ids {
+		result = append(result, int64(itemId))
+	}
+	return result
+}
+
+type RefundChangeRecord struct {
+	RefundID  id        `db:"refund_id"`
+	StateFrom string    `db:"state_from"`
+	StateTo   string    `db:"state_to"`
+	Comment   string    `db:"comment"`
+	Operator  string    `db:"operator"`
+	ChangedAt time.Time `db:"changed_at"`
+}
+
+func (c RefundChangeRecord) ToDomain() models.RefundChange {
+	return models.RefundChange{
+		RefundID:  models.ID(c.RefundID),
+		From:      models.RefundState(c.StateFrom),
+		To:        models.RefundState(c.StateTo),
+		Comment:   c.Comment,
+		Operator:  models.Operator(c.Operator),
+		ChangedAt: c.ChangedAt,
+	}
+}
+
+func TransformRefundChange(change models.RefundChange) RefundChangeRecord {
+	return RefundChangeRecord{
+		RefundID:  id(change.RefundID),
+		StateFrom: string(change.From),
+		StateTo:   string(change.To),
+		Comment:   change.Comment,
+		Operator:  string(change.Operator),
+		ChangedAt: change.ChangedAt,
+	}
+}
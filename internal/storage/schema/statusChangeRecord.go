@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"homework-1/internal/models"
+	"time"
+)
+
+type StatusChangeRecord struct {
+	OrderID    id        `db:"order_id"`
+	StatusFrom status    `db:"status_from"`
+	StatusTo   status    `db:"status_to"`
+	Reason     string    `db:"reason"`
+	Operator   string    `db:"operator"`
+	ChangedAt  time.Time `db:"changed_at"`
+}
+
+func (c StatusChangeRecord) ToDomain() models.StatusChange {
+	return models.StatusChange{
+		OrderID:   models.ID(c.OrderID),
+		From:      models.Status(c.StatusFrom),
+		To:        models.Status(c.StatusTo),
+		Reason:    c.Reason,
+		Operator:  models.Operator(c.Operator),
+		ChangedAt: c.ChangedAt,
+	}
+}
+
+func TransformStatusChange(change models.StatusChange) StatusChangeRecord {
+	return StatusChangeRecord{
+		OrderID:    id(change.OrderID),
+		StatusFrom: status(change.From),
+		StatusTo:   status(change.To),
+		Reason:     change.Reason,
+		Operator:   string(change.Operator),
+		ChangedAt:  change.ChangedAt,
+	}
+}
@@ -2,14 +2,47 @@
 
 package storage
 
-import "homework-1/internal/models"
+import (
+	"context"
+	"homework-1/internal/models"
+	"time"
+)
 
+// Storage Заказы, возвраты, история статусов и коды выдачи читаются и меняются только в границах пункта выдачи
+// из контекста запроса (models.WithPoint), без пункта запрос возвращает ErrNoPoint. Каталог упаковок,
+// справочник пунктов и outbox общие для всех пунктов.
 type Storage interface {
-	AddOrder(order models.Order) error
-	GetOrder(orderId models.ID) (models.Order, error)
-	GetCustomersOrders(customerId models.ID) ([]models.Order, error)
-	GetRefunds() ([]models.Order, error)
-	ChangeOrder(order models.Order) error
-	ReceiveOrder(orderId models.ID) (models.Order, error)
-	ReturnOrder(orderId models.ID) (models.Order, error)
+	AddOrder(ctx context.Context, order models.Order, event models.OrderEvent, code models.PickupCode, place func(cells []models.CellLoad) (models.CellCode, error)) (models.ID, error)
+	GetOrder(ctx context.Context, orderId models.ID) (models.Order, error)
+	ResolveOrderID(ctx context.Context, ref models.ExternalRef) (models.ID, error)
+	GetCustomersOrders(ctx context.Context, query models.OrdersQuery) ([]models.Order, error)
+	GetRefunds(ctx context.Context, query models.RefundsQuery) ([]models.Order, int, error)
+	ChangeOrder(ctx context.Context, order models.Order) error
+	ChangeStatus(ctx context.Context, order models.Order, event models.OrderEvent) error
+	ChangeStatuses(ctx context.Context, orderIds []models.ID, change func(orders []models.Order) ([]models.OrderEvent, error)) error
+	GetTimedOutTryOns(ctx context.Context, now time.Time, limit int) ([]models.ID, error)
+	GetExpiredOrders(ctx context.Context, now time.Time, limit int) ([]models.ID, error)
+	ReturnOrder(ctx context.Context, event models.OrderEvent, refunds []models.RefundChange) (models.Order, error)
+	ReturnItems(ctx context.Context, event models.OrderEvent, refunds []models.RefundChange) error
+	CreateRefund(ctx context.Context, refund models.Refund, change models.RefundChange, event models.OrderEvent) (models.Refund, error)
+	GetRefund(ctx context.Context, refundId models.ID) (models.Refund, error)
+	GetOrderRefunds(ctx context.Context, orderId models.ID, state models.RefundState) ([]models.Refund, error)
+	GetRefundHistory(ctx context.Context, refundId models.ID) ([]models.RefundChange, error)
+	ChangeRefund(ctx context.Context, refundId models.ID, change func(refund models.Refund, order models.Order) (models.Refund, models.RefundChange, []models.OrderEvent, error)) (models.Refund, error)
+	GetStatusHistory(ctx context.Context, orderId models.ID) ([]models.StatusChange, error)
+	AddPackage(ctx context.Context, spec models.PackageSpec) error
+	SeedPackages(ctx context.Context, specs []models.PackageSpec) (int, error)
+	UpdatePackage(ctx context.Context, spec models.PackageSpec) error
+	RetirePackage(ctx context.Context, pack models.PackageType, retiredAt time.Time) error
+	GetPackage(ctx context.Context, pack models.PackageType) (models.PackageSpec, error)
+	GetPackages(ctx context.Context, includeRetired bool) ([]models.PackageSpec, error)
+	GetPickupCode(ctx context.Context, customerId models.ID) (models.PickupCode, error)
+	RecordPickupFailure(ctx context.Context, customerId models.ID, maxAttempts int, lockedUntil time.Time) (models.PickupCode, error)
+	HandOverOrders(ctx context.Context, orderIds []models.ID, used models.PickupCode, next models.PickupCode, change func(orders []models.Order) ([]models.OrderEvent, error)) error
+	GetPickupPoint(ctx context.Context, pointId models.PointID) (models.PickupPoint, error)
+	GetPickupPoints(ctx context.Context) ([]models.PickupPoint, error)
+	GetOperatorPoint(ctx context.Context, operator models.Operator) (models.PointID, error)
+	SeedPickupPoints(ctx context.Context, points []models.PickupPoint) (int, error)
+	MoveOrder(ctx context.Context, orderId models.ID, move func(order models.Order, cells []models.CellLoad) (models.OrderEvent, error)) (models.Order, error)
+	PublishEvents(ctx context.Context, limit int, publish func(event models.OrderEvent) error) (int, error)
 }
@@ -2,14 +2,32 @@ package transactor
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"time"
+)
+
+// ErrConflict Транзакция не завершилась из-за конкурирующей транзакции и после всех повторов.
+var ErrConflict = errors.New("transaction conflict")
+
+// SQLSTATE ошибок, после которых транзакцию можно безопасно повторить целиком.
+const (
+	serializationFailure = "40001"
+	deadlockDetected     = "40P01"
+)
+
+const (
+	maxAttempts  = 3
+	retryBackoff = 20 * time.Millisecond
 )
 
 type QueryEngine interface {
 	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
 }
 
 type QueryEngineProvider interface {
@@ -20,13 +38,37 @@ type Transactor struct {
 	pool *pgxpool.Pool
 }
 
-const key = "tx"
+type txKey struct{}
 
 func NewTransactor(db *pgxpool.Pool) *Transactor {
 	return &Transactor{pool: db}
 }
 
+// RunRepeatableRead Выполняет f в транзакции REPEATABLE READ. При ошибке сериализации или взаимной блокировке
+// транзакция повторяется целиком, поэтому f не должна копить состояние между вызовами.
+// Если повторы не помогли, возвращается ошибка, обернутая в ErrConflict.
 func (t *Transactor) RunRepeatableRead(ctx context.Context, f func(ctxTX context.Context) error) error {
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		err = t.runRepeatableRead(ctx, f)
+		if err == nil || !isRetryable(err) {
+			return err
+		}
+		if attempt == maxAttempts {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("transactor.RunRepeatableRead error: %w", errors.Join(ctx.Err(), err))
+		case <-time.After(time.Duration(attempt) * retryBackoff):
+		}
+	}
+
+	return fmt.Errorf("%w: %w", ErrConflict, err)
+}
+
+func (t *Transactor) runRepeatableRead(ctx context.Context, f func(ctxTX context.Context) error) error {
 	tx, errTx := t.pool.BeginTx(ctx, pgx.TxOptions{
 		IsoLevel:   pgx.RepeatableRead,
 		AccessMode: pgx.ReadWrite,
@@ -35,7 +77,7 @@ func (t *Transactor) RunRepeatableRead(ctx context.Context, f func(ctxTX context
 		return fmt.Errorf("transactor.RunRepeatableRead error: %w", errTx)
 	}
 
-	if errF := f(context.WithValue(ctx, key, tx)); errF != nil {
+	if errF := f(context.WithValue(ctx, txKey{}, tx)); errF != nil {
 		if errRollback := tx.Rollback(ctx); errRollback != nil {
 			return fmt.Errorf("transactor.RunRepeatableRead error: %w, rollback error: %v", errF, errRollback)
 		}
@@ -49,8 +91,18 @@ func (t *Transactor) RunRepeatableRead(ctx context.Context, f func(ctxTX context
 	return nil
 }
 
+// isRetryable Проверяет, что транзакция откатилась из-за конкурирующей транзакции.
+func isRetryable(err error) bool {
+	var errPg *pgconn.PgError
+	if !errors.As(err, &errPg) {
+		return false
+	}
+
+	return errPg.Code == serializationFailure || errPg.Code == deadlockDetected
+}
+
 func (t *Transactor) GetQueryEngine(ctx context.Context) QueryEngine {
-	tx, ok := ctx.Value(key).(QueryEngine)
+	tx, ok := ctx.Value(txKey{}).(QueryEngine)
 	if ok && tx != nil {
 		return tx
 	}
@@ -0,0 +1,22 @@
+package transactor
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRetryable(t *testing.T) {
+	t.Run("Ошибка сериализации и взаимная блокировка повторяются", func(t *testing.T) {
+		assert.True(t, isRetryable(fmt.Errorf("storage.ChangeStatuses error: %w", &pgconn.PgError{Code: serializationFailure})))
+		assert.True(t, isRetryable(&pgconn.PgError{Code: deadlockDetected}))
+	})
+
+	t.Run("Остальные ошибки не повторяются", func(t *testing.T) {
+		assert.False(t, isRetryable(&pgconn.PgError{Code: "23505"}))
+		assert.False(t, isRetryable(errors.New("connection refused")))
+	})
+}
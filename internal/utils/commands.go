@@ -1,13 +1,24 @@
 package utils
 
 const (
-	helpCommand         = "help"
-	addOrderCommand     = "add"
-	returnOrderCommand  = "return"
-	receiveOrderCommand = "receive"
-	getOrdersCommand    = "orders"
-	createRefundCommand = "refund"
-	getRefundsCommand   = "refunds"
+	helpCommand          = "help"
+	addOrderCommand      = "add"
+	returnOrderCommand   = "return"
+	moveOrderCommand     = "move"
+	receiveOrderCommand  = "receive"
+	tryOnCommand         = "try-on"
+	confirmTryOnCommand  = "try-on-confirm"
+	getOrdersCommand     = "orders"
+	createRefundCommand  = "refund"
+	decideRefundCommand  = "decide"
+	getRefundCommand     = "refund-info"
+	getRefundsCommand    = "refunds"
+	orderHistoryCommand  = "history"
+	listPackagesCommand  = "packages"
+	addPackageCommand    = "package-add"
+	setPackageCommand    = "package-set"
+	retirePackageCommand = "package-retire"
+	quoteCommand         = "quote"
 )
 
 type command struct {
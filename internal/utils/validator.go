@@ -3,6 +3,8 @@ package utils
 import (
 	"errors"
 	"fmt"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"homework-1/internal/models"
 	"homework-1/pkg/api/proto/orders_grpc/v1/orders_grpc/v1"
 	"strconv"
 	"strings"
@@ -10,14 +12,14 @@ import (
 )
 
 const (
-	dateLayout = "02-01-2006"
+	dateLayout            = "02-01-2006"
+	externalSeparator     = ":"
+	packageLayerSeparator = "+"
+	decisionSeparator     = "="
 )
 
 var (
 	errIncorrectArgAmount = errors.New("incorrect amount of arguments")
-	errIncorrectId        = errors.New("empty or non-positive order or customer id")
-	errNegativeWeight     = errors.New("weight can not be negative")
-	errNegativeCost       = errors.New("cost can not be negative")
 )
 
 func HandleCommand(command string) (interface{}, error) {
@@ -38,12 +40,30 @@ func HandleCommand(command string) (interface{}, error) {
 			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
 		}
 		return req, nil
+	case moveOrderCommand:
+		req, err := moveOrder(arguments[1:])
+		if err != nil {
+			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
+		}
+		return req, nil
 	case receiveOrderCommand:
 		req, err := receiveOrder(arguments[1:])
 		if err != nil {
 			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
 		}
 		return req, nil
+	case tryOnCommand:
+		req, err := startTryOn(arguments[1:])
+		if err != nil {
+			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
+		}
+		return req, nil
+	case confirmTryOnCommand:
+		req, err := confirmTryOn(arguments[1:])
+		if err != nil {
+			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
+		}
+		return req, nil
 	case getOrdersCommand:
 		req, err := getOrders(arguments[1:])
 		if err != nil {
@@ -56,12 +76,60 @@ func HandleCommand(command string) (interface{}, error) {
 			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
 		}
 		return req, nil
+	case decideRefundCommand:
+		req, err := decideRefund(arguments[1:])
+		if err != nil {
+			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
+		}
+		return req, nil
+	case getRefundCommand:
+		req, err := getRefund(arguments[1:])
+		if err != nil {
+			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
+		}
+		return req, nil
 	case getRefundsCommand:
 		req, err := getRefunds(arguments[1:])
 		if err != nil {
 			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
 		}
 		return req, nil
+	case orderHistoryCommand:
+		req, err := getOrderHistory(arguments[1:])
+		if err != nil {
+			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
+		}
+		return req, nil
+	case listPackagesCommand:
+		req, err := listPackages(arguments[1:])
+		if err != nil {
+			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
+		}
+		return req, nil
+	case addPackageCommand:
+		req, err := addPackage(arguments[1:])
+		if err != nil {
+			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
+		}
+		return req, nil
+	case setPackageCommand:
+		req, err := setPackage(arguments[1:])
+		if err != nil {
+			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
+		}
+		return req, nil
+	case retirePackageCommand:
+		req, err := retirePackage(arguments[1:])
+		if err != nil {
+			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
+		}
+		return req, nil
+	case quoteCommand:
+		req, err := getQuote(arguments[1:])
+		if err != nil {
+			return nil, fmt.Errorf("utils.HandleCommand error: %w\n", err)
+		}
+		return req, nil
 	default:
 		return nil, unknownCommand()
 	}
@@ -85,127 +153,219 @@ func unknownCommand() error {
 	return nil
 }
 
-// addOrder --orderId=1 --customerId=1 --expirationTime=01-01-2024 --packageType=box --weight=1 --cost=1
+// addOrder --order=ozon:123 --customerId=1 --expirationTime=01-01-2024 --packageType=box --weight=1 --cost=1
 func addOrder(args []string) (*orders_grpc.AddOrderRequest, error) {
 	if len(args) != 6 {
 		return nil, errIncorrectArgAmount
 	}
 
-	expirationTime := args[2]
-	pack := args[3]
+	// Составная упаковка задается слоями через +, например box+wrap.
+	layers := strings.Split(args[3], packageLayerSeparator)
 
-	orderIdInt, errParse := strconv.ParseInt(args[0], 10, 64)
+	customerIdInt, errParse := strconv.ParseInt(args[1], 10, 64)
 	if errParse != nil {
 		return nil, fmt.Errorf("cli.addOrder error: %w", errParse)
 	}
-	customerIdInt, errParse := strconv.ParseInt(args[1], 10, 64)
+
+	weightFloat, errParse := strconv.ParseFloat(args[4], 64)
 	if errParse != nil {
 		return nil, fmt.Errorf("cli.addOrder error: %w", errParse)
 	}
-	if orderIdInt <= 0 || customerIdInt <= 0 {
-		return nil, fmt.Errorf("cli.addOrder error: %w", errIncorrectId)
-	}
 
-	_, errDate := time.Parse(dateLayout, expirationTime)
+	// Оператор работает в часовом поясе пункта выдачи, поэтому дата разбирается по местному времени терминала.
+	expirationTime, errDate := time.ParseInLocation(dateLayout, args[2], time.Local)
 	if errDate != nil {
 		return nil, fmt.Errorf("cli.addOrder error: %w", errDate)
 	}
 
-	weightFloat, errParse := strconv.ParseFloat(args[4], 64)
-	if errParse != nil {
-		return nil, fmt.Errorf("cli.addOrder error: %w", errParse)
+	cost, errCost := models.ParseMoney(args[5], models.CurrencyRUB)
+	if errCost != nil {
+		return nil, fmt.Errorf("cli.addOrder error: %w", errCost)
 	}
 
-	if weightFloat < 0 {
-		return nil, fmt.Errorf("cli.addOrder error: %w", errNegativeWeight)
+	req := &orders_grpc.AddOrderRequest{
+		Order:      &orders_grpc.AddOrderRequest_External{External: parseExternal(args[0])},
+		CustomerId: customerIdInt,
+		Expiration: timestamppb.New(expirationTime),
+		Weight:     weightFloat,
+		CostMoney:  &orders_grpc.Money{AmountMinor: cost.Amount, Currency: string(cost.Currency)},
 	}
-
-	costInt, errParse := strconv.ParseFloat(args[5], 64)
-	if errParse != nil {
-		return nil, fmt.Errorf("cli.addOrder error: %w", errParse)
+	if len(layers) > 1 {
+		req.PackageLayers = layers
+	} else {
+		req.PackageType = args[3]
 	}
-
-	if costInt < 0 {
-		return nil, fmt.Errorf("cli.addOrder error: %w", errNegativeCost)
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.addOrder error: %w", errValidate)
 	}
 
-	return &orders_grpc.AddOrderRequest{
-		OrderId:        orderIdInt,
-		CustomerId:     customerIdInt,
-		ExpirationTime: expirationTime,
-		PackageType:    pack,
-		Weight:         weightFloat,
-		Cost:           costInt,
-	}, nil
+	return req, nil
 }
 
-// returnOrder --orderId=1
+// returnOrder --order=1 или --order=ozon:123
 func returnOrder(args []string) (*orders_grpc.ReturnOrderRequest, error) {
 	if len(args) != 1 {
 		return nil, errIncorrectArgAmount
 	}
 
-	orderIdInt, errParse := strconv.ParseInt(args[0], 10, 64)
+	orderIdInt, external, errParse := parseOrder(args[0])
 	if errParse != nil {
 		return nil, fmt.Errorf("cli.returnOrder error: %w", errParse)
 	}
-	if orderIdInt <= 0 {
-		return nil, fmt.Errorf("cli.returnOrder error: %w", errIncorrectId)
+	req := &orders_grpc.ReturnOrderRequest{
+		Order: &orders_grpc.ReturnOrderRequest_OrderId{OrderId: orderIdInt},
+	}
+	if external != nil {
+		req.Order = &orders_grpc.ReturnOrderRequest_External{External: external}
+	}
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.returnOrder error: %w", errValidate)
 	}
 
-	return &orders_grpc.ReturnOrderRequest{
-		OrderId: orderIdInt,
-	}, nil
+	return req, nil
 }
 
-// receiveOrder --orders1=1,2,3,4,5
+// moveOrder --order=1 или --order=ozon:123 --cell=A-01-02
+func moveOrder(args []string) (*orders_grpc.MoveOrderRequest, error) {
+	if len(args) != 2 {
+		return nil, errIncorrectArgAmount
+	}
+
+	orderIdInt, external, errParse := parseOrder(args[0])
+	if errParse != nil {
+		return nil, fmt.Errorf("cli.moveOrder error: %w", errParse)
+	}
+	req := &orders_grpc.MoveOrderRequest{
+		Order: &orders_grpc.MoveOrderRequest_OrderId{OrderId: orderIdInt},
+		Cell:  args[1],
+	}
+	if external != nil {
+		req.Order = &orders_grpc.MoveOrderRequest_External{External: external}
+	}
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.moveOrder error: %w", errValidate)
+	}
+
+	return req, nil
+}
+
+// receiveOrder --orders=1,2,ozon:123 --code=123456 [--declined=10,11]
 func receiveOrder(args []string) (*orders_grpc.ReceiveOrdersRequest, error) {
-	if len(args) != 1 {
+	if len(args) != 2 && len(args) != 3 {
 		return nil, errIncorrectArgAmount
 	}
 
-	orderIds, errParseId := parseIDs(args[0])
-	if errParseId != nil {
-		return nil, fmt.Errorf("cli.receiveOrder error: %w", errParseId)
+	orderIds, externals, errParse := parseOrders(args[0])
+	if errParse != nil {
+		return nil, fmt.Errorf("cli.receiveOrder error: %w", errParse)
+	}
+
+	req := &orders_grpc.ReceiveOrdersRequest{OrderIds: orderIds, Externals: externals, PickupCode: args[1]}
+	// Товары, от которых клиент отказался при выдаче, перечисляются через запятую.
+	if len(args) == 3 {
+		req.DeclinedItemIds, errParse = parseIds(args[2])
+		if errParse != nil {
+			return nil, fmt.Errorf("cli.receiveOrder error: %w", errParse)
+		}
+	}
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.receiveOrder error: %w", errValidate)
 	}
 
-	return &orders_grpc.ReceiveOrdersRequest{
-		OrderIds: orderIds,
-	}, nil
+	return req, nil
 }
 
-// getOrders --customerId=1 --n=1
-func getOrders(args []string) (*orders_grpc.GetOrdersRequest, error) {
+// startTryOn --orders=1,2,ozon:123 --code=123456
+func startTryOn(args []string) (*orders_grpc.StartTryOnRequest, error) {
 	if len(args) != 2 {
 		return nil, errIncorrectArgAmount
 	}
 
-	customerIdInt, errParse := strconv.ParseInt(args[0], 10, 64)
+	orderIds, externals, errParse := parseOrders(args[0])
 	if errParse != nil {
-		return nil, fmt.Errorf("cli.getOrders error: %w", errParse)
+		return nil, fmt.Errorf("cli.startTryOn error: %w", errParse)
+	}
+
+	req := &orders_grpc.StartTryOnRequest{OrderIds: orderIds, Externals: externals, PickupCode: args[1]}
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.startTryOn error: %w", errValidate)
+	}
+
+	return req, nil
+}
+
+// confirmTryOn --decisions=1=keep,2=reject,3=return [--declined=10,11]
+func confirmTryOn(args []string) (*orders_grpc.ConfirmTryOnRequest, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, errIncorrectArgAmount
+	}
+
+	req := &orders_grpc.ConfirmTryOnRequest{}
+	for _, decision := range strings.Split(args[0], ",") {
+		order, outcome, found := strings.Cut(strings.TrimSpace(decision), decisionSeparator)
+		if !found {
+			return nil, fmt.Errorf("cli.confirmTryOn error: %w", errIncorrectArgAmount)
+		}
+		orderIdInt, errParse := strconv.ParseInt(order, 10, 64)
+		if errParse != nil {
+			return nil, fmt.Errorf("cli.confirmTryOn error: %w", errParse)
+		}
+
+		req.Decisions = append(req.Decisions, &orders_grpc.TryOnDecision{
+			OrderId: orderIdInt,
+			Outcome: orders_grpc.TryOnOutcome(parseEnum(orders_grpc.TryOnOutcome_value, "TRY_ON_OUTCOME_", outcome)),
+		})
+	}
+	if len(args) == 2 {
+		declined, errParse := parseIds(args[1])
+		if errParse != nil {
+			return nil, fmt.Errorf("cli.confirmTryOn error: %w", errParse)
+		}
+		req.DeclinedItemIds = declined
 	}
-	if customerIdInt <= 0 {
-		return nil, fmt.Errorf("cli.getOrders error: %w", errIncorrectId)
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.confirmTryOn error: %w", errValidate)
 	}
 
+	return req, nil
+}
+
+// getOrders --customerId=1 --n=1 [--pageToken=...]
+func getOrders(args []string) (*orders_grpc.GetOrdersRequest, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, errIncorrectArgAmount
+	}
+
+	customerIdInt, errParse := strconv.ParseInt(args[0], 10, 64)
+	if errParse != nil {
+		return nil, fmt.Errorf("cli.getOrders error: %w", errParse)
+	}
 	n, errParse := strconv.Atoi(args[1])
 	if errParse != nil {
 		return nil, fmt.Errorf("cli.getOrders error: %w", errParse)
 	}
 
-	return &orders_grpc.GetOrdersRequest{
+	req := &orders_grpc.GetOrdersRequest{
 		CustomerId: customerIdInt,
 		N:          int32(n),
-	}, nil
+	}
+	if len(args) == 3 {
+		req.PageToken = args[2]
+	}
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.getOrders error: %w", errValidate)
+	}
+
+	return req, nil
 }
 
-// createRefund --orderId=1 --customerId=1
+// createRefund --order=1 --customerId=1 [--reason=damaged [--comment=...]]
 func createRefund(args []string) (*orders_grpc.CreateRefundRequest, error) {
-	if len(args) != 2 {
+	if len(args) < 2 {
 		return nil, errIncorrectArgAmount
 	}
 
-	orderIdInt, errParse := strconv.ParseInt(args[0], 10, 64)
+	orderIdInt, external, errParse := parseOrder(args[0])
 	if errParse != nil {
 		return nil, fmt.Errorf("cli.createRefund error: %w", errParse)
 	}
@@ -215,54 +375,316 @@ func createRefund(args []string) (*orders_grpc.CreateRefundRequest, error) {
 		return nil, fmt.Errorf("cli.createRefund error: %w", errParse)
 	}
 
-	if orderIdInt <= 0 || customerIdInt <= 0 {
-		return nil, fmt.Errorf("cli.createRefund error: %w", errIncorrectId)
+	req := &orders_grpc.CreateRefundRequest{
+		Order:      &orders_grpc.CreateRefundRequest_OrderId{OrderId: orderIdInt},
+		CustomerId: customerIdInt,
+	}
+	if external != nil {
+		req.Order = &orders_grpc.CreateRefundRequest_External{External: external}
+	}
+	if len(args) > 2 {
+		req.Reason = orders_grpc.RefundReason(parseEnum(orders_grpc.RefundReason_value, "REFUND_REASON_", args[2]))
+		req.Comment = strings.Join(args[3:], " ")
+	}
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.createRefund error: %w", errValidate)
 	}
 
-	return &orders_grpc.CreateRefundRequest{
-		OrderId:    orderIdInt,
-		CustomerId: customerIdInt,
-	}, nil
+	return req, nil
 }
 
-// getRefunds --page=1 --limit=1
-func getRefunds(args []string) (*orders_grpc.GetRefundsRequest, error) {
-	if len(args) != 2 {
+// decideRefund --refundId=1 --decision=inspect|approve|reject [--comment=...]
+func decideRefund(args []string) (*orders_grpc.DecideRefundRequest, error) {
+	if len(args) < 2 {
 		return nil, errIncorrectArgAmount
 	}
 
-	page, errParse := strconv.Atoi(args[0])
+	refundIdInt, errParse := strconv.ParseInt(args[0], 10, 64)
 	if errParse != nil {
-		return nil, fmt.Errorf("cli.getRefunds error: %w", errParse)
+		return nil, fmt.Errorf("cli.decideRefund error: %w", errParse)
+	}
+
+	req := &orders_grpc.DecideRefundRequest{
+		RefundId: refundIdInt,
+		Decision: orders_grpc.RefundDecision(parseEnum(orders_grpc.RefundDecision_value, "REFUND_DECISION_", args[1])),
+		Comment:  strings.Join(args[2:], " "),
+	}
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.decideRefund error: %w", errValidate)
+	}
+
+	return req, nil
+}
+
+// getRefund --refundId=1
+func getRefund(args []string) (*orders_grpc.GetRefundRequest, error) {
+	if len(args) != 1 {
+		return nil, errIncorrectArgAmount
 	}
 
-	limit, errParse := strconv.Atoi(args[1])
+	refundIdInt, errParse := strconv.ParseInt(args[0], 10, 64)
+	if errParse != nil {
+		return nil, fmt.Errorf("cli.getRefund error: %w", errParse)
+	}
+
+	req := &orders_grpc.GetRefundRequest{RefundId: refundIdInt}
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.getRefund error: %w", errValidate)
+	}
+
+	return req, nil
+}
+
+// getRefunds --limit=1 [--pageToken=...]
+func getRefunds(args []string) (*orders_grpc.GetRefundsRequest, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, errIncorrectArgAmount
+	}
+
+	limit, errParse := strconv.Atoi(args[0])
 	if errParse != nil {
 		return nil, fmt.Errorf("cli.getRefunds error: %w", errParse)
 	}
 
-	return &orders_grpc.GetRefundsRequest{
-		Page:  int32(page),
+	req := &orders_grpc.GetRefundsRequest{
 		Limit: int32(limit),
-	}, nil
+	}
+	if len(args) == 2 {
+		req.PageToken = args[1]
+	}
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.getRefunds error: %w", errValidate)
+	}
+
+	return req, nil
+}
+
+// getOrderHistory --order=1 или --order=ozon:123
+func getOrderHistory(args []string) (*orders_grpc.GetOrderHistoryRequest, error) {
+	if len(args) != 1 {
+		return nil, errIncorrectArgAmount
+	}
+
+	orderIdInt, external, errParse := parseOrder(args[0])
+	if errParse != nil {
+		return nil, fmt.Errorf("cli.getOrderHistory error: %w", errParse)
+	}
+	req := &orders_grpc.GetOrderHistoryRequest{
+		Order: &orders_grpc.GetOrderHistoryRequest_OrderId{OrderId: orderIdInt},
+	}
+	if external != nil {
+		req.Order = &orders_grpc.GetOrderHistoryRequest_External{External: external}
+	}
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.getOrderHistory error: %w", errValidate)
+	}
+
+	return req, nil
+}
+
+// listPackages [--all]
+func listPackages(args []string) (*orders_grpc.ListPackageTypesRequest, error) {
+	if len(args) > 1 || (len(args) == 1 && args[0] != "all") {
+		return nil, errIncorrectArgAmount
+	}
+
+	return &orders_grpc.ListPackageTypesRequest{IncludeRetired: len(args) == 1}, nil
+}
+
+// addPackage --name=box --price=20 --minWeight=0 --maxWeight=30 [--dimensions=60x40x40] [--wrap]
+func addPackage(args []string) (*orders_grpc.CreatePackageTypeRequest, error) {
+	pack, errParse := parsePackageType(args)
+	if errParse != nil {
+		return nil, fmt.Errorf("cli.addPackage error: %w", errParse)
+	}
+
+	req := &orders_grpc.CreatePackageTypeRequest{PackageType: pack}
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.addPackage error: %w", errValidate)
+	}
+
+	return req, nil
+}
+
+// setPackage Аргументы как у addPackage, описание типа заменяется целиком.
+func setPackage(args []string) (*orders_grpc.UpdatePackageTypeRequest, error) {
+	pack, errParse := parsePackageType(args)
+	if errParse != nil {
+		return nil, fmt.Errorf("cli.setPackage error: %w", errParse)
+	}
+
+	req := &orders_grpc.UpdatePackageTypeRequest{PackageType: pack}
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.setPackage error: %w", errValidate)
+	}
+
+	return req, nil
+}
+
+// retirePackage --name=box
+func retirePackage(args []string) (*orders_grpc.RetirePackageTypeRequest, error) {
+	if len(args) != 1 {
+		return nil, errIncorrectArgAmount
+	}
+
+	req := &orders_grpc.RetirePackageTypeRequest{Name: args[0]}
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.retirePackage error: %w", errValidate)
+	}
+
+	return req, nil
+}
+
+// getQuote --weight=12.5 [--dimensions=60x40x40] [--cost=1500]
+func getQuote(args []string) (*orders_grpc.GetQuoteRequest, error) {
+	if len(args) < 1 || len(args) > 3 {
+		return nil, errIncorrectArgAmount
+	}
+
+	weight, errParse := strconv.ParseFloat(args[0], 64)
+	if errParse != nil {
+		return nil, fmt.Errorf("cli.getQuote error: %w", errParse)
+	}
+
+	req := &orders_grpc.GetQuoteRequest{Weight: weight}
+	for _, option := range args[1:] {
+		if strings.Contains(option, "x") {
+			var length, width, height int32
+			if _, errScan := fmt.Sscanf(option, "%dx%dx%d", &length, &width, &height); errScan != nil {
+				return nil, fmt.Errorf("cli.getQuote error: %w", errScan)
+			}
+			req.Dimensions = &orders_grpc.Dimensions{LengthCm: length, WidthCm: width, HeightCm: height}
+			continue
+		}
+
+		cost, errCost := models.ParseMoney(option, models.CurrencyRUB)
+		if errCost != nil {
+			return nil, fmt.Errorf("cli.getQuote error: %w", errCost)
+		}
+		req.CostMoney = &orders_grpc.Money{AmountMinor: cost.Amount, Currency: string(cost.Currency)}
+	}
+	if errValidate := req.ValidateAll(); errValidate != nil {
+		return nil, fmt.Errorf("cli.getQuote error: %w", errValidate)
+	}
+
+	return req, nil
+}
+
+// parsePackageType Необязательные размеры (ДxШxВ в сантиметрах), признак wrap и признак обертки wrapping
+// идут после веса в любом порядке.
+func parsePackageType(args []string) (*orders_grpc.PackageType, error) {
+	if len(args) < 4 || len(args) > 7 {
+		return nil, errIncorrectArgAmount
+	}
+
+	price, errPrice := models.ParseMoney(args[1], models.CurrencyRUB)
+	if errPrice != nil {
+		return nil, errPrice
+	}
+	minWeight, errParse := strconv.ParseFloat(args[2], 64)
+	if errParse != nil {
+		return nil, errParse
+	}
+	maxWeight, errParse := strconv.ParseFloat(args[3], 64)
+	if errParse != nil {
+		return nil, errParse
+	}
+
+	pack := &orders_grpc.PackageType{
+		Name:       args[0],
+		Price:      &orders_grpc.Money{AmountMinor: price.Amount, Currency: string(price.Currency)},
+		MinWeight:  minWeight,
+		MaxWeight:  maxWeight,
+		Dimensions: &orders_grpc.Dimensions{},
+	}
+	for _, option := range args[4:] {
+		switch option {
+		case "wrap":
+			pack.AllowWrap = true
+			continue
+		case "wrapping":
+			pack.Kind = orders_grpc.PackageKind_PACKAGE_KIND_WRAPPING
+			continue
+		}
+
+		var length, width, height int32
+		if _, errScan := fmt.Sscanf(option, "%dx%dx%d", &length, &width, &height); errScan != nil {
+			return nil, errScan
+		}
+		pack.Dimensions = &orders_grpc.Dimensions{LengthCm: length, WidthCm: width, HeightCm: height}
+	}
+
+	return pack, nil
+}
+
+// parseOrder Заказ задается идентификатором пункта выдачи ("15") или внешним номером продавца ("ozon:123").
+func parseOrder(order string) (int64, *orders_grpc.ExternalOrderRef, error) {
+	if strings.Contains(order, externalSeparator) {
+		return 0, parseExternal(order), nil
+	}
+
+	id, errParse := strconv.ParseInt(order, 10, 64)
+	if errParse != nil {
+		return 0, nil, errParse
+	}
+
+	return id, nil, nil
 }
 
-func parseIDs(idsStr string) ([]int64, error) {
-	if idsStr == "" {
-		return nil, errIncorrectId
+// parseOrders Разбирает заказы через запятую на идентификаторы и внешние номера.
+func parseOrders(orders string) ([]int64, []*orders_grpc.ExternalOrderRef, error) {
+	var (
+		ids       []int64
+		externals []*orders_grpc.ExternalOrderRef
+	)
+	for _, order := range strings.Split(orders, ",") {
+		orderIdInt, external, errParse := parseOrder(strings.TrimSpace(order))
+		if errParse != nil {
+			return nil, nil, errParse
+		}
+
+		if external != nil {
+			externals = append(externals, external)
+		} else {
+			ids = append(ids, orderIdInt)
+		}
 	}
 
-	strIds := strings.Split(idsStr, ",")
-	var ids []int64
-	for _, strId := range strIds {
-		id, errParse := strconv.ParseInt(strings.TrimSpace(strId), 10, 64)
+	return ids, externals, nil
+}
+
+func parseIds(ids string) ([]int64, error) {
+	var result []int64
+	for _, id := range strings.Split(ids, ",") {
+		idInt, errParse := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
 		if errParse != nil {
 			return nil, errParse
 		}
-		ids = append(ids, id)
+		result = append(result, idInt)
+	}
+
+	return result, nil
+}
+
+// parseEnum Ищет значение перечисления по имени без префикса: damaged -> REFUND_REASON_DAMAGED.
+// Неизвестное имя дает -1, такое значение отклоняет валидация запроса.
+func parseEnum(values map[string]int32, prefix string, name string) int32 {
+	value, ok := values[prefix+strings.ToUpper(name)]
+	if !ok {
+		return -1
+	}
+
+	return value
+}
+
+// parseExternal Номер без источника считается номером legacy, под которым заказы принимались раньше.
+func parseExternal(order string) *orders_grpc.ExternalOrderRef {
+	source, number, found := strings.Cut(order, externalSeparator)
+	if !found {
+		return &orders_grpc.ExternalOrderRef{Source: models.LegacySource, Number: order}
 	}
 
-	return ids, nil
+	return &orders_grpc.ExternalOrderRef{Source: source, Number: number}
 }
 
 func commandList() []command {
@@ -273,15 +695,27 @@ func commandList() []command {
 		},
 		{
 			name:        addOrderCommand,
-			description: "Добавить заказ",
+			description: "Добавить заказ: номер продавца (источник:номер), клиент, срок хранения, упаковка (слои через +, например box+wrap), вес, стоимость",
 		},
 		{
 			name:        returnOrderCommand,
 			description: "Удалить заказ",
 		},
+		{
+			name:        moveOrderCommand,
+			description: "Переложить заказ в другую ячейку: заказ, ячейка (например A-01-02)",
+		},
 		{
 			name:        receiveOrderCommand,
-			description: "Получить заказ",
+			description: "Получить заказы: заказы через запятую, код выдачи из уведомления клиента, товары, от которых клиент отказался, через запятую (необязательно)",
+		},
+		{
+			name:        tryOnCommand,
+			description: "Отдать заказы на примерку: заказы через запятую, код выдачи из уведомления клиента",
+		},
+		{
+			name:        confirmTryOnCommand,
+			description: "Завершить примерку: решения через запятую в виде заказ=keep/reject/return, товары забранных заказов, от которых клиент отказался, через запятую (необязательно)",
 		},
 		{
 			name:        getOrdersCommand,
@@ -289,11 +723,43 @@ func commandList() []command {
 		},
 		{
 			name:        createRefundCommand,
-			description: "Создать запрос на возврат",
+			description: "Создать запрос на возврат: заказ, клиент, причина (damaged, wrong_item, not_as_described, defective, changed_mind, other), комментарий",
+		},
+		{
+			name:        decideRefundCommand,
+			description: "Решение по возврату: возврат, inspect/approve/reject, комментарий (обязателен для approve и reject)",
+		},
+		{
+			name:        getRefundCommand,
+			description: "Получить возврат и историю решений по нему",
 		},
 		{
 			name:        getRefundsCommand,
 			description: "Получить список возвратов",
 		},
+		{
+			name:        orderHistoryCommand,
+			description: "Получить историю заказа",
+		},
+		{
+			name:        listPackagesCommand,
+			description: "Каталог упаковок, с аргументом all - вместе с выведенными из оборота",
+		},
+		{
+			name:        addPackageCommand,
+			description: "Добавить тип упаковки: имя, цена, минимальный вес, максимальный вес (0 - без ограничения), [размеры ДxШxВ], [wrap - можно обернуть пленкой], [wrapping - сам тип является пленкой]",
+		},
+		{
+			name:        setPackageCommand,
+			description: "Изменить тип упаковки, аргументы как у package-add",
+		},
+		{
+			name:        retirePackageCommand,
+			description: "Вывести тип упаковки из оборота",
+		},
+		{
+			name:        quoteCommand,
+			description: "Подобрать упаковку: вес, [размеры ДxШxВ], [стоимость заказа]. Для add можно указать упаковку auto",
+		},
 	}
 }
@@ -0,0 +1,61 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS status TEXT NOT NULL DEFAULT 'accepted';
+
+UPDATE orders
+SET status = CASE
+                 WHEN refunded THEN 'refunded'
+                 WHEN received_by_customer THEN 'issued'
+                 WHEN expiration_time < NOW() THEN 'expired'
+                 ELSE 'accepted'
+    END;
+
+CREATE TABLE IF NOT EXISTS order_status_history
+(
+    id          BIGSERIAL PRIMARY KEY,
+    order_id    BIGINT    NOT NULL,
+    status_from TEXT      NOT NULL DEFAULT '',
+    status_to   TEXT      NOT NULL,
+    reason      TEXT      NOT NULL DEFAULT '',
+    changed_at  TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS order_status_history_order_id_idx ON order_status_history (order_id, changed_at);
+
+-- У заказов, принятых до появления истории, она восстанавливается: прием и переход в вычисленный выше статус.
+-- Время приема не хранилось, поэтому прием датируется не позже остальных известных моментов заказа.
+INSERT INTO order_status_history (order_id, status_from, status_to, reason, changed_at)
+SELECT order_id,
+       '',
+       'accepted',
+       'accepted from courier, restored on migration',
+       LEAST(expiration_time, COALESCE(received_time, expiration_time), NOW())
+FROM orders;
+
+INSERT INTO order_status_history (order_id, status_from, status_to, reason, changed_at)
+SELECT order_id,
+       'accepted',
+       CASE WHEN status = 'expired' THEN 'expired' ELSE 'issued' END,
+       'status restored on migration',
+       CASE WHEN status = 'expired' THEN expiration_time ELSE COALESCE(received_time, NOW()) END
+FROM orders
+WHERE status IN ('issued', 'refunded', 'expired');
+
+INSERT INTO order_status_history (order_id, status_from, status_to, reason, changed_at)
+SELECT order_id,
+       'issued',
+       'refunded',
+       'status restored on migration',
+       NOW()
+FROM orders
+WHERE status = 'refunded';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS order_status_history;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE order_status_history
+    ADD COLUMN IF NOT EXISTS operator TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE order_status_history
+    DROP COLUMN IF EXISTS operator;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS cost_minor         BIGINT,
+    ADD COLUMN IF NOT EXISTS package_cost_minor BIGINT,
+    ADD COLUMN IF NOT EXISTS currency           VARCHAR(3) NOT NULL DEFAULT 'RUB';
+
+UPDATE orders
+SET cost_minor         = ROUND(cost::NUMERIC * 100)::BIGINT,
+    package_cost_minor = package_cost::BIGINT * 100;
+
+ALTER TABLE orders
+    ALTER COLUMN cost_minor SET NOT NULL,
+    ALTER COLUMN package_cost_minor SET NOT NULL,
+    DROP COLUMN IF EXISTS cost,
+    DROP COLUMN IF EXISTS package_cost;
+
+-- Неопубликованные события хранят заказ в старом формате (стоимость в рублях числом), переводим их в Money.
+UPDATE outbox
+SET payload = jsonb_set(
+        jsonb_set(payload, '{Order,Cost}',
+                  jsonb_build_object('Amount', ROUND((payload -> 'Order' ->> 'Cost')::NUMERIC * 100)::BIGINT, 'Currency', 'RUB')),
+        '{Order,PackageCost}',
+        jsonb_build_object('Amount', ROUND((payload -> 'Order' ->> 'PackageCost')::NUMERIC * 100)::BIGINT, 'Currency', 'RUB'))
+WHERE published_at IS NULL
+  AND jsonb_typeof(payload -> 'Order' -> 'Cost') = 'number';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS cost         FLOAT,
+    ADD COLUMN IF NOT EXISTS package_cost INT;
+
+UPDATE orders
+SET cost         = cost_minor::FLOAT / 100,
+    package_cost = package_cost_minor / 100;
+
+ALTER TABLE orders
+    ALTER COLUMN cost SET NOT NULL,
+    ALTER COLUMN package_cost SET NOT NULL,
+    DROP COLUMN IF EXISTS cost_minor,
+    DROP COLUMN IF EXISTS package_cost_minor,
+    DROP COLUMN IF EXISTS currency;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS external_source TEXT,
+    ADD COLUMN IF NOT EXISTS external_number TEXT;
+
+-- Заказы, принятые до появления внешних номеров, были приняты под номером продавца в order_id.
+UPDATE orders
+SET external_source = 'legacy',
+    external_number = order_id::TEXT;
+
+ALTER TABLE orders
+    ALTER COLUMN external_source SET NOT NULL,
+    ALTER COLUMN external_number SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS orders_external_ref_idx ON orders (external_source, external_number);
+
+-- Раньше order_id передавал клиент, последовательность могла отстать от уже занятых значений.
+SELECT setval(pg_get_serial_sequence('orders', 'order_id'), COALESCE(MAX(order_id), 0) + 1, false)
+FROM orders;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_external_ref_idx;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS external_source,
+    DROP COLUMN IF EXISTS external_number;
+-- +goose StatementEnd
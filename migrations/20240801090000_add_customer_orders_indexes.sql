@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Сервис записывает нулевое время вместо NULL для неполученных заказов, выравниваем старые строки,
+-- чтобы сравнение по ключу (received_time, order_id) не теряло заказы с NULL.
+UPDATE orders
+SET received_time = '0001-01-01 00:00:00'
+WHERE received_time IS NULL;
+
+ALTER TABLE orders
+    ALTER COLUMN received_time SET DEFAULT '0001-01-01 00:00:00',
+    ALTER COLUMN received_time SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS orders_customer_expiration_idx ON orders (customer_id, expiration_time, order_id);
+CREATE INDEX IF NOT EXISTS orders_customer_received_idx ON orders (customer_id, received_time, order_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_customer_received_idx;
+DROP INDEX IF EXISTS orders_customer_expiration_idx;
+
+ALTER TABLE orders
+    ALTER COLUMN received_time DROP NOT NULL,
+    ALTER COLUMN received_time DROP DEFAULT;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS refunded_time TIMESTAMP NOT NULL DEFAULT '0001-01-01 00:00:00';
+
+-- Время возврата берем из истории статусов. У заказов, вернувшихся до появления истории,
+-- его нет, для них берем время получения, чтобы они не собрались в начале списка.
+UPDATE orders o
+SET refunded_time = COALESCE((SELECT MAX(h.changed_at)
+                              FROM order_status_history h
+                              WHERE h.order_id = o.order_id
+                                AND h.status_to = 'refunded'), o.received_time)
+WHERE o.refunded;
+
+CREATE INDEX IF NOT EXISTS orders_refunded_time_idx ON orders (refunded_time, order_id) WHERE refunded;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_refunded_time_idx;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS refunded_time;
+-- +goose StatementEnd
@@ -0,0 +1,56 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS refunds
+(
+    refund_id    BIGSERIAL PRIMARY KEY,
+    order_id     BIGINT    NOT NULL,
+    customer_id  BIGINT    NOT NULL,
+    reason       TEXT      NOT NULL,
+    comment      TEXT      NOT NULL DEFAULT '',
+    state        TEXT      NOT NULL,
+    amount_minor BIGINT    NOT NULL,
+    currency     TEXT      NOT NULL,
+    created_at   TIMESTAMP NOT NULL,
+    updated_at   TIMESTAMP NOT NULL
+);
+
+-- Отклоненный возврат не мешает оформить новый, пока не истек срок возврата.
+CREATE UNIQUE INDEX IF NOT EXISTS refunds_active_order_idx ON refunds (order_id) WHERE state <> 'rejected';
+
+CREATE TABLE IF NOT EXISTS refund_history
+(
+    id         BIGSERIAL PRIMARY KEY,
+    refund_id  BIGINT    NOT NULL,
+    state_from TEXT      NOT NULL DEFAULT '',
+    state_to   TEXT      NOT NULL,
+    comment    TEXT      NOT NULL DEFAULT '',
+    operator   TEXT      NOT NULL DEFAULT '',
+    changed_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS refund_history_refund_id_idx ON refund_history (refund_id, changed_at);
+
+-- Возвраты, оформленные до появления заявок, переносим без причины. Сумма считается без упаковки.
+INSERT INTO refunds (order_id, customer_id, reason, state, amount_minor, currency, created_at, updated_at)
+SELECT order_id,
+       customer_id,
+       'other',
+       CASE WHEN refunded THEN 'approved' ELSE 'requested' END,
+       cost_minor,
+       currency,
+       CASE WHEN refunded THEN refunded_time ELSE NOW() END,
+       CASE WHEN refunded THEN refunded_time ELSE NOW() END
+FROM orders
+WHERE refunded
+   OR status = 'refund_requested';
+
+INSERT INTO refund_history (refund_id, state_to, changed_at)
+SELECT refund_id, state, created_at
+FROM refunds;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS refund_history;
+DROP TABLE IF EXISTS refunds;
+-- +goose StatementEnd
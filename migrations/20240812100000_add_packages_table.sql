@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS packages
+(
+    package_type TEXT PRIMARY KEY,
+    price_minor  BIGINT    NOT NULL,
+    currency     TEXT      NOT NULL,
+    min_weight   FLOAT     NOT NULL DEFAULT 0,
+    max_weight   FLOAT     NOT NULL DEFAULT 0,
+    length_cm    INT       NOT NULL DEFAULT 0,
+    width_cm     INT       NOT NULL DEFAULT 0,
+    height_cm    INT       NOT NULL DEFAULT 0,
+    allow_wrap   BOOLEAN   NOT NULL DEFAULT FALSE,
+    retired_at   TIMESTAMP NOT NULL DEFAULT '0001-01-01',
+    created_at   TIMESTAMP NOT NULL,
+    updated_at   TIMESTAMP NOT NULL
+);
+
+-- Упаковки, которые раньше были заданы в коде, с теми же ценами и ограничениями по весу.
+INSERT INTO packages (package_type, price_minor, currency, max_weight, allow_wrap, created_at, updated_at)
+VALUES ('bag', 500, 'RUB', 10, TRUE, NOW(), NOW()),
+       ('box', 2000, 'RUB', 30, TRUE, NOW(), NOW()),
+       ('wrap', 100, 'RUB', 0, FALSE, NOW(), NOW())
+ON CONFLICT (package_type) DO NOTHING;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS packages;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE packages
+    ADD COLUMN IF NOT EXISTS kind TEXT NOT NULL DEFAULT 'container';
+
+UPDATE packages
+SET kind = 'wrapping'
+WHERE package_type = 'wrap';
+
+-- Заказ хранит слои упаковки от внутреннего к внешнему. Прежняя одиночная упаковка становится единственным слоем.
+ALTER TABLE orders
+    ALTER COLUMN package TYPE TEXT[] USING CASE
+                                               WHEN package IS NULL OR package = '' THEN '{}'::TEXT[]
+                                               ELSE ARRAY [package] END,
+    ALTER COLUMN package SET DEFAULT '{}',
+    ALTER COLUMN package SET NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    ALTER COLUMN package DROP NOT NULL,
+    ALTER COLUMN package DROP DEFAULT,
+    ALTER COLUMN package TYPE TEXT USING array_to_string(package, '+');
+
+ALTER TABLE packages
+    DROP COLUMN IF EXISTS kind;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Код выдачи заказов клиента. Хранится только HMAC кода, код в открытом виде уходит клиенту в событии.
+CREATE TABLE IF NOT EXISTS pickup_codes
+(
+    customer_id     BIGINT PRIMARY KEY,
+    code_hash       BYTEA     NOT NULL,
+    salt            BYTEA     NOT NULL,
+    failed_attempts INT       NOT NULL DEFAULT 0,
+    locked_until    TIMESTAMP NOT NULL DEFAULT '0001-01-01',
+    created_at      TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pickup_codes;
+-- +goose StatementEnd
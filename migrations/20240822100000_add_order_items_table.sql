@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS order_items
+(
+    item_id     BIGSERIAL PRIMARY KEY,
+    order_id    BIGINT NOT NULL REFERENCES orders (order_id) ON DELETE CASCADE,
+    sku         TEXT   NOT NULL DEFAULT '',
+    name        TEXT   NOT NULL,
+    price_minor BIGINT NOT NULL,
+    currency    TEXT   NOT NULL,
+    status      TEXT   NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS order_items_order_id_idx ON order_items (order_id, item_id);
+
+-- Возврат отдельных товаров перечисляет их, пустой список означает возврат заказа целиком.
+ALTER TABLE refunds
+    ADD COLUMN IF NOT EXISTS item_ids BIGINT[] NOT NULL DEFAULT '{}';
+
+-- Товары заказа можно возвращать несколькими заявками, поэтому единственным должен быть только
+-- рассматриваемый возврат, а принятые возвраты заказа могут накапливаться.
+DROP INDEX IF EXISTS refunds_active_order_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS refunds_pending_order_idx ON refunds (order_id) WHERE state IN ('requested', 'inspected');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS refunds_pending_order_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS refunds_active_order_idx ON refunds (order_id) WHERE state <> 'rejected';
+
+ALTER TABLE refunds
+    DROP COLUMN IF EXISTS item_ids;
+
+DROP TABLE IF EXISTS order_items;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS try_on_until TIMESTAMP NOT NULL DEFAULT '0001-01-01 00:00:00';
+
+-- По индексу фоновая проверка находит примерки, время которых вышло.
+CREATE INDEX IF NOT EXISTS orders_try_on_until_idx ON orders (try_on_until, order_id) WHERE status = 'trying_on';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_try_on_until_idx;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS try_on_until;
+-- +goose StatementEnd
@@ -0,0 +1,96 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS pickup_points
+(
+    point_id     TEXT PRIMARY KEY,
+    name         TEXT      NOT NULL DEFAULT '',
+    time_zone    TEXT      NOT NULL,
+    closing_time TEXT      NOT NULL DEFAULT '',
+    created_at   TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Сотрудник работает в одном пункте, по нему сервер определяет пункт каждого запроса.
+CREATE TABLE IF NOT EXISTS pickup_point_operators
+(
+    operator TEXT PRIMARY KEY,
+    point_id TEXT NOT NULL REFERENCES pickup_points (point_id)
+);
+
+-- Все, что было принято до появления нескольких пунктов, относится к пункту default.
+INSERT INTO pickup_points (point_id, name, time_zone, closing_time)
+VALUES ('default', 'default', 'Europe/Moscow', '21:00')
+ON CONFLICT (point_id) DO NOTHING;
+
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS point_id TEXT NOT NULL DEFAULT 'default' REFERENCES pickup_points (point_id);
+ALTER TABLE orders
+    ALTER COLUMN point_id DROP DEFAULT;
+
+-- История статусов переживает удаление заказа, поэтому хранит пункт сама.
+ALTER TABLE order_status_history
+    ADD COLUMN IF NOT EXISTS point_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE order_status_history
+    ALTER COLUMN point_id DROP DEFAULT;
+
+ALTER TABLE refunds
+    ADD COLUMN IF NOT EXISTS point_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE refunds
+    ALTER COLUMN point_id DROP DEFAULT;
+
+-- У клиента, который ждет заказы в нескольких пунктах, в каждом из них свой код выдачи.
+ALTER TABLE pickup_codes
+    ADD COLUMN IF NOT EXISTS point_id TEXT NOT NULL DEFAULT 'default';
+ALTER TABLE pickup_codes
+    ALTER COLUMN point_id DROP DEFAULT,
+    DROP CONSTRAINT IF EXISTS pickup_codes_pkey,
+    ADD PRIMARY KEY (point_id, customer_id);
+
+DROP INDEX IF EXISTS orders_customer_expiration_idx;
+DROP INDEX IF EXISTS orders_customer_received_idx;
+DROP INDEX IF EXISTS orders_refunded_time_idx;
+DROP INDEX IF EXISTS orders_try_on_until_idx;
+DROP INDEX IF EXISTS order_status_history_order_id_idx;
+CREATE INDEX IF NOT EXISTS orders_point_customer_expiration_idx ON orders (point_id, customer_id, expiration_time, order_id);
+CREATE INDEX IF NOT EXISTS orders_point_customer_received_idx ON orders (point_id, customer_id, received_time, order_id);
+CREATE INDEX IF NOT EXISTS orders_point_refunded_time_idx ON orders (point_id, refunded_time, order_id) WHERE refunded;
+CREATE INDEX IF NOT EXISTS orders_point_try_on_until_idx ON orders (point_id, try_on_until, order_id) WHERE status = 'trying_on';
+CREATE INDEX IF NOT EXISTS order_status_history_point_order_id_idx ON order_status_history (point_id, order_id, changed_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS order_status_history_point_order_id_idx;
+DROP INDEX IF EXISTS orders_point_try_on_until_idx;
+DROP INDEX IF EXISTS orders_point_refunded_time_idx;
+DROP INDEX IF EXISTS orders_point_customer_received_idx;
+DROP INDEX IF EXISTS orders_point_customer_expiration_idx;
+CREATE INDEX IF NOT EXISTS order_status_history_order_id_idx ON order_status_history (order_id, changed_at);
+CREATE INDEX IF NOT EXISTS orders_try_on_until_idx ON orders (try_on_until, order_id) WHERE status = 'trying_on';
+CREATE INDEX IF NOT EXISTS orders_refunded_time_idx ON orders (refunded_time, order_id) WHERE refunded;
+CREATE INDEX IF NOT EXISTS orders_customer_received_idx ON orders (customer_id, received_time, order_id);
+CREATE INDEX IF NOT EXISTS orders_customer_expiration_idx ON orders (customer_id, expiration_time, order_id);
+
+-- Коды одного клиента из разных пунктов не помещаются в прежний ключ, остается самый ранний.
+DELETE
+FROM pickup_codes c
+    USING pickup_codes other
+WHERE c.customer_id = other.customer_id
+  AND (c.created_at, c.point_id) > (other.created_at, other.point_id);
+
+ALTER TABLE pickup_codes
+    DROP CONSTRAINT IF EXISTS pickup_codes_pkey,
+    ADD PRIMARY KEY (customer_id),
+    DROP COLUMN IF EXISTS point_id;
+
+ALTER TABLE refunds
+    DROP COLUMN IF EXISTS point_id;
+
+ALTER TABLE order_status_history
+    DROP COLUMN IF EXISTS point_id;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS point_id;
+
+DROP TABLE IF EXISTS pickup_point_operators;
+DROP TABLE IF EXISTS pickup_points;
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS cells
+(
+    point_id    TEXT      NOT NULL REFERENCES pickup_points (point_id),
+    code        TEXT      NOT NULL,
+    zone        TEXT      NOT NULL,
+    rack        INT       NOT NULL,
+    shelf       INT       NOT NULL,
+    capacity    INT       NOT NULL,
+    max_weight  REAL      NOT NULL DEFAULT 0,
+    length_cm   INT       NOT NULL DEFAULT 0,
+    width_cm    INT       NOT NULL DEFAULT 0,
+    height_cm   INT       NOT NULL DEFAULT 0,
+    -- Обновляется при каждой раскладке заказа в ячейку, чтобы параллельные раскладки в одну ячейку
+    -- не переполнили ее: вторая транзакция завершится ошибкой сериализации.
+    assigned_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (point_id, code)
+);
+
+-- Заказы, принятые до появления ячеек, и заказы пунктов без схемы ячеек лежат без ячейки.
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS cell TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS orders_point_cell_idx ON orders (point_id, cell) WHERE cell <> '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_point_cell_idx;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS cell;
+
+DROP TABLE IF EXISTS cells;
+-- +goose StatementEnd
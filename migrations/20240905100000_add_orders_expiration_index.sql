@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- По индексу фоновая проверка находит заказы, срок хранения которых истек, а просрочка еще не записана в историю.
+CREATE INDEX IF NOT EXISTS orders_point_expiration_idx ON orders (point_id, expiration_time, order_id)
+    WHERE status IN ('accepted', 'ready_for_pickup');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS orders_point_expiration_idx;
+-- +goose StatementEnd
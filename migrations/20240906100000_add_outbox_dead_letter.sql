@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- События, которые нельзя прочитать или отправить, откладываются в сторону, чтобы не останавливать ретранслятор.
+-- failed_at Когда событие отложено, failure Причина. Такие события разбираются вручную.
+ALTER TABLE outbox
+    ADD COLUMN IF NOT EXISTS failed_at TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS failure   TEXT NOT NULL DEFAULT '';
+
+DROP INDEX IF EXISTS outbox_unpublished_idx;
+CREATE INDEX IF NOT EXISTS outbox_unpublished_idx ON outbox (id) WHERE published_at IS NULL AND failed_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS outbox_unpublished_idx;
+CREATE INDEX IF NOT EXISTS outbox_unpublished_idx ON outbox (id) WHERE published_at IS NULL;
+
+ALTER TABLE outbox
+    DROP COLUMN IF EXISTS failure,
+    DROP COLUMN IF EXISTS failed_at;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Внешний номер уникален в пределах пункта: один и тот же номер продавца может прийти в разные пункты,
+-- а поиск по внешнему номеру всегда ограничен пунктом запроса.
+DROP INDEX IF EXISTS orders_external_ref_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS orders_point_external_ref_idx ON orders (point_id, external_source, external_number);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Откат не удастся, если один внешний номер уже принят в нескольких пунктах: такие заказы разбираются вручную.
+DROP INDEX IF EXISTS orders_point_external_ref_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS orders_external_ref_idx ON orders (external_source, external_number);
+-- +goose StatementEnd
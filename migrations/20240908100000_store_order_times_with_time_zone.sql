@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Срок хранения считается по часам пункта выдачи, а остальные моменты по часам сервера. В колонке без часового
+-- пояса драйвер сохранял показания часов как время UTC, и сроки пунктов восточнее UTC истекали позже, чем нужно.
+-- С часовым поясом хранится момент времени, и сравнения не зависят от пояса, в котором он был записан.
+ALTER TABLE orders
+    ALTER COLUMN expiration_time TYPE TIMESTAMPTZ USING expiration_time AT TIME ZONE 'UTC',
+    ALTER COLUMN received_time DROP DEFAULT,
+    ALTER COLUMN received_time TYPE TIMESTAMPTZ USING received_time AT TIME ZONE 'UTC',
+    ALTER COLUMN received_time SET DEFAULT '0001-01-01 00:00:00+00',
+    ALTER COLUMN refunded_time DROP DEFAULT,
+    ALTER COLUMN refunded_time TYPE TIMESTAMPTZ USING refunded_time AT TIME ZONE 'UTC',
+    ALTER COLUMN refunded_time SET DEFAULT '0001-01-01 00:00:00+00',
+    ALTER COLUMN try_on_until DROP DEFAULT,
+    ALTER COLUMN try_on_until TYPE TIMESTAMPTZ USING try_on_until AT TIME ZONE 'UTC',
+    ALTER COLUMN try_on_until SET DEFAULT '0001-01-01 00:00:00+00';
+
+ALTER TABLE order_status_history
+    ALTER COLUMN changed_at TYPE TIMESTAMPTZ USING changed_at AT TIME ZONE 'UTC';
+
+-- Записанные сроки хранения - показания часов пункта, они переводятся в момент по поясу пункта.
+-- Просрочка датируется сроком хранения, поэтому ее записи в истории переводятся так же.
+UPDATE order_status_history h
+SET changed_at = (h.changed_at AT TIME ZONE 'UTC') AT TIME ZONE p.time_zone
+FROM orders o
+         JOIN pickup_points p ON p.point_id = o.point_id
+WHERE h.order_id = o.order_id
+  AND h.status_to = 'expired'
+  AND h.changed_at = o.expiration_time;
+
+UPDATE orders o
+SET expiration_time = (o.expiration_time AT TIME ZONE 'UTC') AT TIME ZONE p.time_zone
+FROM pickup_points p
+WHERE p.point_id = o.point_id;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Сроки хранения остаются моментами в UTC и не переводятся обратно в показания часов пункта.
+ALTER TABLE order_status_history
+    ALTER COLUMN changed_at TYPE TIMESTAMP USING changed_at AT TIME ZONE 'UTC';
+
+ALTER TABLE orders
+    ALTER COLUMN try_on_until DROP DEFAULT,
+    ALTER COLUMN try_on_until TYPE TIMESTAMP USING try_on_until AT TIME ZONE 'UTC',
+    ALTER COLUMN try_on_until SET DEFAULT '0001-01-01 00:00:00',
+    ALTER COLUMN refunded_time DROP DEFAULT,
+    ALTER COLUMN refunded_time TYPE TIMESTAMP USING refunded_time AT TIME ZONE 'UTC',
+    ALTER COLUMN refunded_time SET DEFAULT '0001-01-01 00:00:00',
+    ALTER COLUMN received_time DROP DEFAULT,
+    ALTER COLUMN received_time TYPE TIMESTAMP USING received_time AT TIME ZONE 'UTC',
+    ALTER COLUMN received_time SET DEFAULT '0001-01-01 00:00:00',
+    ALTER COLUMN expiration_time TYPE TIMESTAMP USING expiration_time AT TIME ZONE 'UTC';
+-- +goose StatementEnd
@@ -0,0 +1,8 @@
+package openapiv2
+
+import _ "embed"
+
+// OrdersSpec OpenAPI-документ OrdersService, сгенерированный protoc-gen-openapiv2 из orders.proto.
+//
+//go:embed orders_grpc/v1/orders.swagger.json
+var OrdersSpec []byte
@@ -7,6 +7,9 @@
 package orders_grpc
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
@@ -22,165 +25,484 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type AddOrderRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type TryOnOutcome int32
 
-	OrderId        int64   `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	CustomerId     int64   `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	ExpirationTime string  `protobuf:"bytes,3,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
-	PackageType    string  `protobuf:"bytes,4,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
-	Weight         float64 `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
-	Cost           float64 `protobuf:"fixed64,6,opt,name=cost,proto3" json:"cost,omitempty"`
-}
+const (
+	TryOnOutcome_TRY_ON_OUTCOME_UNSPECIFIED TryOnOutcome = 0
+	// Клиент забирает заказ.
+	TryOnOutcome_TRY_ON_OUTCOME_KEEP TryOnOutcome = 1
+	// Заказ возвращается на полку и ждет клиента до конца срока хранения.
+	TryOnOutcome_TRY_ON_OUTCOME_REJECT TryOnOutcome = 2
+	// Клиент отказался от заказа совсем, заказ ждет возврата курьеру.
+	TryOnOutcome_TRY_ON_OUTCOME_RETURN TryOnOutcome = 3
+)
 
-func (x *AddOrderRequest) Reset() {
-	*x = AddOrderRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_orders_grpc_v1_orders_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for TryOnOutcome.
+var (
+	TryOnOutcome_name = map[int32]string{
+		0: "TRY_ON_OUTCOME_UNSPECIFIED",
+		1: "TRY_ON_OUTCOME_KEEP",
+		2: "TRY_ON_OUTCOME_REJECT",
+		3: "TRY_ON_OUTCOME_RETURN",
 	}
+	TryOnOutcome_value = map[string]int32{
+		"TRY_ON_OUTCOME_UNSPECIFIED": 0,
+		"TRY_ON_OUTCOME_KEEP":        1,
+		"TRY_ON_OUTCOME_REJECT":      2,
+		"TRY_ON_OUTCOME_RETURN":      3,
+	}
+)
+
+func (x TryOnOutcome) Enum() *TryOnOutcome {
+	p := new(TryOnOutcome)
+	*p = x
+	return p
 }
 
-func (x *AddOrderRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x TryOnOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*AddOrderRequest) ProtoMessage() {}
+func (TryOnOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_grpc_v1_orders_proto_enumTypes[0].Descriptor()
+}
 
-func (x *AddOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_grpc_v1_orders_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (TryOnOutcome) Type() protoreflect.EnumType {
+	return &file_orders_grpc_v1_orders_proto_enumTypes[0]
 }
 
-// Deprecated: Use AddOrderRequest.ProtoReflect.Descriptor instead.
-func (*AddOrderRequest) Descriptor() ([]byte, []int) {
+func (x TryOnOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TryOnOutcome.Descriptor instead.
+func (TryOnOutcome) EnumDescriptor() ([]byte, []int) {
 	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *AddOrderRequest) GetOrderId() int64 {
-	if x != nil {
-		return x.OrderId
+type OrderSort int32
+
+const (
+	// По сроку хранения.
+	OrderSort_ORDER_SORT_UNSPECIFIED     OrderSort = 0
+	OrderSort_ORDER_SORT_EXPIRATION_TIME OrderSort = 1
+	OrderSort_ORDER_SORT_RECEIVED_TIME   OrderSort = 2
+)
+
+// Enum value maps for OrderSort.
+var (
+	OrderSort_name = map[int32]string{
+		0: "ORDER_SORT_UNSPECIFIED",
+		1: "ORDER_SORT_EXPIRATION_TIME",
+		2: "ORDER_SORT_RECEIVED_TIME",
 	}
-	return 0
+	OrderSort_value = map[string]int32{
+		"ORDER_SORT_UNSPECIFIED":     0,
+		"ORDER_SORT_EXPIRATION_TIME": 1,
+		"ORDER_SORT_RECEIVED_TIME":   2,
+	}
+)
+
+func (x OrderSort) Enum() *OrderSort {
+	p := new(OrderSort)
+	*p = x
+	return p
 }
 
-func (x *AddOrderRequest) GetCustomerId() int64 {
-	if x != nil {
-		return x.CustomerId
-	}
-	return 0
+func (x OrderSort) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *AddOrderRequest) GetExpirationTime() string {
-	if x != nil {
-		return x.ExpirationTime
-	}
-	return ""
+func (OrderSort) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_grpc_v1_orders_proto_enumTypes[1].Descriptor()
 }
 
-func (x *AddOrderRequest) GetPackageType() string {
-	if x != nil {
-		return x.PackageType
+func (OrderSort) Type() protoreflect.EnumType {
+	return &file_orders_grpc_v1_orders_proto_enumTypes[1]
+}
+
+func (x OrderSort) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderSort.Descriptor instead.
+func (OrderSort) EnumDescriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{1}
+}
+
+type OrderState int32
+
+const (
+	OrderState_ORDER_STATE_UNSPECIFIED OrderState = 0
+	// Заказ лежит в пункте выдачи и ждет клиента.
+	OrderState_ORDER_STATE_AT_POINT OrderState = 1
+	// Клиент забрал заказ и не вернул его.
+	OrderState_ORDER_STATE_RECEIVED OrderState = 2
+	// Клиент вернул заказ в пункт выдачи.
+	OrderState_ORDER_STATE_REFUNDED OrderState = 3
+)
+
+// Enum value maps for OrderState.
+var (
+	OrderState_name = map[int32]string{
+		0: "ORDER_STATE_UNSPECIFIED",
+		1: "ORDER_STATE_AT_POINT",
+		2: "ORDER_STATE_RECEIVED",
+		3: "ORDER_STATE_REFUNDED",
 	}
-	return ""
+	OrderState_value = map[string]int32{
+		"ORDER_STATE_UNSPECIFIED": 0,
+		"ORDER_STATE_AT_POINT":    1,
+		"ORDER_STATE_RECEIVED":    2,
+		"ORDER_STATE_REFUNDED":    3,
+	}
+)
+
+func (x OrderState) Enum() *OrderState {
+	p := new(OrderState)
+	*p = x
+	return p
 }
 
-func (x *AddOrderRequest) GetWeight() float64 {
-	if x != nil {
-		return x.Weight
+func (x OrderState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderState) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_grpc_v1_orders_proto_enumTypes[2].Descriptor()
+}
+
+func (OrderState) Type() protoreflect.EnumType {
+	return &file_orders_grpc_v1_orders_proto_enumTypes[2]
+}
+
+func (x OrderState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderState.Descriptor instead.
+func (OrderState) EnumDescriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{2}
+}
+
+type RefundReason int32
+
+const (
+	// Принимается как REFUND_REASON_OTHER для клиентов, которые не передают причину.
+	RefundReason_REFUND_REASON_UNSPECIFIED      RefundReason = 0
+	RefundReason_REFUND_REASON_DAMAGED          RefundReason = 1
+	RefundReason_REFUND_REASON_WRONG_ITEM       RefundReason = 2
+	RefundReason_REFUND_REASON_NOT_AS_DESCRIBED RefundReason = 3
+	RefundReason_REFUND_REASON_DEFECTIVE        RefundReason = 4
+	RefundReason_REFUND_REASON_CHANGED_MIND     RefundReason = 5
+	RefundReason_REFUND_REASON_OTHER            RefundReason = 6
+)
+
+// Enum value maps for RefundReason.
+var (
+	RefundReason_name = map[int32]string{
+		0: "REFUND_REASON_UNSPECIFIED",
+		1: "REFUND_REASON_DAMAGED",
+		2: "REFUND_REASON_WRONG_ITEM",
+		3: "REFUND_REASON_NOT_AS_DESCRIBED",
+		4: "REFUND_REASON_DEFECTIVE",
+		5: "REFUND_REASON_CHANGED_MIND",
+		6: "REFUND_REASON_OTHER",
 	}
-	return 0
+	RefundReason_value = map[string]int32{
+		"REFUND_REASON_UNSPECIFIED":      0,
+		"REFUND_REASON_DAMAGED":          1,
+		"REFUND_REASON_WRONG_ITEM":       2,
+		"REFUND_REASON_NOT_AS_DESCRIBED": 3,
+		"REFUND_REASON_DEFECTIVE":        4,
+		"REFUND_REASON_CHANGED_MIND":     5,
+		"REFUND_REASON_OTHER":            6,
+	}
+)
+
+func (x RefundReason) Enum() *RefundReason {
+	p := new(RefundReason)
+	*p = x
+	return p
 }
 
-func (x *AddOrderRequest) GetCost() float64 {
-	if x != nil {
-		return x.Cost
+func (x RefundReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_grpc_v1_orders_proto_enumTypes[3].Descriptor()
+}
+
+func (RefundReason) Type() protoreflect.EnumType {
+	return &file_orders_grpc_v1_orders_proto_enumTypes[3]
+}
+
+func (x RefundReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundReason.Descriptor instead.
+func (RefundReason) EnumDescriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{3}
+}
+
+type RefundState int32
+
+const (
+	RefundState_REFUND_STATE_UNSPECIFIED RefundState = 0
+	// Клиент оформил возврат.
+	RefundState_REFUND_STATE_REQUESTED RefundState = 1
+	// Сотрудник осмотрел заказ, решение еще не принято.
+	RefundState_REFUND_STATE_INSPECTED RefundState = 2
+	RefundState_REFUND_STATE_APPROVED  RefundState = 3
+	RefundState_REFUND_STATE_REJECTED  RefundState = 4
+	// Принятый возврат передан курьеру вместе с заказом.
+	RefundState_REFUND_STATE_HANDED_TO_COURIER RefundState = 5
+)
+
+// Enum value maps for RefundState.
+var (
+	RefundState_name = map[int32]string{
+		0: "REFUND_STATE_UNSPECIFIED",
+		1: "REFUND_STATE_REQUESTED",
+		2: "REFUND_STATE_INSPECTED",
+		3: "REFUND_STATE_APPROVED",
+		4: "REFUND_STATE_REJECTED",
+		5: "REFUND_STATE_HANDED_TO_COURIER",
 	}
-	return 0
+	RefundState_value = map[string]int32{
+		"REFUND_STATE_UNSPECIFIED":       0,
+		"REFUND_STATE_REQUESTED":         1,
+		"REFUND_STATE_INSPECTED":         2,
+		"REFUND_STATE_APPROVED":          3,
+		"REFUND_STATE_REJECTED":          4,
+		"REFUND_STATE_HANDED_TO_COURIER": 5,
+	}
+)
+
+func (x RefundState) Enum() *RefundState {
+	p := new(RefundState)
+	*p = x
+	return p
 }
 
-type ReturnOrderRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x RefundState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	OrderId int64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+func (RefundState) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_grpc_v1_orders_proto_enumTypes[4].Descriptor()
 }
 
-func (x *ReturnOrderRequest) Reset() {
-	*x = ReturnOrderRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_orders_grpc_v1_orders_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (RefundState) Type() protoreflect.EnumType {
+	return &file_orders_grpc_v1_orders_proto_enumTypes[4]
+}
+
+func (x RefundState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundState.Descriptor instead.
+func (RefundState) EnumDescriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{4}
+}
+
+type RefundDecision int32
+
+const (
+	RefundDecision_REFUND_DECISION_UNSPECIFIED RefundDecision = 0
+	RefundDecision_REFUND_DECISION_INSPECT     RefundDecision = 1
+	RefundDecision_REFUND_DECISION_APPROVE     RefundDecision = 2
+	RefundDecision_REFUND_DECISION_REJECT      RefundDecision = 3
+)
+
+// Enum value maps for RefundDecision.
+var (
+	RefundDecision_name = map[int32]string{
+		0: "REFUND_DECISION_UNSPECIFIED",
+		1: "REFUND_DECISION_INSPECT",
+		2: "REFUND_DECISION_APPROVE",
+		3: "REFUND_DECISION_REJECT",
 	}
+	RefundDecision_value = map[string]int32{
+		"REFUND_DECISION_UNSPECIFIED": 0,
+		"REFUND_DECISION_INSPECT":     1,
+		"REFUND_DECISION_APPROVE":     2,
+		"REFUND_DECISION_REJECT":      3,
+	}
+)
+
+func (x RefundDecision) Enum() *RefundDecision {
+	p := new(RefundDecision)
+	*p = x
+	return p
 }
 
-func (x *ReturnOrderRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x RefundDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*ReturnOrderRequest) ProtoMessage() {}
+func (RefundDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_grpc_v1_orders_proto_enumTypes[5].Descriptor()
+}
 
-func (x *ReturnOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_grpc_v1_orders_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (RefundDecision) Type() protoreflect.EnumType {
+	return &file_orders_grpc_v1_orders_proto_enumTypes[5]
+}
+
+func (x RefundDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundDecision.Descriptor instead.
+func (RefundDecision) EnumDescriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{5}
+}
+
+type ItemStatus int32
+
+const (
+	ItemStatus_ITEM_STATUS_UNSPECIFIED ItemStatus = 0
+	// Товар ждет клиента в пункте выдачи.
+	ItemStatus_ITEM_STATUS_AT_POINT ItemStatus = 1
+	ItemStatus_ITEM_STATUS_ISSUED   ItemStatus = 2
+	// Клиент отказался от товара при выдаче, товар ждет курьера.
+	ItemStatus_ITEM_STATUS_DECLINED         ItemStatus = 3
+	ItemStatus_ITEM_STATUS_REFUND_REQUESTED ItemStatus = 4
+	// Возврат товара принят, товар ждет курьера.
+	ItemStatus_ITEM_STATUS_REFUNDED            ItemStatus = 5
+	ItemStatus_ITEM_STATUS_RETURNED_TO_COURIER ItemStatus = 6
+)
+
+// Enum value maps for ItemStatus.
+var (
+	ItemStatus_name = map[int32]string{
+		0: "ITEM_STATUS_UNSPECIFIED",
+		1: "ITEM_STATUS_AT_POINT",
+		2: "ITEM_STATUS_ISSUED",
+		3: "ITEM_STATUS_DECLINED",
+		4: "ITEM_STATUS_REFUND_REQUESTED",
+		5: "ITEM_STATUS_REFUNDED",
+		6: "ITEM_STATUS_RETURNED_TO_COURIER",
 	}
-	return mi.MessageOf(x)
+	ItemStatus_value = map[string]int32{
+		"ITEM_STATUS_UNSPECIFIED":         0,
+		"ITEM_STATUS_AT_POINT":            1,
+		"ITEM_STATUS_ISSUED":              2,
+		"ITEM_STATUS_DECLINED":            3,
+		"ITEM_STATUS_REFUND_REQUESTED":    4,
+		"ITEM_STATUS_REFUNDED":            5,
+		"ITEM_STATUS_RETURNED_TO_COURIER": 6,
+	}
+)
+
+func (x ItemStatus) Enum() *ItemStatus {
+	p := new(ItemStatus)
+	*p = x
+	return p
 }
 
-// Deprecated: Use ReturnOrderRequest.ProtoReflect.Descriptor instead.
-func (*ReturnOrderRequest) Descriptor() ([]byte, []int) {
-	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{1}
+func (x ItemStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *ReturnOrderRequest) GetOrderId() int64 {
-	if x != nil {
-		return x.OrderId
+func (ItemStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_grpc_v1_orders_proto_enumTypes[6].Descriptor()
+}
+
+func (ItemStatus) Type() protoreflect.EnumType {
+	return &file_orders_grpc_v1_orders_proto_enumTypes[6]
+}
+
+func (x ItemStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ItemStatus.Descriptor instead.
+func (ItemStatus) EnumDescriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{6}
+}
+
+type PackageKind int32
+
+const (
+	// Принимается как PACKAGE_KIND_CONTAINER.
+	PackageKind_PACKAGE_KIND_UNSPECIFIED PackageKind = 0
+	// Пакет или коробка, в которые кладется заказ.
+	PackageKind_PACKAGE_KIND_CONTAINER PackageKind = 1
+	// Пленка, которой оборачивают другую упаковку.
+	PackageKind_PACKAGE_KIND_WRAPPING PackageKind = 2
+)
+
+// Enum value maps for PackageKind.
+var (
+	PackageKind_name = map[int32]string{
+		0: "PACKAGE_KIND_UNSPECIFIED",
+		1: "PACKAGE_KIND_CONTAINER",
+		2: "PACKAGE_KIND_WRAPPING",
 	}
-	return 0
+	PackageKind_value = map[string]int32{
+		"PACKAGE_KIND_UNSPECIFIED": 0,
+		"PACKAGE_KIND_CONTAINER":   1,
+		"PACKAGE_KIND_WRAPPING":    2,
+	}
+)
+
+func (x PackageKind) Enum() *PackageKind {
+	p := new(PackageKind)
+	*p = x
+	return p
 }
 
-type ReceiveOrdersRequest struct {
+func (x PackageKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PackageKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_orders_grpc_v1_orders_proto_enumTypes[7].Descriptor()
+}
+
+func (PackageKind) Type() protoreflect.EnumType {
+	return &file_orders_grpc_v1_orders_proto_enumTypes[7]
+}
+
+func (x PackageKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PackageKind.Descriptor instead.
+func (PackageKind) EnumDescriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{7}
+}
+
+// Номер заказа в системе продавца. Номера разных продавцов могут совпадать, уникальна только пара (source, number) в пределах пункта выдачи.
+type ExternalOrderRef struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderIds []int64 `protobuf:"varint,1,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Number string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
 }
 
-func (x *ReceiveOrdersRequest) Reset() {
-	*x = ReceiveOrdersRequest{}
+func (x *ExternalOrderRef) Reset() {
+	*x = ExternalOrderRef{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orders_grpc_v1_orders_proto_msgTypes[2]
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReceiveOrdersRequest) String() string {
+func (x *ExternalOrderRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiveOrdersRequest) ProtoMessage() {}
+func (*ExternalOrderRef) ProtoMessage() {}
 
-func (x *ReceiveOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_grpc_v1_orders_proto_msgTypes[2]
+func (x *ExternalOrderRef) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,43 +513,75 @@ func (x *ReceiveOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiveOrdersRequest.ProtoReflect.Descriptor instead.
-func (*ReceiveOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ExternalOrderRef.ProtoReflect.Descriptor instead.
+func (*ExternalOrderRef) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *ReceiveOrdersRequest) GetOrderIds() []int64 {
+func (x *ExternalOrderRef) GetSource() string {
 	if x != nil {
-		return x.OrderIds
+		return x.Source
 	}
-	return nil
+	return ""
 }
 
-type ReceiveOrdersResponse struct {
+func (x *ExternalOrderRef) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+type AddOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// Types that are assignable to Order:
+	//	*AddOrderRequest_OrderId
+	//	*AddOrderRequest_External
+	Order      isAddOrderRequest_Order `protobuf_oneof:"order"`
+	CustomerId int64                   `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// Устарело: дата в формате DD-MM-YYYY, используйте expiration. Учитывается, только если expiration не задан.
+	//
+	// Deprecated: Marked as deprecated in orders_grpc/v1/orders.proto.
+	ExpirationTime string `protobuf:"bytes,3,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	// Одиночная упаковка. Учитывается, только если package_layers пуст. Значение auto выбирает самую дешевую
+	// подходящую упаковку из каталога.
+	PackageType string  `protobuf:"bytes,4,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	Weight      float64 `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Устарело: стоимость в рублях, используйте cost_money. Учитывается, только если cost_money не задан.
+	//
+	// Deprecated: Marked as deprecated in orders_grpc/v1/orders.proto.
+	Cost      float64 `protobuf:"fixed64,6,opt,name=cost,proto3" json:"cost,omitempty"`
+	CostMoney *Money  `protobuf:"bytes,7,opt,name=cost_money,json=costMoney,proto3" json:"cost_money,omitempty"`
+	// Последний день хранения: заказ хранится до закрытия пункта выдачи в эту дату по его местному времени.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// Слои упаковки от внутреннего к внешнему, например box и wrap для коробки в пленке.
+	PackageLayers []string `protobuf:"bytes,10,rep,name=package_layers,json=packageLayers,proto3" json:"package_layers,omitempty"`
+	// Товары заказа. Если переданы, стоимость заказа складывается из их цен, а item_id и status игнорируются.
+	Items []*OrderItem `protobuf:"bytes,11,rep,name=items,proto3" json:"items,omitempty"`
+	// Размеры заказа, если известны. Упаковка, в которую заказ не помещается, не принимается и не выбирается для auto.
+	Dimensions *Dimensions `protobuf:"bytes,12,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
 }
 
-func (x *ReceiveOrdersResponse) Reset() {
-	*x = ReceiveOrdersResponse{}
+func (x *AddOrderRequest) Reset() {
+	*x = AddOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orders_grpc_v1_orders_proto_msgTypes[3]
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReceiveOrdersResponse) String() string {
+func (x *AddOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReceiveOrdersResponse) ProtoMessage() {}
+func (*AddOrderRequest) ProtoMessage() {}
 
-func (x *ReceiveOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_grpc_v1_orders_proto_msgTypes[3]
+func (x *AddOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -238,98 +592,152 @@ func (x *ReceiveOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReceiveOrdersResponse.ProtoReflect.Descriptor instead.
-func (*ReceiveOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{3}
-}
+// Deprecated: Use AddOrderRequest.ProtoReflect.Descriptor instead.
+func (*AddOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{1}
+}
 
-func (x *ReceiveOrdersResponse) GetOrders() []*Order {
-	if x != nil {
-		return x.Orders
+func (m *AddOrderRequest) GetOrder() isAddOrderRequest_Order {
+	if m != nil {
+		return m.Order
 	}
 	return nil
 }
 
-type GetOrdersRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CustomerId int64 `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	N          int32 `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+// Deprecated: Marked as deprecated in orders_grpc/v1/orders.proto.
+func (x *AddOrderRequest) GetOrderId() int64 {
+	if x, ok := x.GetOrder().(*AddOrderRequest_OrderId); ok {
+		return x.OrderId
+	}
+	return 0
 }
 
-func (x *GetOrdersRequest) Reset() {
-	*x = GetOrdersRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_orders_grpc_v1_orders_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AddOrderRequest) GetExternal() *ExternalOrderRef {
+	if x, ok := x.GetOrder().(*AddOrderRequest_External); ok {
+		return x.External
 	}
+	return nil
 }
 
-func (x *GetOrdersRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AddOrderRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
 }
 
-func (*GetOrdersRequest) ProtoMessage() {}
-
-func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_grpc_v1_orders_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+// Deprecated: Marked as deprecated in orders_grpc/v1/orders.proto.
+func (x *AddOrderRequest) GetExpirationTime() string {
+	if x != nil {
+		return x.ExpirationTime
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetOrdersRequest.ProtoReflect.Descriptor instead.
-func (*GetOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{4}
+func (x *AddOrderRequest) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
 }
 
-func (x *GetOrdersRequest) GetCustomerId() int64 {
+func (x *AddOrderRequest) GetWeight() float64 {
 	if x != nil {
-		return x.CustomerId
+		return x.Weight
 	}
 	return 0
 }
 
-func (x *GetOrdersRequest) GetN() int32 {
+// Deprecated: Marked as deprecated in orders_grpc/v1/orders.proto.
+func (x *AddOrderRequest) GetCost() float64 {
 	if x != nil {
-		return x.N
+		return x.Cost
 	}
 	return 0
 }
 
-type GetOrdersResponse struct {
+func (x *AddOrderRequest) GetCostMoney() *Money {
+	if x != nil {
+		return x.CostMoney
+	}
+	return nil
+}
+
+func (x *AddOrderRequest) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *AddOrderRequest) GetPackageLayers() []string {
+	if x != nil {
+		return x.PackageLayers
+	}
+	return nil
+}
+
+func (x *AddOrderRequest) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AddOrderRequest) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+type isAddOrderRequest_Order interface {
+	isAddOrderRequest_Order()
+}
+
+type AddOrderRequest_OrderId struct {
+	// Устарело: принимается как внешний номер с источником legacy, используйте external.
+	//
+	// Deprecated: Marked as deprecated in orders_grpc/v1/orders.proto.
+	OrderId int64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3,oneof"`
+}
+
+type AddOrderRequest_External struct {
+	External *ExternalOrderRef `protobuf:"bytes,9,opt,name=external,proto3,oneof"`
+}
+
+func (*AddOrderRequest_OrderId) isAddOrderRequest_Order() {}
+
+func (*AddOrderRequest_External) isAddOrderRequest_Order() {}
+
+type AddOrderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// Идентификатор, назначенный заказу сервисом.
+	OrderId int64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Ячейка, в которую нужно положить заказ. Пустая, если у пункта нет схемы ячеек.
+	Cell string `protobuf:"bytes,2,opt,name=cell,proto3" json:"cell,omitempty"`
 }
 
-func (x *GetOrdersResponse) Reset() {
-	*x = GetOrdersResponse{}
+func (x *AddOrderResponse) Reset() {
+	*x = AddOrderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orders_grpc_v1_orders_proto_msgTypes[5]
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetOrdersResponse) String() string {
+func (x *AddOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrdersResponse) ProtoMessage() {}
+func (*AddOrderResponse) ProtoMessage() {}
 
-func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_grpc_v1_orders_proto_msgTypes[5]
+func (x *AddOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,44 +748,53 @@ func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrdersResponse.ProtoReflect.Descriptor instead.
-func (*GetOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use AddOrderResponse.ProtoReflect.Descriptor instead.
+func (*AddOrderResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetOrdersResponse) GetOrders() []*Order {
+func (x *AddOrderResponse) GetOrderId() int64 {
 	if x != nil {
-		return x.Orders
+		return x.OrderId
 	}
-	return nil
+	return 0
 }
 
-type CreateRefundRequest struct {
+func (x *AddOrderResponse) GetCell() string {
+	if x != nil {
+		return x.Cell
+	}
+	return ""
+}
+
+type ReturnOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId    int64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	CustomerId int64 `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// Types that are assignable to Order:
+	//	*ReturnOrderRequest_OrderId
+	//	*ReturnOrderRequest_External
+	Order isReturnOrderRequest_Order `protobuf_oneof:"order"`
 }
 
-func (x *CreateRefundRequest) Reset() {
-	*x = CreateRefundRequest{}
+func (x *ReturnOrderRequest) Reset() {
+	*x = ReturnOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orders_grpc_v1_orders_proto_msgTypes[6]
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateRefundRequest) String() string {
+func (x *ReturnOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRefundRequest) ProtoMessage() {}
+func (*ReturnOrderRequest) ProtoMessage() {}
 
-func (x *CreateRefundRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_grpc_v1_orders_proto_msgTypes[6]
+func (x *ReturnOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -388,51 +805,80 @@ func (x *CreateRefundRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRefundRequest.ProtoReflect.Descriptor instead.
-func (*CreateRefundRequest) Descriptor() ([]byte, []int) {
-	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ReturnOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReturnOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CreateRefundRequest) GetOrderId() int64 {
-	if x != nil {
+func (m *ReturnOrderRequest) GetOrder() isReturnOrderRequest_Order {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+func (x *ReturnOrderRequest) GetOrderId() int64 {
+	if x, ok := x.GetOrder().(*ReturnOrderRequest_OrderId); ok {
 		return x.OrderId
 	}
 	return 0
 }
 
-func (x *CreateRefundRequest) GetCustomerId() int64 {
-	if x != nil {
-		return x.CustomerId
+func (x *ReturnOrderRequest) GetExternal() *ExternalOrderRef {
+	if x, ok := x.GetOrder().(*ReturnOrderRequest_External); ok {
+		return x.External
 	}
-	return 0
+	return nil
 }
 
-type GetRefundsRequest struct {
+type isReturnOrderRequest_Order interface {
+	isReturnOrderRequest_Order()
+}
+
+type ReturnOrderRequest_OrderId struct {
+	OrderId int64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3,oneof"`
+}
+
+type ReturnOrderRequest_External struct {
+	External *ExternalOrderRef `protobuf:"bytes,2,opt,name=external,proto3,oneof"`
+}
+
+func (*ReturnOrderRequest_OrderId) isReturnOrderRequest_Order() {}
+
+func (*ReturnOrderRequest_External) isReturnOrderRequest_Order() {}
+
+// Заказы можно передать идентификаторами, внешними номерами или смешанно, хотя бы один из списков не пуст.
+type ReceiveOrdersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page  int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	OrderIds  []int64             `protobuf:"varint,1,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	Externals []*ExternalOrderRef `protobuf:"bytes,2,rep,name=externals,proto3" json:"externals,omitempty"`
+	// Код выдачи, который клиент получил в уведомлении о поступлении заказов. Код одноразовый:
+	// после выдачи на оставшиеся в пункте заказы клиенту приходит новый код.
+	PickupCode string `protobuf:"bytes,3,opt,name=pickup_code,json=pickupCode,proto3" json:"pickup_code,omitempty"`
+	// Товары, от которых клиент отказался при выдаче. Остальные товары заказов считаются выданными.
+	DeclinedItemIds []int64 `protobuf:"varint,4,rep,packed,name=declined_item_ids,json=declinedItemIds,proto3" json:"declined_item_ids,omitempty"`
 }
 
-func (x *GetRefundsRequest) Reset() {
-	*x = GetRefundsRequest{}
+func (x *ReceiveOrdersRequest) Reset() {
+	*x = ReceiveOrdersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orders_grpc_v1_orders_proto_msgTypes[7]
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRefundsRequest) String() string {
+func (x *ReceiveOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRefundsRequest) ProtoMessage() {}
+func (*ReceiveOrdersRequest) ProtoMessage() {}
 
-func (x *GetRefundsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_grpc_v1_orders_proto_msgTypes[7]
+func (x *ReceiveOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,50 +889,66 @@ func (x *GetRefundsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRefundsRequest.ProtoReflect.Descriptor instead.
-func (*GetRefundsRequest) Descriptor() ([]byte, []int) {
-	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ReceiveOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetRefundsRequest) GetPage() int32 {
+func (x *ReceiveOrdersRequest) GetOrderIds() []int64 {
 	if x != nil {
-		return x.Page
+		return x.OrderIds
 	}
-	return 0
+	return nil
 }
 
-func (x *GetRefundsRequest) GetLimit() int32 {
+func (x *ReceiveOrdersRequest) GetExternals() []*ExternalOrderRef {
 	if x != nil {
-		return x.Limit
+		return x.Externals
 	}
-	return 0
+	return nil
 }
 
-type GetRefundsResponse struct {
+func (x *ReceiveOrdersRequest) GetPickupCode() string {
+	if x != nil {
+		return x.PickupCode
+	}
+	return ""
+}
+
+func (x *ReceiveOrdersRequest) GetDeclinedItemIds() []int64 {
+	if x != nil {
+		return x.DeclinedItemIds
+	}
+	return nil
+}
+
+type ReceiveOrdersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Refunds []*Order `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// Ячейки, из которых нужно взять заказы, по порядку адресов и без повторов.
+	PickCells []string `protobuf:"bytes,2,rep,name=pick_cells,json=pickCells,proto3" json:"pick_cells,omitempty"`
 }
 
-func (x *GetRefundsResponse) Reset() {
-	*x = GetRefundsResponse{}
+func (x *ReceiveOrdersResponse) Reset() {
+	*x = ReceiveOrdersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orders_grpc_v1_orders_proto_msgTypes[8]
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetRefundsResponse) String() string {
+func (x *ReceiveOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRefundsResponse) ProtoMessage() {}
+func (*ReceiveOrdersResponse) ProtoMessage() {}
 
-func (x *GetRefundsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_grpc_v1_orders_proto_msgTypes[8]
+func (x *ReceiveOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,51 +959,55 @@ func (x *GetRefundsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRefundsResponse.ProtoReflect.Descriptor instead.
-func (*GetRefundsResponse) Descriptor() ([]byte, []int) {
-	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ReceiveOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetRefundsResponse) GetRefunds() []*Order {
+func (x *ReceiveOrdersResponse) GetOrders() []*Order {
 	if x != nil {
-		return x.Refunds
+		return x.Orders
 	}
 	return nil
 }
 
-type Order struct {
+func (x *ReceiveOrdersResponse) GetPickCells() []string {
+	if x != nil {
+		return x.PickCells
+	}
+	return nil
+}
+
+type MoveOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId        int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	CustomerId     int64                  `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
-	Received       bool                   `protobuf:"varint,4,opt,name=received,proto3" json:"received,omitempty"`
-	Refunded       bool                   `protobuf:"varint,5,opt,name=refunded,proto3" json:"refunded,omitempty"`
-	PackageType    string                 `protobuf:"bytes,6,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
-	Weight         float64                `protobuf:"fixed64,7,opt,name=weight,proto3" json:"weight,omitempty"`
-	Cost           float64                `protobuf:"fixed64,8,opt,name=cost,proto3" json:"cost,omitempty"`
-	PackCost       float64                `protobuf:"fixed64,9,opt,name=pack_cost,json=packCost,proto3" json:"pack_cost,omitempty"`
+	// Types that are assignable to Order:
+	//	*MoveOrderRequest_OrderId
+	//	*MoveOrderRequest_External
+	Order isMoveOrderRequest_Order `protobuf_oneof:"order"`
+	// Ячейка назначения, например A-01-02.
+	Cell string `protobuf:"bytes,3,opt,name=cell,proto3" json:"cell,omitempty"`
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
+func (x *MoveOrderRequest) Reset() {
+	*x = MoveOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_orders_grpc_v1_orders_proto_msgTypes[9]
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Order) String() string {
+func (x *MoveOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*MoveOrderRequest) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
-	mi := &file_orders_grpc_v1_orders_proto_msgTypes[9]
+func (x *MoveOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,72 +1018,2538 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
+// Deprecated: Use MoveOrderRequest.ProtoReflect.Descriptor instead.
+func (*MoveOrderRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *MoveOrderRequest) GetOrder() isMoveOrderRequest_Order {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+func (x *MoveOrderRequest) GetOrderId() int64 {
+	if x, ok := x.GetOrder().(*MoveOrderRequest_OrderId); ok {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *MoveOrderRequest) GetExternal() *ExternalOrderRef {
+	if x, ok := x.GetOrder().(*MoveOrderRequest_External); ok {
+		return x.External
+	}
+	return nil
+}
+
+func (x *MoveOrderRequest) GetCell() string {
+	if x != nil {
+		return x.Cell
+	}
+	return ""
+}
+
+type isMoveOrderRequest_Order interface {
+	isMoveOrderRequest_Order()
+}
+
+type MoveOrderRequest_OrderId struct {
+	OrderId int64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3,oneof"`
+}
+
+type MoveOrderRequest_External struct {
+	External *ExternalOrderRef `protobuf:"bytes,2,opt,name=external,proto3,oneof"`
+}
+
+func (*MoveOrderRequest_OrderId) isMoveOrderRequest_Order() {}
+
+func (*MoveOrderRequest_External) isMoveOrderRequest_Order() {}
+
+type MoveOrderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order *Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *MoveOrderResponse) Reset() {
+	*x = MoveOrderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveOrderResponse) ProtoMessage() {}
+
+func (x *MoveOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveOrderResponse.ProtoReflect.Descriptor instead.
+func (*MoveOrderResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MoveOrderResponse) GetOrder() *Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type StartTryOnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderIds  []int64             `protobuf:"varint,1,rep,packed,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	Externals []*ExternalOrderRef `protobuf:"bytes,2,rep,name=externals,proto3" json:"externals,omitempty"`
+	// Код выдачи, который клиент получил в уведомлении о поступлении заказов. Код одноразовый:
+	// после выдачи на оставшиеся в пункте заказы клиенту приходит новый код.
+	PickupCode string `protobuf:"bytes,3,opt,name=pickup_code,json=pickupCode,proto3" json:"pickup_code,omitempty"`
+}
+
+func (x *StartTryOnRequest) Reset() {
+	*x = StartTryOnRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartTryOnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTryOnRequest) ProtoMessage() {}
+
+func (x *StartTryOnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTryOnRequest.ProtoReflect.Descriptor instead.
+func (*StartTryOnRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StartTryOnRequest) GetOrderIds() []int64 {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+func (x *StartTryOnRequest) GetExternals() []*ExternalOrderRef {
+	if x != nil {
+		return x.Externals
+	}
+	return nil
+}
+
+func (x *StartTryOnRequest) GetPickupCode() string {
+	if x != nil {
+		return x.PickupCode
+	}
+	return ""
+}
+
+type StartTryOnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *StartTryOnResponse) Reset() {
+	*x = StartTryOnResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartTryOnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTryOnResponse) ProtoMessage() {}
+
+func (x *StartTryOnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTryOnResponse.ProtoReflect.Descriptor instead.
+func (*StartTryOnResponse) Descriptor() ([]byte, []int) {
 	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Order) GetOrderId() int64 {
+func (x *StartTryOnResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type TryOnDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId int64        `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Outcome TryOnOutcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=orders_grpc.TryOnOutcome" json:"outcome,omitempty"`
+}
+
+func (x *TryOnDecision) Reset() {
+	*x = TryOnDecision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TryOnDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TryOnDecision) ProtoMessage() {}
+
+func (x *TryOnDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TryOnDecision.ProtoReflect.Descriptor instead.
+func (*TryOnDecision) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TryOnDecision) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *TryOnDecision) GetOutcome() TryOnOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return TryOnOutcome_TRY_ON_OUTCOME_UNSPECIFIED
+}
+
+type ConfirmTryOnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Decisions []*TryOnDecision `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	// Товары забранных заказов, от которых клиент отказался.
+	DeclinedItemIds []int64 `protobuf:"varint,2,rep,packed,name=declined_item_ids,json=declinedItemIds,proto3" json:"declined_item_ids,omitempty"`
+}
+
+func (x *ConfirmTryOnRequest) Reset() {
+	*x = ConfirmTryOnRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmTryOnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTryOnRequest) ProtoMessage() {}
+
+func (x *ConfirmTryOnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTryOnRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTryOnRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ConfirmTryOnRequest) GetDecisions() []*TryOnDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+func (x *ConfirmTryOnRequest) GetDeclinedItemIds() []int64 {
+	if x != nil {
+		return x.DeclinedItemIds
+	}
+	return nil
+}
+
+type ConfirmTryOnResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *ConfirmTryOnResponse) Reset() {
+	*x = ConfirmTryOnResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfirmTryOnResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTryOnResponse) ProtoMessage() {}
+
+func (x *ConfirmTryOnResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTryOnResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTryOnResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConfirmTryOnResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type GetOrdersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CustomerId int64 `protobuf:"varint,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// Размер страницы, 0 - все заказы клиента одной страницей.
+	N int32 `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+	// Токен из next_page_token предыдущего ответа. Сортировка должна совпадать с запросом, в котором он получен.
+	PageToken   string     `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Sort        OrderSort  `protobuf:"varint,4,opt,name=sort,proto3,enum=orders_grpc.OrderSort" json:"sort,omitempty"`
+	Descending  bool       `protobuf:"varint,5,opt,name=descending,proto3" json:"descending,omitempty"`
+	State       OrderState `protobuf:"varint,6,opt,name=state,proto3,enum=orders_grpc.OrderState" json:"state,omitempty"`
+	PackageType string     `protobuf:"bytes,7,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+}
+
+func (x *GetOrdersRequest) Reset() {
+	*x = GetOrdersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersRequest) ProtoMessage() {}
+
+func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetOrdersRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *GetOrdersRequest) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *GetOrdersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetOrdersRequest) GetSort() OrderSort {
+	if x != nil {
+		return x.Sort
+	}
+	return OrderSort_ORDER_SORT_UNSPECIFIED
+}
+
+func (x *GetOrdersRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *GetOrdersRequest) GetState() OrderState {
+	if x != nil {
+		return x.State
+	}
+	return OrderState_ORDER_STATE_UNSPECIFIED
+}
+
+func (x *GetOrdersRequest) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+type GetOrdersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orders []*Order `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// Пусто на последней странице.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *GetOrdersResponse) Reset() {
+	*x = GetOrdersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersResponse) ProtoMessage() {}
+
+func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetOrdersResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *GetOrdersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateRefundRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Order:
+	//	*CreateRefundRequest_OrderId
+	//	*CreateRefundRequest_External
+	Order      isCreateRefundRequest_Order `protobuf_oneof:"order"`
+	CustomerId int64                       `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Reason     RefundReason                `protobuf:"varint,4,opt,name=reason,proto3,enum=orders_grpc.RefundReason" json:"reason,omitempty"`
+	// Пояснение клиента.
+	Comment string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Возвращаемые товары заказа. Пусто - все выданные товары или заказ целиком, если товаров у него нет.
+	ItemIds []int64 `protobuf:"varint,6,rep,packed,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+}
+
+func (x *CreateRefundRequest) Reset() {
+	*x = CreateRefundRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRefundRequest) ProtoMessage() {}
+
+func (x *CreateRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRefundRequest.ProtoReflect.Descriptor instead.
+func (*CreateRefundRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{15}
+}
+
+func (m *CreateRefundRequest) GetOrder() isCreateRefundRequest_Order {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+func (x *CreateRefundRequest) GetOrderId() int64 {
+	if x, ok := x.GetOrder().(*CreateRefundRequest_OrderId); ok {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *CreateRefundRequest) GetExternal() *ExternalOrderRef {
+	if x, ok := x.GetOrder().(*CreateRefundRequest_External); ok {
+		return x.External
+	}
+	return nil
+}
+
+func (x *CreateRefundRequest) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *CreateRefundRequest) GetReason() RefundReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RefundReason_REFUND_REASON_UNSPECIFIED
+}
+
+func (x *CreateRefundRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CreateRefundRequest) GetItemIds() []int64 {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+type isCreateRefundRequest_Order interface {
+	isCreateRefundRequest_Order()
+}
+
+type CreateRefundRequest_OrderId struct {
+	OrderId int64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3,oneof"`
+}
+
+type CreateRefundRequest_External struct {
+	External *ExternalOrderRef `protobuf:"bytes,3,opt,name=external,proto3,oneof"`
+}
+
+func (*CreateRefundRequest_OrderId) isCreateRefundRequest_Order() {}
+
+func (*CreateRefundRequest_External) isCreateRefundRequest_Order() {}
+
+type CreateRefundResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Refund *Refund `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund,omitempty"`
+}
+
+func (x *CreateRefundResponse) Reset() {
+	*x = CreateRefundResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRefundResponse) ProtoMessage() {}
+
+func (x *CreateRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRefundResponse.ProtoReflect.Descriptor instead.
+func (*CreateRefundResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateRefundResponse) GetRefund() *Refund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+type DecideRefundRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefundId int64          `protobuf:"varint,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	Decision RefundDecision `protobuf:"varint,2,opt,name=decision,proto3,enum=orders_grpc.RefundDecision" json:"decision,omitempty"`
+	// Обоснование решения. Обязательно при принятии и отклонении возврата.
+	Comment string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *DecideRefundRequest) Reset() {
+	*x = DecideRefundRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecideRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideRefundRequest) ProtoMessage() {}
+
+func (x *DecideRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideRefundRequest.ProtoReflect.Descriptor instead.
+func (*DecideRefundRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DecideRefundRequest) GetRefundId() int64 {
+	if x != nil {
+		return x.RefundId
+	}
+	return 0
+}
+
+func (x *DecideRefundRequest) GetDecision() RefundDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return RefundDecision_REFUND_DECISION_UNSPECIFIED
+}
+
+func (x *DecideRefundRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type DecideRefundResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Refund *Refund `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund,omitempty"`
+}
+
+func (x *DecideRefundResponse) Reset() {
+	*x = DecideRefundResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecideRefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecideRefundResponse) ProtoMessage() {}
+
+func (x *DecideRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecideRefundResponse.ProtoReflect.Descriptor instead.
+func (*DecideRefundResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DecideRefundResponse) GetRefund() *Refund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+type GetRefundRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefundId int64 `protobuf:"varint,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+}
+
+func (x *GetRefundRequest) Reset() {
+	*x = GetRefundRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundRequest) ProtoMessage() {}
+
+func (x *GetRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundRequest.ProtoReflect.Descriptor instead.
+func (*GetRefundRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetRefundRequest) GetRefundId() int64 {
+	if x != nil {
+		return x.RefundId
+	}
+	return 0
+}
+
+type GetRefundResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Refund *Refund        `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund,omitempty"`
+	Events []*RefundEvent `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *GetRefundResponse) Reset() {
+	*x = GetRefundResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRefundResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundResponse) ProtoMessage() {}
+
+func (x *GetRefundResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundResponse.ProtoReflect.Descriptor instead.
+func (*GetRefundResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetRefundResponse) GetRefund() *Refund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *GetRefundResponse) GetEvents() []*RefundEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Сумма возврата равна стоимости заказа без упаковки или сумме цен возвращаемых товаров.
+type Refund struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefundId   int64                  `protobuf:"varint,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	OrderId    int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId int64                  `protobuf:"varint,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Reason     RefundReason           `protobuf:"varint,4,opt,name=reason,proto3,enum=orders_grpc.RefundReason" json:"reason,omitempty"`
+	Comment    string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment,omitempty"`
+	State      RefundState            `protobuf:"varint,6,opt,name=state,proto3,enum=orders_grpc.RefundState" json:"state,omitempty"`
+	Amount     *Money                 `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Возвращаемые товары, пусто у возврата заказа без товаров.
+	ItemIds []int64 `protobuf:"varint,10,rep,packed,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Refund) GetRefundId() int64 {
+	if x != nil {
+		return x.RefundId
+	}
+	return 0
+}
+
+func (x *Refund) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Refund) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *Refund) GetReason() RefundReason {
+	if x != nil {
+		return x.Reason
+	}
+	return RefundReason_REFUND_REASON_UNSPECIFIED
+}
+
+func (x *Refund) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Refund) GetState() RefundState {
+	if x != nil {
+		return x.State
+	}
+	return RefundState_REFUND_STATE_UNSPECIFIED
+}
+
+func (x *Refund) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Refund) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Refund) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Refund) GetItemIds() []int64 {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+type RefundEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefundId  int64                  `protobuf:"varint,1,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	StateFrom string                 `protobuf:"bytes,2,opt,name=state_from,json=stateFrom,proto3" json:"state_from,omitempty"`
+	StateTo   string                 `protobuf:"bytes,3,opt,name=state_to,json=stateTo,proto3" json:"state_to,omitempty"`
+	Comment   string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	Operator  string                 `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	Time      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *RefundEvent) Reset() {
+	*x = RefundEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefundEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundEvent) ProtoMessage() {}
+
+func (x *RefundEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundEvent.ProtoReflect.Descriptor instead.
+func (*RefundEvent) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RefundEvent) GetRefundId() int64 {
+	if x != nil {
+		return x.RefundId
+	}
+	return 0
+}
+
+func (x *RefundEvent) GetStateFrom() string {
+	if x != nil {
+		return x.StateFrom
+	}
+	return ""
+}
+
+func (x *RefundEvent) GetStateTo() string {
+	if x != nil {
+		return x.StateTo
+	}
+	return ""
+}
+
+func (x *RefundEvent) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *RefundEvent) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *RefundEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type GetRefundsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Устарело: номер страницы, используйте page_token. Учитывается, только если page_token не задан.
+	//
+	// Deprecated: Marked as deprecated in orders_grpc/v1/orders.proto.
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	// Размер страницы, 0 - все возвраты одной страницей.
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Токен из next_page_token предыдущего ответа.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Начало диапазона времени возврата, включительно.
+	From *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	// Конец диапазона времени возврата, не включительно.
+	To *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+}
+
+func (x *GetRefundsRequest) Reset() {
+	*x = GetRefundsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRefundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundsRequest) ProtoMessage() {}
+
+func (x *GetRefundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundsRequest.ProtoReflect.Descriptor instead.
+func (*GetRefundsRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{23}
+}
+
+// Deprecated: Marked as deprecated in orders_grpc/v1/orders.proto.
+func (x *GetRefundsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRefundsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetRefundsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetRefundsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetRefundsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type GetRefundsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Заказы с принятым возвратом по возрастанию времени принятия.
+	Refunds []*Order `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	// Пусто на последней странице.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Число возвратов в диапазоне from-to без учета страниц.
+	TotalCount int64 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+}
+
+func (x *GetRefundsResponse) Reset() {
+	*x = GetRefundsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRefundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundsResponse) ProtoMessage() {}
+
+func (x *GetRefundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundsResponse.ProtoReflect.Descriptor instead.
+func (*GetRefundsResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetRefundsResponse) GetRefunds() []*Order {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+func (x *GetRefundsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetRefundsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetOrderHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Order:
+	//	*GetOrderHistoryRequest_OrderId
+	//	*GetOrderHistoryRequest_External
+	Order isGetOrderHistoryRequest_Order `protobuf_oneof:"order"`
+}
+
+func (x *GetOrderHistoryRequest) Reset() {
+	*x = GetOrderHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderHistoryRequest) ProtoMessage() {}
+
+func (x *GetOrderHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{25}
+}
+
+func (m *GetOrderHistoryRequest) GetOrder() isGetOrderHistoryRequest_Order {
+	if m != nil {
+		return m.Order
+	}
+	return nil
+}
+
+func (x *GetOrderHistoryRequest) GetOrderId() int64 {
+	if x, ok := x.GetOrder().(*GetOrderHistoryRequest_OrderId); ok {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *GetOrderHistoryRequest) GetExternal() *ExternalOrderRef {
+	if x, ok := x.GetOrder().(*GetOrderHistoryRequest_External); ok {
+		return x.External
+	}
+	return nil
+}
+
+type isGetOrderHistoryRequest_Order interface {
+	isGetOrderHistoryRequest_Order()
+}
+
+type GetOrderHistoryRequest_OrderId struct {
+	OrderId int64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3,oneof"`
+}
+
+type GetOrderHistoryRequest_External struct {
+	External *ExternalOrderRef `protobuf:"bytes,2,opt,name=external,proto3,oneof"`
+}
+
+func (*GetOrderHistoryRequest_OrderId) isGetOrderHistoryRequest_Order() {}
+
+func (*GetOrderHistoryRequest_External) isGetOrderHistoryRequest_Order() {}
+
+type GetOrderHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*OrderEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *GetOrderHistoryResponse) Reset() {
+	*x = GetOrderHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderHistoryResponse) ProtoMessage() {}
+
+func (x *GetOrderHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetOrderHistoryResponse) GetEvents() []*OrderEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type OrderEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId    int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	StatusFrom string                 `protobuf:"bytes,2,opt,name=status_from,json=statusFrom,proto3" json:"status_from,omitempty"`
+	StatusTo   string                 `protobuf:"bytes,3,opt,name=status_to,json=statusTo,proto3" json:"status_to,omitempty"`
+	Reason     string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Operator   string                 `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	Time       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEvent) ProtoMessage() {}
+
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OrderEvent) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderEvent) GetStatusFrom() string {
+	if x != nil {
+		return x.StatusFrom
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetStatusTo() string {
+	if x != nil {
+		return x.StatusTo
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+type Order struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId        int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId     int64                  `protobuf:"varint,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	ExpirationTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiration_time,json=expirationTime,proto3" json:"expiration_time,omitempty"`
+	Received       bool                   `protobuf:"varint,4,opt,name=received,proto3" json:"received,omitempty"`
+	Refunded       bool                   `protobuf:"varint,5,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	// Слои упаковки через +, например box+wrap.
+	PackageType string  `protobuf:"bytes,6,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	Weight      float64 `protobuf:"fixed64,7,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Deprecated: Marked as deprecated in orders_grpc/v1/orders.proto.
+	Cost float64 `protobuf:"fixed64,8,opt,name=cost,proto3" json:"cost,omitempty"`
+	// Deprecated: Marked as deprecated in orders_grpc/v1/orders.proto.
+	PackCost      float64           `protobuf:"fixed64,9,opt,name=pack_cost,json=packCost,proto3" json:"pack_cost,omitempty"`
+	CostMoney     *Money            `protobuf:"bytes,10,opt,name=cost_money,json=costMoney,proto3" json:"cost_money,omitempty"`
+	PackCostMoney *Money            `protobuf:"bytes,11,opt,name=pack_cost_money,json=packCostMoney,proto3" json:"pack_cost_money,omitempty"`
+	TotalCost     *Money            `protobuf:"bytes,12,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+	External      *ExternalOrderRef `protobuf:"bytes,13,opt,name=external,proto3" json:"external,omitempty"`
+	// Заполнено только у возвращенных заказов.
+	RefundedTime *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=refunded_time,json=refundedTime,proto3" json:"refunded_time,omitempty"`
+	// Слои упаковки от внутреннего к внешнему.
+	PackageLayers []string     `protobuf:"bytes,15,rep,name=package_layers,json=packageLayers,proto3" json:"package_layers,omitempty"`
+	Items         []*OrderItem `protobuf:"bytes,16,rep,name=items,proto3" json:"items,omitempty"`
+	// Заполнено только у заказов на примерке.
+	TryOnUntil *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=try_on_until,json=tryOnUntil,proto3" json:"try_on_until,omitempty"`
+	// Ячейка хранения, например A-01-02. Пустая у выданных заказов и в пунктах без схемы ячеек.
+	Cell string `protobuf:"bytes,18,opt,name=cell,proto3" json:"cell,omitempty"`
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Order) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Order) GetCustomerId() int64 {
+	if x != nil {
+		return x.CustomerId
+	}
+	return 0
+}
+
+func (x *Order) GetExpirationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpirationTime
+	}
+	return nil
+}
+
+func (x *Order) GetReceived() bool {
+	if x != nil {
+		return x.Received
+	}
+	return false
+}
+
+func (x *Order) GetRefunded() bool {
+	if x != nil {
+		return x.Refunded
+	}
+	return false
+}
+
+func (x *Order) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+func (x *Order) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in orders_grpc/v1/orders.proto.
+func (x *Order) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in orders_grpc/v1/orders.proto.
+func (x *Order) GetPackCost() float64 {
+	if x != nil {
+		return x.PackCost
+	}
+	return 0
+}
+
+func (x *Order) GetCostMoney() *Money {
+	if x != nil {
+		return x.CostMoney
+	}
+	return nil
+}
+
+func (x *Order) GetPackCostMoney() *Money {
+	if x != nil {
+		return x.PackCostMoney
+	}
+	return nil
+}
+
+func (x *Order) GetTotalCost() *Money {
+	if x != nil {
+		return x.TotalCost
+	}
+	return nil
+}
+
+func (x *Order) GetExternal() *ExternalOrderRef {
+	if x != nil {
+		return x.External
+	}
+	return nil
+}
+
+func (x *Order) GetRefundedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefundedTime
+	}
+	return nil
+}
+
+func (x *Order) GetPackageLayers() []string {
+	if x != nil {
+		return x.PackageLayers
+	}
+	return nil
+}
+
+func (x *Order) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetTryOnUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TryOnUntil
+	}
+	return nil
+}
+
+func (x *Order) GetCell() string {
+	if x != nil {
+		return x.Cell
+	}
+	return ""
+}
+
+// Товар заказа. Цена - стоимость позиции целиком.
+type OrderItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId int64 `protobuf:"varint,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// Артикул продавца.
+	Sku    string     `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name   string     `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Price  *Money     `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+	Status ItemStatus `protobuf:"varint,5,opt,name=status,proto3,enum=orders_grpc.ItemStatus" json:"status,omitempty"`
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *OrderItem) GetItemId() int64 {
+	if x != nil {
+		return x.ItemId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *OrderItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrderItem) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *OrderItem) GetStatus() ItemStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ItemStatus_ITEM_STATUS_UNSPECIFIED
+}
+
+// Сумма в минимальных единицах валюты (копейках для рубля).
+type Money struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AmountMinor int64 `protobuf:"varint,1,opt,name=amount_minor,json=amountMinor,proto3" json:"amount_minor,omitempty"`
+	// Код валюты ISO 4217, по умолчанию RUB.
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Money) GetAmountMinor() int64 {
+	if x != nil {
+		return x.AmountMinor
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// Внутренние размеры упаковки в сантиметрах, нули - упаковка любого размера.
+type Dimensions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LengthCm int32 `protobuf:"varint,1,opt,name=length_cm,json=lengthCm,proto3" json:"length_cm,omitempty"`
+	WidthCm  int32 `protobuf:"varint,2,opt,name=width_cm,json=widthCm,proto3" json:"width_cm,omitempty"`
+	HeightCm int32 `protobuf:"varint,3,opt,name=height_cm,json=heightCm,proto3" json:"height_cm,omitempty"`
+}
+
+func (x *Dimensions) Reset() {
+	*x = Dimensions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Dimensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dimensions) ProtoMessage() {}
+
+func (x *Dimensions) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
+func (*Dimensions) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Dimensions) GetLengthCm() int32 {
+	if x != nil {
+		return x.LengthCm
+	}
+	return 0
+}
+
+func (x *Dimensions) GetWidthCm() int32 {
+	if x != nil {
+		return x.WidthCm
+	}
+	return 0
+}
+
+func (x *Dimensions) GetHeightCm() int32 {
+	if x != nil {
+		return x.HeightCm
+	}
+	return 0
+}
+
+// Тип упаковки из каталога. Заказ принимается, если вес не меньше min_weight и меньше max_weight,
+// нулевой max_weight снимает ограничение сверху.
+type PackageType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price      *Money      `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	MinWeight  float64     `protobuf:"fixed64,3,opt,name=min_weight,json=minWeight,proto3" json:"min_weight,omitempty"`
+	MaxWeight  float64     `protobuf:"fixed64,4,opt,name=max_weight,json=maxWeight,proto3" json:"max_weight,omitempty"`
+	Dimensions *Dimensions `protobuf:"bytes,5,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	// Упаковку можно обернуть пленкой.
+	AllowWrap bool `protobuf:"varint,6,opt,name=allow_wrap,json=allowWrap,proto3" json:"allow_wrap,omitempty"`
+	// Заполняется сервисом, в запросах игнорируется.
+	RetiredAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=retired_at,json=retiredAt,proto3" json:"retired_at,omitempty"`
+	// Обертку можно надеть только на упаковку с allow_wrap, контейнер бывает только внутренним слоем.
+	Kind PackageKind `protobuf:"varint,8,opt,name=kind,proto3,enum=orders_grpc.PackageKind" json:"kind,omitempty"`
+}
+
+func (x *PackageType) Reset() {
+	*x = PackageType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PackageType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageType) ProtoMessage() {}
+
+func (x *PackageType) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageType.ProtoReflect.Descriptor instead.
+func (*PackageType) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PackageType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PackageType) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PackageType) GetMinWeight() float64 {
+	if x != nil {
+		return x.MinWeight
+	}
+	return 0
+}
+
+func (x *PackageType) GetMaxWeight() float64 {
+	if x != nil {
+		return x.MaxWeight
+	}
+	return 0
+}
+
+func (x *PackageType) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *PackageType) GetAllowWrap() bool {
+	if x != nil {
+		return x.AllowWrap
+	}
+	return false
+}
+
+func (x *PackageType) GetRetiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetiredAt
+	}
+	return nil
+}
+
+func (x *PackageType) GetKind() PackageKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PackageKind_PACKAGE_KIND_UNSPECIFIED
+}
+
+type CreatePackageTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageType *PackageType `protobuf:"bytes,1,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+}
+
+func (x *CreatePackageTypeRequest) Reset() {
+	*x = CreatePackageTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePackageTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePackageTypeRequest) ProtoMessage() {}
+
+func (x *CreatePackageTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePackageTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePackageTypeRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreatePackageTypeRequest) GetPackageType() *PackageType {
+	if x != nil {
+		return x.PackageType
+	}
+	return nil
+}
+
+type CreatePackageTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageType *PackageType `protobuf:"bytes,1,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+}
+
+func (x *CreatePackageTypeResponse) Reset() {
+	*x = CreatePackageTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePackageTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePackageTypeResponse) ProtoMessage() {}
+
+func (x *CreatePackageTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePackageTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreatePackageTypeResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreatePackageTypeResponse) GetPackageType() *PackageType {
+	if x != nil {
+		return x.PackageType
+	}
+	return nil
+}
+
+// Описание заменяется целиком. Стоимость упаковки уже принятых заказов не меняется.
+type UpdatePackageTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageType *PackageType `protobuf:"bytes,1,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+}
+
+func (x *UpdatePackageTypeRequest) Reset() {
+	*x = UpdatePackageTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePackageTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePackageTypeRequest) ProtoMessage() {}
+
+func (x *UpdatePackageTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePackageTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePackageTypeRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdatePackageTypeRequest) GetPackageType() *PackageType {
+	if x != nil {
+		return x.PackageType
+	}
+	return nil
+}
+
+type UpdatePackageTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageType *PackageType `protobuf:"bytes,1,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+}
+
+func (x *UpdatePackageTypeResponse) Reset() {
+	*x = UpdatePackageTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdatePackageTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePackageTypeResponse) ProtoMessage() {}
+
+func (x *UpdatePackageTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePackageTypeResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePackageTypeResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdatePackageTypeResponse) GetPackageType() *PackageType {
+	if x != nil {
+		return x.PackageType
+	}
+	return nil
+}
+
+// Выведенный из оборота тип остается в каталоге, но новые заказы в нем не принимаются.
+type RetirePackageTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *RetirePackageTypeRequest) Reset() {
+	*x = RetirePackageTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetirePackageTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetirePackageTypeRequest) ProtoMessage() {}
+
+func (x *RetirePackageTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetirePackageTypeRequest.ProtoReflect.Descriptor instead.
+func (*RetirePackageTypeRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RetirePackageTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RetirePackageTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageType *PackageType `protobuf:"bytes,1,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+}
+
+func (x *RetirePackageTypeResponse) Reset() {
+	*x = RetirePackageTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetirePackageTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetirePackageTypeResponse) ProtoMessage() {}
+
+func (x *RetirePackageTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetirePackageTypeResponse.ProtoReflect.Descriptor instead.
+func (*RetirePackageTypeResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RetirePackageTypeResponse) GetPackageType() *PackageType {
+	if x != nil {
+		return x.PackageType
+	}
+	return nil
+}
+
+type ListPackageTypesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncludeRetired bool `protobuf:"varint,1,opt,name=include_retired,json=includeRetired,proto3" json:"include_retired,omitempty"`
+}
+
+func (x *ListPackageTypesRequest) Reset() {
+	*x = ListPackageTypesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPackageTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPackageTypesRequest) ProtoMessage() {}
+
+func (x *ListPackageTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPackageTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListPackageTypesRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListPackageTypesRequest) GetIncludeRetired() bool {
+	if x != nil {
+		return x.IncludeRetired
+	}
+	return false
+}
+
+type ListPackageTypesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackageTypes []*PackageType `protobuf:"bytes,1,rep,name=package_types,json=packageTypes,proto3" json:"package_types,omitempty"`
+}
+
+func (x *ListPackageTypesResponse) Reset() {
+	*x = ListPackageTypesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPackageTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPackageTypesResponse) ProtoMessage() {}
+
+func (x *ListPackageTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPackageTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListPackageTypesResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListPackageTypesResponse) GetPackageTypes() []*PackageType {
 	if x != nil {
-		return x.OrderId
+		return x.PackageTypes
 	}
-	return 0
+	return nil
 }
 
-func (x *Order) GetCustomerId() int64 {
+type GetQuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weight float64 `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Размеры заказа, если известны. Заказ можно повернуть, поэтому порядок сторон не важен.
+	Dimensions *Dimensions `protobuf:"bytes,2,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	// Стоимость заказа для расчета total_cost, по умолчанию ноль.
+	CostMoney *Money `protobuf:"bytes,3,opt,name=cost_money,json=costMoney,proto3" json:"cost_money,omitempty"`
+}
+
+func (x *GetQuoteRequest) Reset() {
+	*x = GetQuoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuoteRequest) ProtoMessage() {}
+
+func (x *GetQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetQuoteRequest) GetWeight() float64 {
 	if x != nil {
-		return x.CustomerId
+		return x.Weight
 	}
 	return 0
 }
 
-func (x *Order) GetExpirationTime() *timestamppb.Timestamp {
+func (x *GetQuoteRequest) GetDimensions() *Dimensions {
 	if x != nil {
-		return x.ExpirationTime
+		return x.Dimensions
 	}
 	return nil
 }
 
-func (x *Order) GetReceived() bool {
+func (x *GetQuoteRequest) GetCostMoney() *Money {
 	if x != nil {
-		return x.Received
+		return x.CostMoney
 	}
-	return false
+	return nil
 }
 
-func (x *Order) GetRefunded() bool {
-	if x != nil {
-		return x.Refunded
+type PackageQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Слои через +, как в Order.package_type.
+	PackageType   string   `protobuf:"bytes,1,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	PackageLayers []string `protobuf:"bytes,2,rep,name=package_layers,json=packageLayers,proto3" json:"package_layers,omitempty"`
+	PackCost      *Money   `protobuf:"bytes,3,opt,name=pack_cost,json=packCost,proto3" json:"pack_cost,omitempty"`
+	// Стоимость заказа вместе с упаковкой, как Order.total_cost.
+	TotalCost *Money `protobuf:"bytes,4,opt,name=total_cost,json=totalCost,proto3" json:"total_cost,omitempty"`
+}
+
+func (x *PackageQuote) Reset() {
+	*x = PackageQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *Order) GetPackageType() string {
+func (x *PackageQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageQuote) ProtoMessage() {}
+
+func (x *PackageQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageQuote.ProtoReflect.Descriptor instead.
+func (*PackageQuote) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PackageQuote) GetPackageType() string {
 	if x != nil {
 		return x.PackageType
 	}
 	return ""
 }
 
-func (x *Order) GetWeight() float64 {
+func (x *PackageQuote) GetPackageLayers() []string {
 	if x != nil {
-		return x.Weight
+		return x.PackageLayers
 	}
-	return 0
+	return nil
 }
 
-func (x *Order) GetCost() float64 {
+func (x *PackageQuote) GetPackCost() *Money {
 	if x != nil {
-		return x.Cost
+		return x.PackCost
 	}
-	return 0
+	return nil
 }
 
-func (x *Order) GetPackCost() float64 {
+func (x *PackageQuote) GetTotalCost() *Money {
 	if x != nil {
-		return x.PackCost
+		return x.TotalCost
 	}
-	return 0
+	return nil
+}
+
+type GetQuoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Самый дешевый вариант первый. Пустой список означает, что заказ не подходит ни одной упаковке.
+	Options []*PackageQuote `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *GetQuoteResponse) Reset() {
+	*x = GetQuoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_orders_grpc_v1_orders_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuoteResponse) ProtoMessage() {}
+
+func (x *GetQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_orders_grpc_v1_orders_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuoteResponse.ProtoReflect.Descriptor instead.
+func (*GetQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_orders_grpc_v1_orders_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetQuoteResponse) GetOptions() []*PackageQuote {
+	if x != nil {
+		return x.Options
+	}
+	return nil
 }
 
 var File_orders_grpc_v1_orders_proto protoreflect.FileDescriptor
@@ -625,107 +3557,703 @@ var File_orders_grpc_v1_orders_proto protoreflect.FileDescriptor
 var file_orders_grpc_v1_orders_proto_rawDesc = []byte{
 	0x0a, 0x1b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31,
 	0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x64,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74,
-	0x22, 0x2f, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x33, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2a, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x41, 0x0a, 0x10, 0x47,
-	0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x01, 0x6e, 0x22, 0x3f,
-	0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
+	0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x58, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x65, 0x66, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18,
+	0x40, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x89, 0x05, 0x0a, 0x0f, 0x41, 0x64,
+	0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x09, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x18, 0x01, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x0f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xfa, 0x42, 0x23, 0x72, 0x21, 0x32, 0x1f, 0x5e, 0x28,
+	0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32,
+	0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x29, 0x3f, 0x24, 0x18, 0x01, 0x52,
+	0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x63, 0x6f,
+	0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x10, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74,
+	0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x35, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x92, 0x01, 0x08, 0x10,
+	0x04, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x4c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x92, 0x01, 0x02, 0x10, 0x64, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x37,
+	0x0a, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x64, 0x69, 0x6d,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x41, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x65, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x65, 0x6c, 0x6c, 0x22, 0x85, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x24, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01,
+	0x22, 0xee, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x09, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0c, 0xfa, 0x42,
+	0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x52, 0x09, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x73, 0x12, 0x34, 0x0a, 0x0b, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xfa, 0x42, 0x10, 0x72, 0x0e, 0x32, 0x0c, 0x5e,
+	0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x2c, 0x38, 0x7d, 0x24, 0x52, 0x0a, 0x70, 0x69, 0x63,
+	0x6b, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x38, 0x0a, 0x11, 0x64, 0x65, 0x63, 0x6c, 0x69,
+	0x6e, 0x65, 0x64, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x03, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x0f, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64,
+	0x73, 0x22, 0x62, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x69, 0x63, 0x6b, 0x5f, 0x63,
+	0x65, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x69, 0x63, 0x6b,
+	0x43, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x3b, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x66, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x1d, 0x0a,
+	0x04, 0x63, 0x65, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06,
+	0x72, 0x04, 0x10, 0x01, 0x18, 0x20, 0x52, 0x04, 0x63, 0x65, 0x6c, 0x6c, 0x42, 0x0c, 0x0a, 0x05,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x3d, 0x0a, 0x11, 0x4d, 0x6f,
+	0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0xb1, 0x01, 0x0a, 0x11, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x72, 0x79, 0x4f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x03, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x3b, 0x0a, 0x09, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x52, 0x09, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x70, 0x69, 0x63, 0x6b, 0x75,
+	0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x13, 0xfa, 0x42,
+	0x10, 0x72, 0x0e, 0x32, 0x0c, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x2c, 0x38, 0x7d,
+	0x24, 0x52, 0x0a, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x40, 0x0a,
+	0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x79, 0x4f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
 	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70,
 	0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22,
-	0x51, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
+	0x74, 0x0a, 0x0d, 0x54, 0x72, 0x79, 0x4f, 0x6e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x79, 0x4f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x54, 0x72, 0x79, 0x4f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a,
+	0x09, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54,
+	0x72, 0x79, 0x4f, 0x6e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x38, 0x0a, 0x11, 0x64, 0x65, 0x63, 0x6c, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x69, 0x74,
+	0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0c, 0xfa, 0x42,
+	0x09, 0x92, 0x01, 0x06, 0x22, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0f, 0x64, 0x65, 0x63, 0x6c,
+	0x69, 0x6e, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x22, 0x42, 0x0a, 0x14, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x72, 0x79, 0x4f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22,
+	0xa7, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x01, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x1a, 0x05,
+	0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x01, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x67, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a,
+	0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xba, 0x02, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x3b, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x66, 0x48, 0x00, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x28, 0x0a,
+	0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x0a, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0x92,
+	0x01, 0x06, 0x22, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64,
+	0x73, 0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22,
+	0x43, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x22, 0xa4, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x49, 0x64, 0x12, 0x43, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x08, 0x64,
+	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18,
+	0xe8, 0x07, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x43, 0x0a, 0x14, 0x44,
+	0x65, 0x63, 0x69, 0x64, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x22, 0x38, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x9b,
+	0x03, 0x0a, 0x06, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49,
 	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x3d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x22, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x75, 0x6e,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x07, 0x72, 0x65,
-	0x66, 0x75, 0x6e, 0x64, 0x73, 0x22, 0xac, 0x02, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
-	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x5f,
-	0x63, 0x6f, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b,
-	0x43, 0x6f, 0x73, 0x74, 0x32, 0xd6, 0x03, 0x0a, 0x0d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x12, 0x1c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63,
-	0x2e, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
-	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x12, 0x56, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x12, 0x21, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72,
-	0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67,
-	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72,
-	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x66, 0x75, 0x6e, 0x64, 0x12, 0x20, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x2a, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x22, 0xca, 0x01, 0x0a,
+	0x0b, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x18, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x20,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xfa,
+	0x42, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x8b, 0x01, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x07, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48,
+	0x00, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x08, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x4a, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2f, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x22, 0xc9, 0x01, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1b, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2e, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xf9, 0x05,
+	0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x04, 0x63,
+	0x6f, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04, 0x63,
+	0x6f, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x73, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b,
+	0x43, 0x6f, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x6e,
+	0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x63, 0x6f,
+	0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x0f, 0x70, 0x61, 0x63, 0x6b, 0x5f,
+	0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x73, 0x74, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x73,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x66, 0x52, 0x08, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x12, 0x3f, 0x0a, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x74, 0x72, 0x79, 0x5f, 0x6f,
+	0x6e, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x74, 0x72, 0x79, 0x4f, 0x6e,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x65, 0x6c, 0x6c, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x65, 0x6c, 0x6c, 0x22, 0xc4, 0x01, 0x0a, 0x09, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x1e, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05,
+	0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x49, 0x74,
+	0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x65, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x0c, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xfa, 0x42, 0x11, 0x72, 0x0f, 0x32, 0x0d,
+	0x5e, 0x28, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x7b, 0x33, 0x7d, 0x29, 0x3f, 0x24, 0x52, 0x08, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x7c, 0x0a, 0x0a, 0x44, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x09, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f,
+	0x63, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28,
+	0x00, 0x52, 0x08, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x43, 0x6d, 0x12, 0x22, 0x0a, 0x08, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x5f, 0x63, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x07, 0x77, 0x69, 0x64, 0x74, 0x68, 0x43, 0x6d, 0x12,
+	0x24, 0x0a, 0x09, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x63, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x43, 0x6d, 0x22, 0x94, 0x03, 0x0a, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1e, 0xfa, 0x42, 0x1b, 0x72, 0x19, 0x32, 0x17, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x2d, 0x5d, 0x7b, 0x30, 0x2c, 0x33,
+	0x31, 0x7d, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2d, 0x0a,
+	0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2d, 0x0a, 0x0a,
+	0x6d, 0x61, 0x78, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x52, 0x09, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x64,
+	0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x77, 0x72,
+	0x61, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x57,
+	0x72, 0x61, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x61, 0x0a, 0x18,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0x58, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x61, 0x0a, 0x18, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x58, 0x0a, 0x19,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x37, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x58, 0x0a, 0x19, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x0c,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x42, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x22, 0x59, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xfa, 0x42,
+	0x0b, 0x12, 0x09, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a,
+	0x0a, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x22, 0xbc, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x4c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x70,
+	0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x08, 0x70, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x73, 0x74, 0x22,
+	0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x7d, 0x0a, 0x0c, 0x54, 0x72, 0x79, 0x4f,
+	0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x54, 0x52, 0x59, 0x5f,
+	0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x52, 0x59, 0x5f,
+	0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x10,
+	0x01, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x52, 0x59, 0x5f, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x43,
+	0x4f, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15,
+	0x54, 0x52, 0x59, 0x5f, 0x4f, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x52,
+	0x45, 0x54, 0x55, 0x52, 0x4e, 0x10, 0x03, 0x2a, 0x65, 0x0a, 0x09, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x53, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x4f,
+	0x52, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1e, 0x0a, 0x1a, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x45,
+	0x58, 0x50, 0x49, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01,
+	0x12, 0x1c, 0x0a, 0x18, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x52,
+	0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x2a, 0x77,
+	0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x17,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x54, 0x5f, 0x50, 0x4f, 0x49, 0x4e,
+	0x54, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x18, 0x0a,
+	0x14, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x46,
+	0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xe0, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x46, 0x55,
+	0x4e, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x46, 0x55, 0x4e,
+	0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x41, 0x4d, 0x41, 0x47, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x10, 0x02,
+	0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x53, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x42,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10,
+	0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x5f, 0x4d, 0x49, 0x4e, 0x44, 0x10,
+	0x05, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x06, 0x2a, 0xbd, 0x01, 0x0a, 0x0b, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45,
+	0x46, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x46, 0x55,
+	0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x52,
+	0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x54, 0x4f,
+	0x5f, 0x43, 0x4f, 0x55, 0x52, 0x49, 0x45, 0x52, 0x10, 0x05, 0x2a, 0x87, 0x01, 0x0a, 0x0e, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x1b, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b,
+	0x0a, 0x17, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x52,
+	0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41,
+	0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x46, 0x55,
+	0x4e, 0x44, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4a, 0x45,
+	0x43, 0x54, 0x10, 0x03, 0x2a, 0xd6, 0x01, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x18, 0x0a, 0x14, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x41, 0x54, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x54,
+	0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x44, 0x45, 0x43, 0x4c, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c,
+	0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x46, 0x55,
+	0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x18,
+	0x0a, 0x14, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45,
+	0x46, 0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x05, 0x12, 0x23, 0x0a, 0x1f, 0x49, 0x54, 0x45, 0x4d,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x45, 0x44,
+	0x5f, 0x54, 0x4f, 0x5f, 0x43, 0x4f, 0x55, 0x52, 0x49, 0x45, 0x52, 0x10, 0x06, 0x2a, 0x62, 0x0a,
+	0x0b, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x18,
+	0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41,
+	0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41,
+	0x49, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47,
+	0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x57, 0x52, 0x41, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x10,
+	0x02, 0x32, 0xba, 0x11, 0x0a, 0x0d, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x5e, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x1c, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64,
+	0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0xae, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x66, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x60, 0x5a, 0x40, 0x22, 0x3e, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x7b, 0x65, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x65,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x2f,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x12, 0xb4, 0x01, 0x0a, 0x09, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x12, 0x1d, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x4d, 0x6f, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x62, 0x3a, 0x01, 0x2a, 0x5a, 0x41, 0x3a, 0x01,
+	0x2a, 0x22, 0x3c, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x65, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x7b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x7d, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x22,
+	0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x75, 0x0a, 0x0d, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x21, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12,
+	0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x12, 0x6b, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x79, 0x4f, 0x6e,
+	0x12, 0x1e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x79, 0x4f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x79, 0x4f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x76,
+	0x31, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x74, 0x72, 0x79, 0x2d, 0x6f, 0x6e, 0x12,
+	0x79, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x72, 0x79, 0x4f, 0x6e, 0x12,
+	0x20, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x72, 0x79, 0x4f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x72, 0x79, 0x4f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22,
+	0x19, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x74, 0x72, 0x79, 0x2d,
+	0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x12, 0x76, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22,
+	0x2f, 0x76, 0x31, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x6b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x12, 0x20, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72,
 	0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x66, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x66, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x26, 0x5a,
-	0x24, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
-	0x5f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a,
+	0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0x80, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x12, 0x20, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x44,
+	0x65, 0x63, 0x69, 0x64, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x01, 0x2a,
+	0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x2f, 0x7b, 0x72,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x6b, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x12,
+	0x1d, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0x62, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x2e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x73, 0x12, 0xc6, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x68, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x62, 0x5a, 0x41, 0x12, 0x3f, 0x2f, 0x76,
+	0x31, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2f, 0x7b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x7d, 0x2f, 0x7b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1d, 0x2f,
+	0x76, 0x31, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x86, 0x01, 0x0a,
+	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x25, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x2e, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x30, 0x3a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x1a, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x12, 0x86, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x74, 0x69, 0x72, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22,
+	0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x7b, 0x6e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x12, 0x75, 0x0a, 0x10, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12,
+	0x24, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x5a, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x1c,
+	0x2e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x42, 0x67,
+	0x92, 0x41, 0x3e, 0x12, 0x15, 0x0a, 0x0e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x20, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a,
+	0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x5a, 0x24, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -740,43 +4268,166 @@ func file_orders_grpc_v1_orders_proto_rawDescGZIP() []byte {
 	return file_orders_grpc_v1_orders_proto_rawDescData
 }
 
-var file_orders_grpc_v1_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_orders_grpc_v1_orders_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_orders_grpc_v1_orders_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
 var file_orders_grpc_v1_orders_proto_goTypes = []any{
-	(*AddOrderRequest)(nil),       // 0: orders_grpc.AddOrderRequest
-	(*ReturnOrderRequest)(nil),    // 1: orders_grpc.ReturnOrderRequest
-	(*ReceiveOrdersRequest)(nil),  // 2: orders_grpc.ReceiveOrdersRequest
-	(*ReceiveOrdersResponse)(nil), // 3: orders_grpc.ReceiveOrdersResponse
-	(*GetOrdersRequest)(nil),      // 4: orders_grpc.GetOrdersRequest
-	(*GetOrdersResponse)(nil),     // 5: orders_grpc.GetOrdersResponse
-	(*CreateRefundRequest)(nil),   // 6: orders_grpc.CreateRefundRequest
-	(*GetRefundsRequest)(nil),     // 7: orders_grpc.GetRefundsRequest
-	(*GetRefundsResponse)(nil),    // 8: orders_grpc.GetRefundsResponse
-	(*Order)(nil),                 // 9: orders_grpc.Order
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 11: google.protobuf.Empty
+	(TryOnOutcome)(0),                 // 0: orders_grpc.TryOnOutcome
+	(OrderSort)(0),                    // 1: orders_grpc.OrderSort
+	(OrderState)(0),                   // 2: orders_grpc.OrderState
+	(RefundReason)(0),                 // 3: orders_grpc.RefundReason
+	(RefundState)(0),                  // 4: orders_grpc.RefundState
+	(RefundDecision)(0),               // 5: orders_grpc.RefundDecision
+	(ItemStatus)(0),                   // 6: orders_grpc.ItemStatus
+	(PackageKind)(0),                  // 7: orders_grpc.PackageKind
+	(*ExternalOrderRef)(nil),          // 8: orders_grpc.ExternalOrderRef
+	(*AddOrderRequest)(nil),           // 9: orders_grpc.AddOrderRequest
+	(*AddOrderResponse)(nil),          // 10: orders_grpc.AddOrderResponse
+	(*ReturnOrderRequest)(nil),        // 11: orders_grpc.ReturnOrderRequest
+	(*ReceiveOrdersRequest)(nil),      // 12: orders_grpc.ReceiveOrdersRequest
+	(*ReceiveOrdersResponse)(nil),     // 13: orders_grpc.ReceiveOrdersResponse
+	(*MoveOrderRequest)(nil),          // 14: orders_grpc.MoveOrderRequest
+	(*MoveOrderResponse)(nil),         // 15: orders_grpc.MoveOrderResponse
+	(*StartTryOnRequest)(nil),         // 16: orders_grpc.StartTryOnRequest
+	(*StartTryOnResponse)(nil),        // 17: orders_grpc.StartTryOnResponse
+	(*TryOnDecision)(nil),             // 18: orders_grpc.TryOnDecision
+	(*ConfirmTryOnRequest)(nil),       // 19: orders_grpc.ConfirmTryOnRequest
+	(*ConfirmTryOnResponse)(nil),      // 20: orders_grpc.ConfirmTryOnResponse
+	(*GetOrdersRequest)(nil),          // 21: orders_grpc.GetOrdersRequest
+	(*GetOrdersResponse)(nil),         // 22: orders_grpc.GetOrdersResponse
+	(*CreateRefundRequest)(nil),       // 23: orders_grpc.CreateRefundRequest
+	(*CreateRefundResponse)(nil),      // 24: orders_grpc.CreateRefundResponse
+	(*DecideRefundRequest)(nil),       // 25: orders_grpc.DecideRefundRequest
+	(*DecideRefundResponse)(nil),      // 26: orders_grpc.DecideRefundResponse
+	(*GetRefundRequest)(nil),          // 27: orders_grpc.GetRefundRequest
+	(*GetRefundResponse)(nil),         // 28: orders_grpc.GetRefundResponse
+	(*Refund)(nil),                    // 29: orders_grpc.Refund
+	(*RefundEvent)(nil),               // 30: orders_grpc.RefundEvent
+	(*GetRefundsRequest)(nil),         // 31: orders_grpc.GetRefundsRequest
+	(*GetRefundsResponse)(nil),        // 32: orders_grpc.GetRefundsResponse
+	(*GetOrderHistoryRequest)(nil),    // 33: orders_grpc.GetOrderHistoryRequest
+	(*GetOrderHistoryResponse)(nil),   // 34: orders_grpc.GetOrderHistoryResponse
+	(*OrderEvent)(nil),                // 35: orders_grpc.OrderEvent
+	(*Order)(nil),                     // 36: orders_grpc.Order
+	(*OrderItem)(nil),                 // 37: orders_grpc.OrderItem
+	(*Money)(nil),                     // 38: orders_grpc.Money
+	(*Dimensions)(nil),                // 39: orders_grpc.Dimensions
+	(*PackageType)(nil),               // 40: orders_grpc.PackageType
+	(*CreatePackageTypeRequest)(nil),  // 41: orders_grpc.CreatePackageTypeRequest
+	(*CreatePackageTypeResponse)(nil), // 42: orders_grpc.CreatePackageTypeResponse
+	(*UpdatePackageTypeRequest)(nil),  // 43: orders_grpc.UpdatePackageTypeRequest
+	(*UpdatePackageTypeResponse)(nil), // 44: orders_grpc.UpdatePackageTypeResponse
+	(*RetirePackageTypeRequest)(nil),  // 45: orders_grpc.RetirePackageTypeRequest
+	(*RetirePackageTypeResponse)(nil), // 46: orders_grpc.RetirePackageTypeResponse
+	(*ListPackageTypesRequest)(nil),   // 47: orders_grpc.ListPackageTypesRequest
+	(*ListPackageTypesResponse)(nil),  // 48: orders_grpc.ListPackageTypesResponse
+	(*GetQuoteRequest)(nil),           // 49: orders_grpc.GetQuoteRequest
+	(*PackageQuote)(nil),              // 50: orders_grpc.PackageQuote
+	(*GetQuoteResponse)(nil),          // 51: orders_grpc.GetQuoteResponse
+	(*timestamppb.Timestamp)(nil),     // 52: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),             // 53: google.protobuf.Empty
 }
 var file_orders_grpc_v1_orders_proto_depIdxs = []int32{
-	9,  // 0: orders_grpc.ReceiveOrdersResponse.orders:type_name -> orders_grpc.Order
-	9,  // 1: orders_grpc.GetOrdersResponse.orders:type_name -> orders_grpc.Order
-	9,  // 2: orders_grpc.GetRefundsResponse.refunds:type_name -> orders_grpc.Order
-	10, // 3: orders_grpc.Order.expiration_time:type_name -> google.protobuf.Timestamp
-	0,  // 4: orders_grpc.OrdersService.AddOrder:input_type -> orders_grpc.AddOrderRequest
-	1,  // 5: orders_grpc.OrdersService.ReturnOrder:input_type -> orders_grpc.ReturnOrderRequest
-	2,  // 6: orders_grpc.OrdersService.ReceiveOrders:input_type -> orders_grpc.ReceiveOrdersRequest
-	4,  // 7: orders_grpc.OrdersService.GetOrders:input_type -> orders_grpc.GetOrdersRequest
-	6,  // 8: orders_grpc.OrdersService.CreateRefund:input_type -> orders_grpc.CreateRefundRequest
-	7,  // 9: orders_grpc.OrdersService.GetRefunds:input_type -> orders_grpc.GetRefundsRequest
-	11, // 10: orders_grpc.OrdersService.AddOrder:output_type -> google.protobuf.Empty
-	11, // 11: orders_grpc.OrdersService.ReturnOrder:output_type -> google.protobuf.Empty
-	3,  // 12: orders_grpc.OrdersService.ReceiveOrders:output_type -> orders_grpc.ReceiveOrdersResponse
-	5,  // 13: orders_grpc.OrdersService.GetOrders:output_type -> orders_grpc.GetOrdersResponse
-	11, // 14: orders_grpc.OrdersService.CreateRefund:output_type -> google.protobuf.Empty
-	8,  // 15: orders_grpc.OrdersService.GetRefunds:output_type -> orders_grpc.GetRefundsResponse
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	8,  // 0: orders_grpc.AddOrderRequest.external:type_name -> orders_grpc.ExternalOrderRef
+	38, // 1: orders_grpc.AddOrderRequest.cost_money:type_name -> orders_grpc.Money
+	52, // 2: orders_grpc.AddOrderRequest.expiration:type_name -> google.protobuf.Timestamp
+	37, // 3: orders_grpc.AddOrderRequest.items:type_name -> orders_grpc.OrderItem
+	39, // 4: orders_grpc.AddOrderRequest.dimensions:type_name -> orders_grpc.Dimensions
+	8,  // 5: orders_grpc.ReturnOrderRequest.external:type_name -> orders_grpc.ExternalOrderRef
+	8,  // 6: orders_grpc.ReceiveOrdersRequest.externals:type_name -> orders_grpc.ExternalOrderRef
+	36, // 7: orders_grpc.ReceiveOrdersResponse.orders:type_name -> orders_grpc.Order
+	8,  // 8: orders_grpc.MoveOrderRequest.external:type_name -> orders_grpc.ExternalOrderRef
+	36, // 9: orders_grpc.MoveOrderResponse.order:type_name -> orders_grpc.Order
+	8,  // 10: orders_grpc.StartTryOnRequest.externals:type_name -> orders_grpc.ExternalOrderRef
+	36, // 11: orders_grpc.StartTryOnResponse.orders:type_name -> orders_grpc.Order
+	0,  // 12: orders_grpc.TryOnDecision.outcome:type_name -> orders_grpc.TryOnOutcome
+	18, // 13: orders_grpc.ConfirmTryOnRequest.decisions:type_name -> orders_grpc.TryOnDecision
+	36, // 14: orders_grpc.ConfirmTryOnResponse.orders:type_name -> orders_grpc.Order
+	1,  // 15: orders_grpc.GetOrdersRequest.sort:type_name -> orders_grpc.OrderSort
+	2,  // 16: orders_grpc.GetOrdersRequest.state:type_name -> orders_grpc.OrderState
+	36, // 17: orders_grpc.GetOrdersResponse.orders:type_name -> orders_grpc.Order
+	8,  // 18: orders_grpc.CreateRefundRequest.external:type_name -> orders_grpc.ExternalOrderRef
+	3,  // 19: orders_grpc.CreateRefundRequest.reason:type_name -> orders_grpc.RefundReason
+	29, // 20: orders_grpc.CreateRefundResponse.refund:type_name -> orders_grpc.Refund
+	5,  // 21: orders_grpc.DecideRefundRequest.decision:type_name -> orders_grpc.RefundDecision
+	29, // 22: orders_grpc.DecideRefundResponse.refund:type_name -> orders_grpc.Refund
+	29, // 23: orders_grpc.GetRefundResponse.refund:type_name -> orders_grpc.Refund
+	30, // 24: orders_grpc.GetRefundResponse.events:type_name -> orders_grpc.RefundEvent
+	3,  // 25: orders_grpc.Refund.reason:type_name -> orders_grpc.RefundReason
+	4,  // 26: orders_grpc.Refund.state:type_name -> orders_grpc.RefundState
+	38, // 27: orders_grpc.Refund.amount:type_name -> orders_grpc.Money
+	52, // 28: orders_grpc.Refund.created_at:type_name -> google.protobuf.Timestamp
+	52, // 29: orders_grpc.Refund.updated_at:type_name -> google.protobuf.Timestamp
+	52, // 30: orders_grpc.RefundEvent.time:type_name -> google.protobuf.Timestamp
+	52, // 31: orders_grpc.GetRefundsRequest.from:type_name -> google.protobuf.Timestamp
+	52, // 32: orders_grpc.GetRefundsRequest.to:type_name -> google.protobuf.Timestamp
+	36, // 33: orders_grpc.GetRefundsResponse.refunds:type_name -> orders_grpc.Order
+	8,  // 34: orders_grpc.GetOrderHistoryRequest.external:type_name -> orders_grpc.ExternalOrderRef
+	35, // 35: orders_grpc.GetOrderHistoryResponse.events:type_name -> orders_grpc.OrderEvent
+	52, // 36: orders_grpc.OrderEvent.time:type_name -> google.protobuf.Timestamp
+	52, // 37: orders_grpc.Order.expiration_time:type_name -> google.protobuf.Timestamp
+	38, // 38: orders_grpc.Order.cost_money:type_name -> orders_grpc.Money
+	38, // 39: orders_grpc.Order.pack_cost_money:type_name -> orders_grpc.Money
+	38, // 40: orders_grpc.Order.total_cost:type_name -> orders_grpc.Money
+	8,  // 41: orders_grpc.Order.external:type_name -> orders_grpc.ExternalOrderRef
+	52, // 42: orders_grpc.Order.refunded_time:type_name -> google.protobuf.Timestamp
+	37, // 43: orders_grpc.Order.items:type_name -> orders_grpc.OrderItem
+	52, // 44: orders_grpc.Order.try_on_until:type_name -> google.protobuf.Timestamp
+	38, // 45: orders_grpc.OrderItem.price:type_name -> orders_grpc.Money
+	6,  // 46: orders_grpc.OrderItem.status:type_name -> orders_grpc.ItemStatus
+	38, // 47: orders_grpc.PackageType.price:type_name -> orders_grpc.Money
+	39, // 48: orders_grpc.PackageType.dimensions:type_name -> orders_grpc.Dimensions
+	52, // 49: orders_grpc.PackageType.retired_at:type_name -> google.protobuf.Timestamp
+	7,  // 50: orders_grpc.PackageType.kind:type_name -> orders_grpc.PackageKind
+	40, // 51: orders_grpc.CreatePackageTypeRequest.package_type:type_name -> orders_grpc.PackageType
+	40, // 52: orders_grpc.CreatePackageTypeResponse.package_type:type_name -> orders_grpc.PackageType
+	40, // 53: orders_grpc.UpdatePackageTypeRequest.package_type:type_name -> orders_grpc.PackageType
+	40, // 54: orders_grpc.UpdatePackageTypeResponse.package_type:type_name -> orders_grpc.PackageType
+	40, // 55: orders_grpc.RetirePackageTypeResponse.package_type:type_name -> orders_grpc.PackageType
+	40, // 56: orders_grpc.ListPackageTypesResponse.package_types:type_name -> orders_grpc.PackageType
+	39, // 57: orders_grpc.GetQuoteRequest.dimensions:type_name -> orders_grpc.Dimensions
+	38, // 58: orders_grpc.GetQuoteRequest.cost_money:type_name -> orders_grpc.Money
+	38, // 59: orders_grpc.PackageQuote.pack_cost:type_name -> orders_grpc.Money
+	38, // 60: orders_grpc.PackageQuote.total_cost:type_name -> orders_grpc.Money
+	50, // 61: orders_grpc.GetQuoteResponse.options:type_name -> orders_grpc.PackageQuote
+	9,  // 62: orders_grpc.OrdersService.AddOrder:input_type -> orders_grpc.AddOrderRequest
+	11, // 63: orders_grpc.OrdersService.ReturnOrder:input_type -> orders_grpc.ReturnOrderRequest
+	14, // 64: orders_grpc.OrdersService.MoveOrder:input_type -> orders_grpc.MoveOrderRequest
+	12, // 65: orders_grpc.OrdersService.ReceiveOrders:input_type -> orders_grpc.ReceiveOrdersRequest
+	16, // 66: orders_grpc.OrdersService.StartTryOn:input_type -> orders_grpc.StartTryOnRequest
+	19, // 67: orders_grpc.OrdersService.ConfirmTryOn:input_type -> orders_grpc.ConfirmTryOnRequest
+	21, // 68: orders_grpc.OrdersService.GetOrders:input_type -> orders_grpc.GetOrdersRequest
+	23, // 69: orders_grpc.OrdersService.CreateRefund:input_type -> orders_grpc.CreateRefundRequest
+	25, // 70: orders_grpc.OrdersService.DecideRefund:input_type -> orders_grpc.DecideRefundRequest
+	27, // 71: orders_grpc.OrdersService.GetRefund:input_type -> orders_grpc.GetRefundRequest
+	31, // 72: orders_grpc.OrdersService.GetRefunds:input_type -> orders_grpc.GetRefundsRequest
+	33, // 73: orders_grpc.OrdersService.GetOrderHistory:input_type -> orders_grpc.GetOrderHistoryRequest
+	41, // 74: orders_grpc.OrdersService.CreatePackageType:input_type -> orders_grpc.CreatePackageTypeRequest
+	43, // 75: orders_grpc.OrdersService.UpdatePackageType:input_type -> orders_grpc.UpdatePackageTypeRequest
+	45, // 76: orders_grpc.OrdersService.RetirePackageType:input_type -> orders_grpc.RetirePackageTypeRequest
+	47, // 77: orders_grpc.OrdersService.ListPackageTypes:input_type -> orders_grpc.ListPackageTypesRequest
+	49, // 78: orders_grpc.OrdersService.GetQuote:input_type -> orders_grpc.GetQuoteRequest
+	10, // 79: orders_grpc.OrdersService.AddOrder:output_type -> orders_grpc.AddOrderResponse
+	53, // 80: orders_grpc.OrdersService.ReturnOrder:output_type -> google.protobuf.Empty
+	15, // 81: orders_grpc.OrdersService.MoveOrder:output_type -> orders_grpc.MoveOrderResponse
+	13, // 82: orders_grpc.OrdersService.ReceiveOrders:output_type -> orders_grpc.ReceiveOrdersResponse
+	17, // 83: orders_grpc.OrdersService.StartTryOn:output_type -> orders_grpc.StartTryOnResponse
+	20, // 84: orders_grpc.OrdersService.ConfirmTryOn:output_type -> orders_grpc.ConfirmTryOnResponse
+	22, // 85: orders_grpc.OrdersService.GetOrders:output_type -> orders_grpc.GetOrdersResponse
+	24, // 86: orders_grpc.OrdersService.CreateRefund:output_type -> orders_grpc.CreateRefundResponse
+	26, // 87: orders_grpc.OrdersService.DecideRefund:output_type -> orders_grpc.DecideRefundResponse
+	28, // 88: orders_grpc.OrdersService.GetRefund:output_type -> orders_grpc.GetRefundResponse
+	32, // 89: orders_grpc.OrdersService.GetRefunds:output_type -> orders_grpc.GetRefundsResponse
+	34, // 90: orders_grpc.OrdersService.GetOrderHistory:output_type -> orders_grpc.GetOrderHistoryResponse
+	42, // 91: orders_grpc.OrdersService.CreatePackageType:output_type -> orders_grpc.CreatePackageTypeResponse
+	44, // 92: orders_grpc.OrdersService.UpdatePackageType:output_type -> orders_grpc.UpdatePackageTypeResponse
+	46, // 93: orders_grpc.OrdersService.RetirePackageType:output_type -> orders_grpc.RetirePackageTypeResponse
+	48, // 94: orders_grpc.OrdersService.ListPackageTypes:output_type -> orders_grpc.ListPackageTypesResponse
+	51, // 95: orders_grpc.OrdersService.GetQuote:output_type -> orders_grpc.GetQuoteResponse
+	79, // [79:96] is the sub-list for method output_type
+	62, // [62:79] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
 }
 
 func init() { file_orders_grpc_v1_orders_proto_init() }
@@ -786,6 +4437,18 @@ func file_orders_grpc_v1_orders_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_orders_grpc_v1_orders_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ExternalOrderRef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[1].Exporter = func(v any, i int) any {
 			switch v := v.(*AddOrderRequest); i {
 			case 0:
 				return &v.state
@@ -797,8 +4460,128 @@ func file_orders_grpc_v1_orders_proto_init() {
 				return nil
 			}
 		}
-		file_orders_grpc_v1_orders_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*ReturnOrderRequest); i {
+		file_orders_grpc_v1_orders_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*AddOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*ReturnOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ReceiveOrdersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ReceiveOrdersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*MoveOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*MoveOrderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*StartTryOnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*StartTryOnResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*TryOnDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfirmTryOnRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfirmTryOnResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -809,8 +4592,8 @@ func file_orders_grpc_v1_orders_proto_init() {
 				return nil
 			}
 		}
-		file_orders_grpc_v1_orders_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*ReceiveOrdersRequest); i {
+		file_orders_grpc_v1_orders_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOrdersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -821,8 +4604,8 @@ func file_orders_grpc_v1_orders_proto_init() {
 				return nil
 			}
 		}
-		file_orders_grpc_v1_orders_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*ReceiveOrdersResponse); i {
+		file_orders_grpc_v1_orders_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOrdersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -833,8 +4616,8 @@ func file_orders_grpc_v1_orders_proto_init() {
 				return nil
 			}
 		}
-		file_orders_grpc_v1_orders_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GetOrdersRequest); i {
+		file_orders_grpc_v1_orders_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateRefundRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -845,8 +4628,8 @@ func file_orders_grpc_v1_orders_proto_init() {
 				return nil
 			}
 		}
-		file_orders_grpc_v1_orders_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GetOrdersResponse); i {
+		file_orders_grpc_v1_orders_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateRefundResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -857,8 +4640,8 @@ func file_orders_grpc_v1_orders_proto_init() {
 				return nil
 			}
 		}
-		file_orders_grpc_v1_orders_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateRefundRequest); i {
+		file_orders_grpc_v1_orders_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*DecideRefundRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -869,7 +4652,67 @@ func file_orders_grpc_v1_orders_proto_init() {
 				return nil
 			}
 		}
-		file_orders_grpc_v1_orders_proto_msgTypes[7].Exporter = func(v any, i int) any {
+		file_orders_grpc_v1_orders_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*DecideRefundResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRefundRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*GetRefundResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*Refund); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*RefundEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[23].Exporter = func(v any, i int) any {
 			switch v := v.(*GetRefundsRequest); i {
 			case 0:
 				return &v.state
@@ -881,7 +4724,7 @@ func file_orders_grpc_v1_orders_proto_init() {
 				return nil
 			}
 		}
-		file_orders_grpc_v1_orders_proto_msgTypes[8].Exporter = func(v any, i int) any {
+		file_orders_grpc_v1_orders_proto_msgTypes[24].Exporter = func(v any, i int) any {
 			switch v := v.(*GetRefundsResponse); i {
 			case 0:
 				return &v.state
@@ -893,7 +4736,43 @@ func file_orders_grpc_v1_orders_proto_init() {
 				return nil
 			}
 		}
-		file_orders_grpc_v1_orders_proto_msgTypes[9].Exporter = func(v any, i int) any {
+		file_orders_grpc_v1_orders_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOrderHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*GetOrderHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*OrderEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[28].Exporter = func(v any, i int) any {
 			switch v := v.(*Order); i {
 			case 0:
 				return &v.state
@@ -905,19 +4784,220 @@ func file_orders_grpc_v1_orders_proto_init() {
 				return nil
 			}
 		}
+		file_orders_grpc_v1_orders_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*OrderItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*Money); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*Dimensions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*PackageType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePackageTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*CreatePackageTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePackageTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdatePackageTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*RetirePackageTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*RetirePackageTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPackageTypesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*ListPackageTypesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQuoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*PackageQuote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_orders_grpc_v1_orders_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*GetQuoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_orders_grpc_v1_orders_proto_msgTypes[1].OneofWrappers = []any{
+		(*AddOrderRequest_OrderId)(nil),
+		(*AddOrderRequest_External)(nil),
+	}
+	file_orders_grpc_v1_orders_proto_msgTypes[3].OneofWrappers = []any{
+		(*ReturnOrderRequest_OrderId)(nil),
+		(*ReturnOrderRequest_External)(nil),
+	}
+	file_orders_grpc_v1_orders_proto_msgTypes[6].OneofWrappers = []any{
+		(*MoveOrderRequest_OrderId)(nil),
+		(*MoveOrderRequest_External)(nil),
+	}
+	file_orders_grpc_v1_orders_proto_msgTypes[15].OneofWrappers = []any{
+		(*CreateRefundRequest_OrderId)(nil),
+		(*CreateRefundRequest_External)(nil),
+	}
+	file_orders_grpc_v1_orders_proto_msgTypes[25].OneofWrappers = []any{
+		(*GetOrderHistoryRequest_OrderId)(nil),
+		(*GetOrderHistoryRequest_External)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_orders_grpc_v1_orders_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   10,
+			NumEnums:      8,
+			NumMessages:   44,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_orders_grpc_v1_orders_proto_goTypes,
 		DependencyIndexes: file_orders_grpc_v1_orders_proto_depIdxs,
+		EnumInfos:         file_orders_grpc_v1_orders_proto_enumTypes,
 		MessageInfos:      file_orders_grpc_v1_orders_proto_msgTypes,
 	}.Build()
 	File_orders_grpc_v1_orders_proto = out.File
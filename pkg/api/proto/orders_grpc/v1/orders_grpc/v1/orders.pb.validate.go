@@ -0,0 +1,7039 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: orders_grpc/v1/orders.proto
+
+package orders_grpc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ExternalOrderRef with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExternalOrderRef) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExternalOrderRef with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExternalOrderRefMultiError, or nil if none found.
+func (m *ExternalOrderRef) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExternalOrderRef) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetSource()); l < 1 || l > 64 {
+		err := ExternalOrderRefValidationError{
+			field:  "Source",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetNumber()); l < 1 || l > 64 {
+		err := ExternalOrderRefValidationError{
+			field:  "Number",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExternalOrderRefMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExternalOrderRefMultiError is an error wrapping multiple validation errors
+// returned by ExternalOrderRef.ValidateAll() if the designated constraints
+// aren't met.
+type ExternalOrderRefMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExternalOrderRefMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExternalOrderRefMultiError) AllErrors() []error { return m }
+
+// ExternalOrderRefValidationError is the validation error returned by
+// ExternalOrderRef.Validate if the designated constraints aren't met.
+type ExternalOrderRefValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExternalOrderRefValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExternalOrderRefValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExternalOrderRefValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExternalOrderRefValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExternalOrderRefValidationError) ErrorName() string { return "ExternalOrderRefValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExternalOrderRefValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExternalOrderRef.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExternalOrderRefValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExternalOrderRefValidationError{}
+
+// Validate checks the field values on AddOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddOrderRequestMultiError, or nil if none found.
+func (m *AddOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCustomerId() <= 0 {
+		err := AddOrderRequestValidationError{
+			field:  "CustomerId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AddOrderRequest_ExpirationTime_Pattern.MatchString(m.GetExpirationTime()) {
+		err := AddOrderRequestValidationError{
+			field:  "ExpirationTime",
+			reason: "value does not match regex pattern \"^([0-9]{2}-[0-9]{2}-[0-9]{4})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PackageType
+
+	if m.GetWeight() < 0 {
+		err := AddOrderRequestValidationError{
+			field:  "Weight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCost() < 0 {
+		err := AddOrderRequestValidationError{
+			field:  "Cost",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetCostMoney()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddOrderRequestValidationError{
+					field:  "CostMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddOrderRequestValidationError{
+					field:  "CostMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCostMoney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddOrderRequestValidationError{
+				field:  "CostMoney",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpiration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddOrderRequestValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddOrderRequestValidationError{
+					field:  "Expiration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddOrderRequestValidationError{
+				field:  "Expiration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetPackageLayers()) > 4 {
+		err := AddOrderRequestValidationError{
+			field:  "PackageLayers",
+			reason: "value must contain no more than 4 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPackageLayers() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := AddOrderRequestValidationError{
+				field:  fmt.Sprintf("PackageLayers[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(m.GetItems()) > 100 {
+		err := AddOrderRequestValidationError{
+			field:  "Items",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddOrderRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddOrderRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddOrderRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetDimensions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddOrderRequestValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddOrderRequestValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDimensions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddOrderRequestValidationError{
+				field:  "Dimensions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	oneofOrderPresent := false
+	switch v := m.Order.(type) {
+	case *AddOrderRequest_OrderId:
+		if v == nil {
+			err := AddOrderRequestValidationError{
+				field:  "Order",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOrderPresent = true
+
+		if m.GetOrderId() <= 0 {
+			err := AddOrderRequestValidationError{
+				field:  "OrderId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *AddOrderRequest_External:
+		if v == nil {
+			err := AddOrderRequestValidationError{
+				field:  "Order",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOrderPresent = true
+
+		if all {
+			switch v := interface{}(m.GetExternal()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddOrderRequestValidationError{
+						field:  "External",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddOrderRequestValidationError{
+						field:  "External",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExternal()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddOrderRequestValidationError{
+					field:  "External",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOrderPresent {
+		err := AddOrderRequestValidationError{
+			field:  "Order",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AddOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by AddOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddOrderRequestMultiError) AllErrors() []error { return m }
+
+// AddOrderRequestValidationError is the validation error returned by
+// AddOrderRequest.Validate if the designated constraints aren't met.
+type AddOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddOrderRequestValidationError) ErrorName() string { return "AddOrderRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddOrderRequestValidationError{}
+
+var _AddOrderRequest_ExpirationTime_Pattern = regexp.MustCompile("^([0-9]{2}-[0-9]{2}-[0-9]{4})?$")
+
+// Validate checks the field values on AddOrderResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddOrderResponseMultiError, or nil if none found.
+func (m *AddOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for Cell
+
+	if len(errors) > 0 {
+		return AddOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddOrderResponseMultiError is an error wrapping multiple validation errors
+// returned by AddOrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AddOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddOrderResponseMultiError) AllErrors() []error { return m }
+
+// AddOrderResponseValidationError is the validation error returned by
+// AddOrderResponse.Validate if the designated constraints aren't met.
+type AddOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddOrderResponseValidationError) ErrorName() string { return "AddOrderResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AddOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddOrderResponseValidationError{}
+
+// Validate checks the field values on ReturnOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnOrderRequestMultiError, or nil if none found.
+func (m *ReturnOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofOrderPresent := false
+	switch v := m.Order.(type) {
+	case *ReturnOrderRequest_OrderId:
+		if v == nil {
+			err := ReturnOrderRequestValidationError{
+				field:  "Order",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOrderPresent = true
+
+		if m.GetOrderId() <= 0 {
+			err := ReturnOrderRequestValidationError{
+				field:  "OrderId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *ReturnOrderRequest_External:
+		if v == nil {
+			err := ReturnOrderRequestValidationError{
+				field:  "Order",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOrderPresent = true
+
+		if all {
+			switch v := interface{}(m.GetExternal()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReturnOrderRequestValidationError{
+						field:  "External",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReturnOrderRequestValidationError{
+						field:  "External",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExternal()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReturnOrderRequestValidationError{
+					field:  "External",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOrderPresent {
+		err := ReturnOrderRequestValidationError{
+			field:  "Order",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ReturnOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by ReturnOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReturnOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnOrderRequestMultiError) AllErrors() []error { return m }
+
+// ReturnOrderRequestValidationError is the validation error returned by
+// ReturnOrderRequest.Validate if the designated constraints aren't met.
+type ReturnOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnOrderRequestValidationError) ErrorName() string {
+	return "ReturnOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReturnOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnOrderRequestValidationError{}
+
+// Validate checks the field values on ReceiveOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReceiveOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReceiveOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReceiveOrdersRequestMultiError, or nil if none found.
+func (m *ReceiveOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReceiveOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrderIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := ReceiveOrdersRequestValidationError{
+				field:  fmt.Sprintf("OrderIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetExternals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReceiveOrdersRequestValidationError{
+						field:  fmt.Sprintf("Externals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReceiveOrdersRequestValidationError{
+						field:  fmt.Sprintf("Externals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReceiveOrdersRequestValidationError{
+					field:  fmt.Sprintf("Externals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if !_ReceiveOrdersRequest_PickupCode_Pattern.MatchString(m.GetPickupCode()) {
+		err := ReceiveOrdersRequestValidationError{
+			field:  "PickupCode",
+			reason: "value does not match regex pattern \"^[0-9]{4,8}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetDeclinedItemIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := ReceiveOrdersRequestValidationError{
+				field:  fmt.Sprintf("DeclinedItemIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReceiveOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiveOrdersRequestMultiError is an error wrapping multiple validation
+// errors returned by ReceiveOrdersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReceiveOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiveOrdersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiveOrdersRequestMultiError) AllErrors() []error { return m }
+
+// ReceiveOrdersRequestValidationError is the validation error returned by
+// ReceiveOrdersRequest.Validate if the designated constraints aren't met.
+type ReceiveOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiveOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiveOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiveOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiveOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiveOrdersRequestValidationError) ErrorName() string {
+	return "ReceiveOrdersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReceiveOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiveOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiveOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiveOrdersRequestValidationError{}
+
+var _ReceiveOrdersRequest_PickupCode_Pattern = regexp.MustCompile("^[0-9]{4,8}$")
+
+// Validate checks the field values on ReceiveOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReceiveOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReceiveOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReceiveOrdersResponseMultiError, or nil if none found.
+func (m *ReceiveOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReceiveOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReceiveOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReceiveOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReceiveOrdersResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReceiveOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReceiveOrdersResponseMultiError is an error wrapping multiple validation
+// errors returned by ReceiveOrdersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReceiveOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReceiveOrdersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReceiveOrdersResponseMultiError) AllErrors() []error { return m }
+
+// ReceiveOrdersResponseValidationError is the validation error returned by
+// ReceiveOrdersResponse.Validate if the designated constraints aren't met.
+type ReceiveOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReceiveOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReceiveOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReceiveOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReceiveOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReceiveOrdersResponseValidationError) ErrorName() string {
+	return "ReceiveOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReceiveOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReceiveOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReceiveOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReceiveOrdersResponseValidationError{}
+
+// Validate checks the field values on MoveOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveOrderRequestMultiError, or nil if none found.
+func (m *MoveOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetCell()); l < 1 || l > 32 {
+		err := MoveOrderRequestValidationError{
+			field:  "Cell",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofOrderPresent := false
+	switch v := m.Order.(type) {
+	case *MoveOrderRequest_OrderId:
+		if v == nil {
+			err := MoveOrderRequestValidationError{
+				field:  "Order",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOrderPresent = true
+
+		if m.GetOrderId() <= 0 {
+			err := MoveOrderRequestValidationError{
+				field:  "OrderId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *MoveOrderRequest_External:
+		if v == nil {
+			err := MoveOrderRequestValidationError{
+				field:  "Order",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOrderPresent = true
+
+		if all {
+			switch v := interface{}(m.GetExternal()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MoveOrderRequestValidationError{
+						field:  "External",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MoveOrderRequestValidationError{
+						field:  "External",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExternal()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MoveOrderRequestValidationError{
+					field:  "External",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOrderPresent {
+		err := MoveOrderRequestValidationError{
+			field:  "Order",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoveOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveOrderRequestMultiError) AllErrors() []error { return m }
+
+// MoveOrderRequestValidationError is the validation error returned by
+// MoveOrderRequest.Validate if the designated constraints aren't met.
+type MoveOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveOrderRequestValidationError) ErrorName() string { return "MoveOrderRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoveOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveOrderRequestValidationError{}
+
+// Validate checks the field values on MoveOrderResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveOrderResponseMultiError, or nil if none found.
+func (m *MoveOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MoveOrderResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MoveOrderResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MoveOrderResponseValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MoveOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveOrderResponseMultiError is an error wrapping multiple validation errors
+// returned by MoveOrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type MoveOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveOrderResponseMultiError) AllErrors() []error { return m }
+
+// MoveOrderResponseValidationError is the validation error returned by
+// MoveOrderResponse.Validate if the designated constraints aren't met.
+type MoveOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveOrderResponseValidationError) ErrorName() string {
+	return "MoveOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MoveOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveOrderResponseValidationError{}
+
+// Validate checks the field values on StartTryOnRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StartTryOnRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartTryOnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartTryOnRequestMultiError, or nil if none found.
+func (m *StartTryOnRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartTryOnRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrderIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := StartTryOnRequestValidationError{
+				field:  fmt.Sprintf("OrderIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	for idx, item := range m.GetExternals() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartTryOnRequestValidationError{
+						field:  fmt.Sprintf("Externals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartTryOnRequestValidationError{
+						field:  fmt.Sprintf("Externals[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartTryOnRequestValidationError{
+					field:  fmt.Sprintf("Externals[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if !_StartTryOnRequest_PickupCode_Pattern.MatchString(m.GetPickupCode()) {
+		err := StartTryOnRequestValidationError{
+			field:  "PickupCode",
+			reason: "value does not match regex pattern \"^[0-9]{4,8}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StartTryOnRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartTryOnRequestMultiError is an error wrapping multiple validation errors
+// returned by StartTryOnRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StartTryOnRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartTryOnRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartTryOnRequestMultiError) AllErrors() []error { return m }
+
+// StartTryOnRequestValidationError is the validation error returned by
+// StartTryOnRequest.Validate if the designated constraints aren't met.
+type StartTryOnRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartTryOnRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartTryOnRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartTryOnRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartTryOnRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartTryOnRequestValidationError) ErrorName() string {
+	return "StartTryOnRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartTryOnRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartTryOnRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartTryOnRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartTryOnRequestValidationError{}
+
+var _StartTryOnRequest_PickupCode_Pattern = regexp.MustCompile("^[0-9]{4,8}$")
+
+// Validate checks the field values on StartTryOnResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartTryOnResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartTryOnResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartTryOnResponseMultiError, or nil if none found.
+func (m *StartTryOnResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartTryOnResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartTryOnResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartTryOnResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartTryOnResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StartTryOnResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartTryOnResponseMultiError is an error wrapping multiple validation errors
+// returned by StartTryOnResponse.ValidateAll() if the designated constraints
+// aren't met.
+type StartTryOnResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartTryOnResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartTryOnResponseMultiError) AllErrors() []error { return m }
+
+// StartTryOnResponseValidationError is the validation error returned by
+// StartTryOnResponse.Validate if the designated constraints aren't met.
+type StartTryOnResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartTryOnResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartTryOnResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartTryOnResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartTryOnResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartTryOnResponseValidationError) ErrorName() string {
+	return "StartTryOnResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartTryOnResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartTryOnResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StartTryOnResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartTryOnResponseValidationError{}
+
+// Validate checks the field values on TryOnDecision with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TryOnDecision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TryOnDecision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TryOnDecisionMultiError, or
+// nil if none found.
+func (m *TryOnDecision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TryOnDecision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderId() <= 0 {
+		err := TryOnDecisionValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _TryOnDecision_Outcome_NotInLookup[m.GetOutcome()]; ok {
+		err := TryOnDecisionValidationError{
+			field:  "Outcome",
+			reason: "value must not be in list [TRY_ON_OUTCOME_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TryOnOutcome_name[int32(m.GetOutcome())]; !ok {
+		err := TryOnDecisionValidationError{
+			field:  "Outcome",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return TryOnDecisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// TryOnDecisionMultiError is an error wrapping multiple validation errors
+// returned by TryOnDecision.ValidateAll() if the designated constraints
+// aren't met.
+type TryOnDecisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TryOnDecisionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TryOnDecisionMultiError) AllErrors() []error { return m }
+
+// TryOnDecisionValidationError is the validation error returned by
+// TryOnDecision.Validate if the designated constraints aren't met.
+type TryOnDecisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TryOnDecisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TryOnDecisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TryOnDecisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TryOnDecisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TryOnDecisionValidationError) ErrorName() string { return "TryOnDecisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TryOnDecisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTryOnDecision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TryOnDecisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TryOnDecisionValidationError{}
+
+var _TryOnDecision_Outcome_NotInLookup = map[TryOnOutcome]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ConfirmTryOnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTryOnRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTryOnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTryOnRequestMultiError, or nil if none found.
+func (m *ConfirmTryOnRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTryOnRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetDecisions()) < 1 {
+		err := ConfirmTryOnRequestValidationError{
+			field:  "Decisions",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetDecisions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfirmTryOnRequestValidationError{
+						field:  fmt.Sprintf("Decisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfirmTryOnRequestValidationError{
+						field:  fmt.Sprintf("Decisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfirmTryOnRequestValidationError{
+					field:  fmt.Sprintf("Decisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetDeclinedItemIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := ConfirmTryOnRequestValidationError{
+				field:  fmt.Sprintf("DeclinedItemIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ConfirmTryOnRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTryOnRequestMultiError is an error wrapping multiple validation
+// errors returned by ConfirmTryOnRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmTryOnRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTryOnRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTryOnRequestMultiError) AllErrors() []error { return m }
+
+// ConfirmTryOnRequestValidationError is the validation error returned by
+// ConfirmTryOnRequest.Validate if the designated constraints aren't met.
+type ConfirmTryOnRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTryOnRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTryOnRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTryOnRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTryOnRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTryOnRequestValidationError) ErrorName() string {
+	return "ConfirmTryOnRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTryOnRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTryOnRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTryOnRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTryOnRequestValidationError{}
+
+// Validate checks the field values on ConfirmTryOnResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ConfirmTryOnResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfirmTryOnResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfirmTryOnResponseMultiError, or nil if none found.
+func (m *ConfirmTryOnResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfirmTryOnResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfirmTryOnResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfirmTryOnResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfirmTryOnResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ConfirmTryOnResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfirmTryOnResponseMultiError is an error wrapping multiple validation
+// errors returned by ConfirmTryOnResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ConfirmTryOnResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfirmTryOnResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfirmTryOnResponseMultiError) AllErrors() []error { return m }
+
+// ConfirmTryOnResponseValidationError is the validation error returned by
+// ConfirmTryOnResponse.Validate if the designated constraints aren't met.
+type ConfirmTryOnResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmTryOnResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmTryOnResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmTryOnResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmTryOnResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmTryOnResponseValidationError) ErrorName() string {
+	return "ConfirmTryOnResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmTryOnResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmTryOnResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmTryOnResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmTryOnResponseValidationError{}
+
+// Validate checks the field values on GetOrdersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrdersRequestMultiError, or nil if none found.
+func (m *GetOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCustomerId() <= 0 {
+		err := GetOrdersRequestValidationError{
+			field:  "CustomerId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetN(); val < 0 || val > 1000 {
+		err := GetOrdersRequestValidationError{
+			field:  "N",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if _, ok := OrderSort_name[int32(m.GetSort())]; !ok {
+		err := GetOrdersRequestValidationError{
+			field:  "Sort",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Descending
+
+	if _, ok := OrderState_name[int32(m.GetState())]; !ok {
+		err := GetOrdersRequestValidationError{
+			field:  "State",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PackageType
+
+	if len(errors) > 0 {
+		return GetOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrdersRequestMultiError is an error wrapping multiple validation errors
+// returned by GetOrdersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrdersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrdersRequestMultiError) AllErrors() []error { return m }
+
+// GetOrdersRequestValidationError is the validation error returned by
+// GetOrdersRequest.Validate if the designated constraints aren't met.
+type GetOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrdersRequestValidationError) ErrorName() string { return "GetOrdersRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrdersRequestValidationError{}
+
+// Validate checks the field values on GetOrdersResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrdersResponseMultiError, or nil if none found.
+func (m *GetOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOrdersResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return GetOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrdersResponseMultiError is an error wrapping multiple validation errors
+// returned by GetOrdersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrdersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrdersResponseMultiError) AllErrors() []error { return m }
+
+// GetOrdersResponseValidationError is the validation error returned by
+// GetOrdersResponse.Validate if the designated constraints aren't met.
+type GetOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrdersResponseValidationError) ErrorName() string {
+	return "GetOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrdersResponseValidationError{}
+
+// Validate checks the field values on CreateRefundRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRefundRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRefundRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRefundRequestMultiError, or nil if none found.
+func (m *CreateRefundRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRefundRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCustomerId() <= 0 {
+		err := CreateRefundRequestValidationError{
+			field:  "CustomerId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := RefundReason_name[int32(m.GetReason())]; !ok {
+		err := CreateRefundRequestValidationError{
+			field:  "Reason",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 1000 {
+		err := CreateRefundRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetItemIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := CreateRefundRequestValidationError{
+				field:  fmt.Sprintf("ItemIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	oneofOrderPresent := false
+	switch v := m.Order.(type) {
+	case *CreateRefundRequest_OrderId:
+		if v == nil {
+			err := CreateRefundRequestValidationError{
+				field:  "Order",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOrderPresent = true
+
+		if m.GetOrderId() <= 0 {
+			err := CreateRefundRequestValidationError{
+				field:  "OrderId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *CreateRefundRequest_External:
+		if v == nil {
+			err := CreateRefundRequestValidationError{
+				field:  "Order",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOrderPresent = true
+
+		if all {
+			switch v := interface{}(m.GetExternal()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateRefundRequestValidationError{
+						field:  "External",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateRefundRequestValidationError{
+						field:  "External",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExternal()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateRefundRequestValidationError{
+					field:  "External",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOrderPresent {
+		err := CreateRefundRequestValidationError{
+			field:  "Order",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateRefundRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRefundRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateRefundRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRefundRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRefundRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRefundRequestMultiError) AllErrors() []error { return m }
+
+// CreateRefundRequestValidationError is the validation error returned by
+// CreateRefundRequest.Validate if the designated constraints aren't met.
+type CreateRefundRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRefundRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRefundRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRefundRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRefundRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRefundRequestValidationError) ErrorName() string {
+	return "CreateRefundRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRefundRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRefundRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRefundRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRefundRequestValidationError{}
+
+// Validate checks the field values on CreateRefundResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRefundResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRefundResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRefundResponseMultiError, or nil if none found.
+func (m *CreateRefundResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRefundResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRefund()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRefundResponseValidationError{
+					field:  "Refund",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRefundResponseValidationError{
+					field:  "Refund",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefund()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRefundResponseValidationError{
+				field:  "Refund",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRefundResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRefundResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateRefundResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateRefundResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRefundResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRefundResponseMultiError) AllErrors() []error { return m }
+
+// CreateRefundResponseValidationError is the validation error returned by
+// CreateRefundResponse.Validate if the designated constraints aren't met.
+type CreateRefundResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRefundResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRefundResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRefundResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRefundResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRefundResponseValidationError) ErrorName() string {
+	return "CreateRefundResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRefundResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRefundResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRefundResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRefundResponseValidationError{}
+
+// Validate checks the field values on DecideRefundRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DecideRefundRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DecideRefundRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DecideRefundRequestMultiError, or nil if none found.
+func (m *DecideRefundRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DecideRefundRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetRefundId() <= 0 {
+		err := DecideRefundRequestValidationError{
+			field:  "RefundId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _DecideRefundRequest_Decision_NotInLookup[m.GetDecision()]; ok {
+		err := DecideRefundRequestValidationError{
+			field:  "Decision",
+			reason: "value must not be in list [REFUND_DECISION_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := RefundDecision_name[int32(m.GetDecision())]; !ok {
+		err := DecideRefundRequestValidationError{
+			field:  "Decision",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 1000 {
+		err := DecideRefundRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DecideRefundRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DecideRefundRequestMultiError is an error wrapping multiple validation
+// errors returned by DecideRefundRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DecideRefundRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DecideRefundRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DecideRefundRequestMultiError) AllErrors() []error { return m }
+
+// DecideRefundRequestValidationError is the validation error returned by
+// DecideRefundRequest.Validate if the designated constraints aren't met.
+type DecideRefundRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecideRefundRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecideRefundRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecideRefundRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecideRefundRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecideRefundRequestValidationError) ErrorName() string {
+	return "DecideRefundRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecideRefundRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecideRefundRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecideRefundRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecideRefundRequestValidationError{}
+
+var _DecideRefundRequest_Decision_NotInLookup = map[RefundDecision]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on DecideRefundResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DecideRefundResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DecideRefundResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DecideRefundResponseMultiError, or nil if none found.
+func (m *DecideRefundResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DecideRefundResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRefund()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DecideRefundResponseValidationError{
+					field:  "Refund",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DecideRefundResponseValidationError{
+					field:  "Refund",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefund()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DecideRefundResponseValidationError{
+				field:  "Refund",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DecideRefundResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DecideRefundResponseMultiError is an error wrapping multiple validation
+// errors returned by DecideRefundResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DecideRefundResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DecideRefundResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DecideRefundResponseMultiError) AllErrors() []error { return m }
+
+// DecideRefundResponseValidationError is the validation error returned by
+// DecideRefundResponse.Validate if the designated constraints aren't met.
+type DecideRefundResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecideRefundResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecideRefundResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecideRefundResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecideRefundResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecideRefundResponseValidationError) ErrorName() string {
+	return "DecideRefundResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecideRefundResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecideRefundResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecideRefundResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecideRefundResponseValidationError{}
+
+// Validate checks the field values on GetRefundRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRefundRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRefundRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRefundRequestMultiError, or nil if none found.
+func (m *GetRefundRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRefundRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetRefundId() <= 0 {
+		err := GetRefundRequestValidationError{
+			field:  "RefundId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRefundRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRefundRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRefundRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRefundRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRefundRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRefundRequestMultiError) AllErrors() []error { return m }
+
+// GetRefundRequestValidationError is the validation error returned by
+// GetRefundRequest.Validate if the designated constraints aren't met.
+type GetRefundRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRefundRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRefundRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRefundRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRefundRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRefundRequestValidationError) ErrorName() string { return "GetRefundRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRefundRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRefundRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRefundRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRefundRequestValidationError{}
+
+// Validate checks the field values on GetRefundResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRefundResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRefundResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRefundResponseMultiError, or nil if none found.
+func (m *GetRefundResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRefundResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRefund()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRefundResponseValidationError{
+					field:  "Refund",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRefundResponseValidationError{
+					field:  "Refund",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefund()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRefundResponseValidationError{
+				field:  "Refund",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRefundResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRefundResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRefundResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetRefundResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRefundResponseMultiError is an error wrapping multiple validation errors
+// returned by GetRefundResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetRefundResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRefundResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRefundResponseMultiError) AllErrors() []error { return m }
+
+// GetRefundResponseValidationError is the validation error returned by
+// GetRefundResponse.Validate if the designated constraints aren't met.
+type GetRefundResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRefundResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRefundResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRefundResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRefundResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRefundResponseValidationError) ErrorName() string {
+	return "GetRefundResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRefundResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRefundResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRefundResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRefundResponseValidationError{}
+
+// Validate checks the field values on Refund with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Refund) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Refund with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in RefundMultiError, or nil if none found.
+func (m *Refund) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Refund) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefundId
+
+	// no validation rules for OrderId
+
+	// no validation rules for CustomerId
+
+	// no validation rules for Reason
+
+	// no validation rules for Comment
+
+	// no validation rules for State
+
+	if all {
+		switch v := interface{}(m.GetAmount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefundValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefundValidationError{
+					field:  "Amount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAmount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefundValidationError{
+				field:  "Amount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefundValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefundValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefundValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefundValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefundValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefundValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RefundMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundMultiError is an error wrapping multiple validation errors returned by
+// Refund.ValidateAll() if the designated constraints aren't met.
+type RefundMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundMultiError) AllErrors() []error { return m }
+
+// RefundValidationError is the validation error returned by Refund.Validate if
+// the designated constraints aren't met.
+type RefundValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundValidationError) ErrorName() string { return "RefundValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RefundValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefund.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundValidationError{}
+
+// Validate checks the field values on RefundEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RefundEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RefundEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RefundEventMultiError, or
+// nil if none found.
+func (m *RefundEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RefundEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RefundId
+
+	// no validation rules for StateFrom
+
+	// no validation rules for StateTo
+
+	// no validation rules for Comment
+
+	// no validation rules for Operator
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RefundEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RefundEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RefundEventValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RefundEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// RefundEventMultiError is an error wrapping multiple validation errors
+// returned by RefundEvent.ValidateAll() if the designated constraints aren't met.
+type RefundEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RefundEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RefundEventMultiError) AllErrors() []error { return m }
+
+// RefundEventValidationError is the validation error returned by
+// RefundEvent.Validate if the designated constraints aren't met.
+type RefundEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RefundEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RefundEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RefundEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RefundEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RefundEventValidationError) ErrorName() string { return "RefundEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RefundEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRefundEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RefundEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RefundEventValidationError{}
+
+// Validate checks the field values on GetRefundsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetRefundsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRefundsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRefundsRequestMultiError, or nil if none found.
+func (m *GetRefundsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRefundsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPage() < 0 {
+		err := GetRefundsRequestValidationError{
+			field:  "Page",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := GetRefundsRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRefundsRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRefundsRequestValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRefundsRequestValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetRefundsRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetRefundsRequestValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetRefundsRequestValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetRefundsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRefundsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRefundsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetRefundsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRefundsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRefundsRequestMultiError) AllErrors() []error { return m }
+
+// GetRefundsRequestValidationError is the validation error returned by
+// GetRefundsRequest.Validate if the designated constraints aren't met.
+type GetRefundsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRefundsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRefundsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRefundsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRefundsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRefundsRequestValidationError) ErrorName() string {
+	return "GetRefundsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRefundsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRefundsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRefundsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRefundsRequestValidationError{}
+
+// Validate checks the field values on GetRefundsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetRefundsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRefundsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetRefundsResponseMultiError, or nil if none found.
+func (m *GetRefundsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRefundsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRefunds() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetRefundsResponseValidationError{
+						field:  fmt.Sprintf("Refunds[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetRefundsResponseValidationError{
+						field:  fmt.Sprintf("Refunds[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetRefundsResponseValidationError{
+					field:  fmt.Sprintf("Refunds[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalCount
+
+	if len(errors) > 0 {
+		return GetRefundsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetRefundsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetRefundsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetRefundsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRefundsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRefundsResponseMultiError) AllErrors() []error { return m }
+
+// GetRefundsResponseValidationError is the validation error returned by
+// GetRefundsResponse.Validate if the designated constraints aren't met.
+type GetRefundsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRefundsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRefundsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRefundsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRefundsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRefundsResponseValidationError) ErrorName() string {
+	return "GetRefundsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetRefundsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRefundsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRefundsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRefundsResponseValidationError{}
+
+// Validate checks the field values on GetOrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderHistoryRequestMultiError, or nil if none found.
+func (m *GetOrderHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofOrderPresent := false
+	switch v := m.Order.(type) {
+	case *GetOrderHistoryRequest_OrderId:
+		if v == nil {
+			err := GetOrderHistoryRequestValidationError{
+				field:  "Order",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOrderPresent = true
+
+		if m.GetOrderId() <= 0 {
+			err := GetOrderHistoryRequestValidationError{
+				field:  "OrderId",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *GetOrderHistoryRequest_External:
+		if v == nil {
+			err := GetOrderHistoryRequestValidationError{
+				field:  "Order",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOrderPresent = true
+
+		if all {
+			switch v := interface{}(m.GetExternal()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOrderHistoryRequestValidationError{
+						field:  "External",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOrderHistoryRequestValidationError{
+						field:  "External",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExternal()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOrderHistoryRequestValidationError{
+					field:  "External",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOrderPresent {
+		err := GetOrderHistoryRequestValidationError{
+			field:  "Order",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetOrderHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetOrderHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetOrderHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetOrderHistoryRequestValidationError is the validation error returned by
+// GetOrderHistoryRequest.Validate if the designated constraints aren't met.
+type GetOrderHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderHistoryRequestValidationError) ErrorName() string {
+	return "GetOrderHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrderHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderHistoryRequestValidationError{}
+
+// Validate checks the field values on GetOrderHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderHistoryResponseMultiError, or nil if none found.
+func (m *GetOrderHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOrderHistoryResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetOrderHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetOrderHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetOrderHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetOrderHistoryResponseValidationError is the validation error returned by
+// GetOrderHistoryResponse.Validate if the designated constraints aren't met.
+type GetOrderHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderHistoryResponseValidationError) ErrorName() string {
+	return "GetOrderHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrderHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderHistoryResponseValidationError{}
+
+// Validate checks the field values on OrderEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderEventMultiError, or
+// nil if none found.
+func (m *OrderEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for StatusFrom
+
+	// no validation rules for StatusTo
+
+	// no validation rules for Reason
+
+	// no validation rules for Operator
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderEventValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OrderEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderEventMultiError is an error wrapping multiple validation errors
+// returned by OrderEvent.ValidateAll() if the designated constraints aren't met.
+type OrderEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderEventMultiError) AllErrors() []error { return m }
+
+// OrderEventValidationError is the validation error returned by
+// OrderEvent.Validate if the designated constraints aren't met.
+type OrderEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderEventValidationError) ErrorName() string { return "OrderEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderEventValidationError{}
+
+// Validate checks the field values on Order with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Order) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Order with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in OrderMultiError, or nil if none found.
+func (m *Order) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Order) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for CustomerId
+
+	if all {
+		switch v := interface{}(m.GetExpirationTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "ExpirationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "ExpirationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpirationTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "ExpirationTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Received
+
+	// no validation rules for Refunded
+
+	// no validation rules for PackageType
+
+	// no validation rules for Weight
+
+	// no validation rules for Cost
+
+	// no validation rules for PackCost
+
+	if all {
+		switch v := interface{}(m.GetCostMoney()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "CostMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "CostMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCostMoney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "CostMoney",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPackCostMoney()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "PackCostMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "PackCostMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPackCostMoney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "PackCostMoney",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotalCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "TotalCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "TotalCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotalCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "TotalCost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExternal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "External",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "External",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExternal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "External",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRefundedTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "RefundedTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "RefundedTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefundedTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "RefundedTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetTryOnUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "TryOnUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderValidationError{
+					field:  "TryOnUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTryOnUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderValidationError{
+				field:  "TryOnUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Cell
+
+	if len(errors) > 0 {
+		return OrderMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderMultiError is an error wrapping multiple validation errors returned by
+// Order.ValidateAll() if the designated constraints aren't met.
+type OrderMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderMultiError) AllErrors() []error { return m }
+
+// OrderValidationError is the validation error returned by Order.Validate if
+// the designated constraints aren't met.
+type OrderValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderValidationError) ErrorName() string { return "OrderValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrder.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderValidationError{}
+
+// Validate checks the field values on OrderItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderItemMultiError, or nil
+// if none found.
+func (m *OrderItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ItemId
+
+	if utf8.RuneCountInString(m.GetSku()) > 64 {
+		err := OrderItemValidationError{
+			field:  "Sku",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 256 {
+		err := OrderItemValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() == nil {
+		err := OrderItemValidationError{
+			field:  "Price",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderItemValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderItemValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderItemValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return OrderItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderItemMultiError is an error wrapping multiple validation errors returned
+// by OrderItem.ValidateAll() if the designated constraints aren't met.
+type OrderItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderItemMultiError) AllErrors() []error { return m }
+
+// OrderItemValidationError is the validation error returned by
+// OrderItem.Validate if the designated constraints aren't met.
+type OrderItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderItemValidationError) ErrorName() string { return "OrderItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderItemValidationError{}
+
+// Validate checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Money) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MoneyMultiError, or nil if none found.
+func (m *Money) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Money) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAmountMinor() < 0 {
+		err := MoneyValidationError{
+			field:  "AmountMinor",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_Money_Currency_Pattern.MatchString(m.GetCurrency()) {
+		err := MoneyValidationError{
+			field:  "Currency",
+			reason: "value does not match regex pattern \"^([A-Z]{3})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MoneyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoneyMultiError is an error wrapping multiple validation errors returned by
+// Money.ValidateAll() if the designated constraints aren't met.
+type MoneyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoneyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoneyMultiError) AllErrors() []error { return m }
+
+// MoneyValidationError is the validation error returned by Money.Validate if
+// the designated constraints aren't met.
+type MoneyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoneyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoneyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoneyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoneyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoneyValidationError) ErrorName() string { return "MoneyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoneyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoney.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoneyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoneyValidationError{}
+
+var _Money_Currency_Pattern = regexp.MustCompile("^([A-Z]{3})?$")
+
+// Validate checks the field values on Dimensions with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Dimensions) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Dimensions with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DimensionsMultiError, or
+// nil if none found.
+func (m *Dimensions) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Dimensions) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetLengthCm() < 0 {
+		err := DimensionsValidationError{
+			field:  "LengthCm",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWidthCm() < 0 {
+		err := DimensionsValidationError{
+			field:  "WidthCm",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetHeightCm() < 0 {
+		err := DimensionsValidationError{
+			field:  "HeightCm",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DimensionsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DimensionsMultiError is an error wrapping multiple validation errors
+// returned by Dimensions.ValidateAll() if the designated constraints aren't met.
+type DimensionsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DimensionsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DimensionsMultiError) AllErrors() []error { return m }
+
+// DimensionsValidationError is the validation error returned by
+// Dimensions.Validate if the designated constraints aren't met.
+type DimensionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DimensionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DimensionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DimensionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DimensionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DimensionsValidationError) ErrorName() string { return "DimensionsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DimensionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDimensions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DimensionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DimensionsValidationError{}
+
+// Validate checks the field values on PackageType with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PackageType) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PackageType with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PackageTypeMultiError, or
+// nil if none found.
+func (m *PackageType) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PackageType) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_PackageType_Name_Pattern.MatchString(m.GetName()) {
+		err := PackageTypeValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z][a-z0-9_-]{0,31}$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPrice() == nil {
+		err := PackageTypeValidationError{
+			field:  "Price",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PackageTypeValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PackageTypeValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PackageTypeValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMinWeight() < 0 {
+		err := PackageTypeValidationError{
+			field:  "MinWeight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMaxWeight() < 0 {
+		err := PackageTypeValidationError{
+			field:  "MaxWeight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDimensions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PackageTypeValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PackageTypeValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDimensions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PackageTypeValidationError{
+				field:  "Dimensions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AllowWrap
+
+	if all {
+		switch v := interface{}(m.GetRetiredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PackageTypeValidationError{
+					field:  "RetiredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PackageTypeValidationError{
+					field:  "RetiredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetiredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PackageTypeValidationError{
+				field:  "RetiredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Kind
+
+	if len(errors) > 0 {
+		return PackageTypeMultiError(errors)
+	}
+
+	return nil
+}
+
+// PackageTypeMultiError is an error wrapping multiple validation errors
+// returned by PackageType.ValidateAll() if the designated constraints aren't met.
+type PackageTypeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PackageTypeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PackageTypeMultiError) AllErrors() []error { return m }
+
+// PackageTypeValidationError is the validation error returned by
+// PackageType.Validate if the designated constraints aren't met.
+type PackageTypeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PackageTypeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PackageTypeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PackageTypeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PackageTypeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PackageTypeValidationError) ErrorName() string { return "PackageTypeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PackageTypeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPackageType.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PackageTypeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PackageTypeValidationError{}
+
+var _PackageType_Name_Pattern = regexp.MustCompile("^[a-z][a-z0-9_-]{0,31}$")
+
+// Validate checks the field values on CreatePackageTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePackageTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePackageTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePackageTypeRequestMultiError, or nil if none found.
+func (m *CreatePackageTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePackageTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPackageType() == nil {
+		err := CreatePackageTypeRequestValidationError{
+			field:  "PackageType",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPackageType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePackageTypeRequestValidationError{
+					field:  "PackageType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePackageTypeRequestValidationError{
+					field:  "PackageType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPackageType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePackageTypeRequestValidationError{
+				field:  "PackageType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePackageTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePackageTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by CreatePackageTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreatePackageTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePackageTypeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePackageTypeRequestMultiError) AllErrors() []error { return m }
+
+// CreatePackageTypeRequestValidationError is the validation error returned by
+// CreatePackageTypeRequest.Validate if the designated constraints aren't met.
+type CreatePackageTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePackageTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePackageTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePackageTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePackageTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePackageTypeRequestValidationError) ErrorName() string {
+	return "CreatePackageTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePackageTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePackageTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePackageTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePackageTypeRequestValidationError{}
+
+// Validate checks the field values on CreatePackageTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreatePackageTypeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreatePackageTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreatePackageTypeResponseMultiError, or nil if none found.
+func (m *CreatePackageTypeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreatePackageTypeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPackageType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreatePackageTypeResponseValidationError{
+					field:  "PackageType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreatePackageTypeResponseValidationError{
+					field:  "PackageType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPackageType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreatePackageTypeResponseValidationError{
+				field:  "PackageType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreatePackageTypeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreatePackageTypeResponseMultiError is an error wrapping multiple validation
+// errors returned by CreatePackageTypeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CreatePackageTypeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreatePackageTypeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreatePackageTypeResponseMultiError) AllErrors() []error { return m }
+
+// CreatePackageTypeResponseValidationError is the validation error returned by
+// CreatePackageTypeResponse.Validate if the designated constraints aren't met.
+type CreatePackageTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreatePackageTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreatePackageTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreatePackageTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreatePackageTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreatePackageTypeResponseValidationError) ErrorName() string {
+	return "CreatePackageTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreatePackageTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreatePackageTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreatePackageTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreatePackageTypeResponseValidationError{}
+
+// Validate checks the field values on UpdatePackageTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePackageTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePackageTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePackageTypeRequestMultiError, or nil if none found.
+func (m *UpdatePackageTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePackageTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPackageType() == nil {
+		err := UpdatePackageTypeRequestValidationError{
+			field:  "PackageType",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPackageType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePackageTypeRequestValidationError{
+					field:  "PackageType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePackageTypeRequestValidationError{
+					field:  "PackageType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPackageType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePackageTypeRequestValidationError{
+				field:  "PackageType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePackageTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePackageTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePackageTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePackageTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePackageTypeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePackageTypeRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePackageTypeRequestValidationError is the validation error returned by
+// UpdatePackageTypeRequest.Validate if the designated constraints aren't met.
+type UpdatePackageTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePackageTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePackageTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePackageTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePackageTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePackageTypeRequestValidationError) ErrorName() string {
+	return "UpdatePackageTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePackageTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePackageTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePackageTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePackageTypeRequestValidationError{}
+
+// Validate checks the field values on UpdatePackageTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePackageTypeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePackageTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePackageTypeResponseMultiError, or nil if none found.
+func (m *UpdatePackageTypeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePackageTypeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPackageType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePackageTypeResponseValidationError{
+					field:  "PackageType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePackageTypeResponseValidationError{
+					field:  "PackageType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPackageType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePackageTypeResponseValidationError{
+				field:  "PackageType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePackageTypeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePackageTypeResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdatePackageTypeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdatePackageTypeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePackageTypeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePackageTypeResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePackageTypeResponseValidationError is the validation error returned by
+// UpdatePackageTypeResponse.Validate if the designated constraints aren't met.
+type UpdatePackageTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePackageTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePackageTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePackageTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePackageTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePackageTypeResponseValidationError) ErrorName() string {
+	return "UpdatePackageTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePackageTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePackageTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePackageTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePackageTypeResponseValidationError{}
+
+// Validate checks the field values on RetirePackageTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetirePackageTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetirePackageTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetirePackageTypeRequestMultiError, or nil if none found.
+func (m *RetirePackageTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetirePackageTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := RetirePackageTypeRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RetirePackageTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetirePackageTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by RetirePackageTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RetirePackageTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetirePackageTypeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetirePackageTypeRequestMultiError) AllErrors() []error { return m }
+
+// RetirePackageTypeRequestValidationError is the validation error returned by
+// RetirePackageTypeRequest.Validate if the designated constraints aren't met.
+type RetirePackageTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetirePackageTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetirePackageTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetirePackageTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetirePackageTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetirePackageTypeRequestValidationError) ErrorName() string {
+	return "RetirePackageTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetirePackageTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetirePackageTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetirePackageTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetirePackageTypeRequestValidationError{}
+
+// Validate checks the field values on RetirePackageTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RetirePackageTypeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetirePackageTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RetirePackageTypeResponseMultiError, or nil if none found.
+func (m *RetirePackageTypeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetirePackageTypeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPackageType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RetirePackageTypeResponseValidationError{
+					field:  "PackageType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RetirePackageTypeResponseValidationError{
+					field:  "PackageType",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPackageType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RetirePackageTypeResponseValidationError{
+				field:  "PackageType",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RetirePackageTypeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RetirePackageTypeResponseMultiError is an error wrapping multiple validation
+// errors returned by RetirePackageTypeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type RetirePackageTypeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RetirePackageTypeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RetirePackageTypeResponseMultiError) AllErrors() []error { return m }
+
+// RetirePackageTypeResponseValidationError is the validation error returned by
+// RetirePackageTypeResponse.Validate if the designated constraints aren't met.
+type RetirePackageTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RetirePackageTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RetirePackageTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RetirePackageTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RetirePackageTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RetirePackageTypeResponseValidationError) ErrorName() string {
+	return "RetirePackageTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RetirePackageTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRetirePackageTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RetirePackageTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RetirePackageTypeResponseValidationError{}
+
+// Validate checks the field values on ListPackageTypesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPackageTypesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPackageTypesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPackageTypesRequestMultiError, or nil if none found.
+func (m *ListPackageTypesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPackageTypesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IncludeRetired
+
+	if len(errors) > 0 {
+		return ListPackageTypesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPackageTypesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListPackageTypesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListPackageTypesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPackageTypesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPackageTypesRequestMultiError) AllErrors() []error { return m }
+
+// ListPackageTypesRequestValidationError is the validation error returned by
+// ListPackageTypesRequest.Validate if the designated constraints aren't met.
+type ListPackageTypesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPackageTypesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPackageTypesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPackageTypesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPackageTypesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPackageTypesRequestValidationError) ErrorName() string {
+	return "ListPackageTypesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPackageTypesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPackageTypesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPackageTypesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPackageTypesRequestValidationError{}
+
+// Validate checks the field values on ListPackageTypesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListPackageTypesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListPackageTypesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListPackageTypesResponseMultiError, or nil if none found.
+func (m *ListPackageTypesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListPackageTypesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPackageTypes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListPackageTypesResponseValidationError{
+						field:  fmt.Sprintf("PackageTypes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListPackageTypesResponseValidationError{
+						field:  fmt.Sprintf("PackageTypes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListPackageTypesResponseValidationError{
+					field:  fmt.Sprintf("PackageTypes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListPackageTypesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListPackageTypesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListPackageTypesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListPackageTypesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListPackageTypesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListPackageTypesResponseMultiError) AllErrors() []error { return m }
+
+// ListPackageTypesResponseValidationError is the validation error returned by
+// ListPackageTypesResponse.Validate if the designated constraints aren't met.
+type ListPackageTypesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListPackageTypesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListPackageTypesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListPackageTypesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListPackageTypesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListPackageTypesResponseValidationError) ErrorName() string {
+	return "ListPackageTypesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListPackageTypesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListPackageTypesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListPackageTypesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListPackageTypesResponseValidationError{}
+
+// Validate checks the field values on GetQuoteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetQuoteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQuoteRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQuoteRequestMultiError, or nil if none found.
+func (m *GetQuoteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQuoteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetWeight() < 0 {
+		err := GetQuoteRequestValidationError{
+			field:  "Weight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDimensions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetQuoteRequestValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetQuoteRequestValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDimensions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetQuoteRequestValidationError{
+				field:  "Dimensions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCostMoney()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetQuoteRequestValidationError{
+					field:  "CostMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetQuoteRequestValidationError{
+					field:  "CostMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCostMoney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetQuoteRequestValidationError{
+				field:  "CostMoney",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetQuoteRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQuoteRequestMultiError is an error wrapping multiple validation errors
+// returned by GetQuoteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetQuoteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQuoteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQuoteRequestMultiError) AllErrors() []error { return m }
+
+// GetQuoteRequestValidationError is the validation error returned by
+// GetQuoteRequest.Validate if the designated constraints aren't met.
+type GetQuoteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQuoteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQuoteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQuoteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQuoteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQuoteRequestValidationError) ErrorName() string { return "GetQuoteRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetQuoteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQuoteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQuoteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQuoteRequestValidationError{}
+
+// Validate checks the field values on PackageQuote with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PackageQuote) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PackageQuote with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PackageQuoteMultiError, or
+// nil if none found.
+func (m *PackageQuote) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PackageQuote) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PackageType
+
+	if all {
+		switch v := interface{}(m.GetPackCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PackageQuoteValidationError{
+					field:  "PackCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PackageQuoteValidationError{
+					field:  "PackCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPackCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PackageQuoteValidationError{
+				field:  "PackCost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotalCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PackageQuoteValidationError{
+					field:  "TotalCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PackageQuoteValidationError{
+					field:  "TotalCost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotalCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PackageQuoteValidationError{
+				field:  "TotalCost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PackageQuoteMultiError(errors)
+	}
+
+	return nil
+}
+
+// PackageQuoteMultiError is an error wrapping multiple validation errors
+// returned by PackageQuote.ValidateAll() if the designated constraints aren't met.
+type PackageQuoteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PackageQuoteMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PackageQuoteMultiError) AllErrors() []error { return m }
+
+// PackageQuoteValidationError is the validation error returned by
+// PackageQuote.Validate if the designated constraints aren't met.
+type PackageQuoteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PackageQuoteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PackageQuoteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PackageQuoteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PackageQuoteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PackageQuoteValidationError) ErrorName() string { return "PackageQuoteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PackageQuoteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPackageQuote.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PackageQuoteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PackageQuoteValidationError{}
+
+// Validate checks the field values on GetQuoteResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetQuoteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetQuoteResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetQuoteResponseMultiError, or nil if none found.
+func (m *GetQuoteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetQuoteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetOptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetQuoteResponseValidationError{
+						field:  fmt.Sprintf("Options[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetQuoteResponseValidationError{
+						field:  fmt.Sprintf("Options[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetQuoteResponseValidationError{
+					field:  fmt.Sprintf("Options[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetQuoteResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetQuoteResponseMultiError is an error wrapping multiple validation errors
+// returned by GetQuoteResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetQuoteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetQuoteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetQuoteResponseMultiError) AllErrors() []error { return m }
+
+// GetQuoteResponseValidationError is the validation error returned by
+// GetQuoteResponse.Validate if the designated constraints aren't met.
+type GetQuoteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetQuoteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetQuoteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetQuoteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetQuoteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetQuoteResponseValidationError) ErrorName() string { return "GetQuoteResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetQuoteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetQuoteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetQuoteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetQuoteResponseValidationError{}
@@ -20,24 +20,51 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	OrdersService_AddOrder_FullMethodName      = "/orders_grpc.OrdersService/AddOrder"
-	OrdersService_ReturnOrder_FullMethodName   = "/orders_grpc.OrdersService/ReturnOrder"
-	OrdersService_ReceiveOrders_FullMethodName = "/orders_grpc.OrdersService/ReceiveOrders"
-	OrdersService_GetOrders_FullMethodName     = "/orders_grpc.OrdersService/GetOrders"
-	OrdersService_CreateRefund_FullMethodName  = "/orders_grpc.OrdersService/CreateRefund"
-	OrdersService_GetRefunds_FullMethodName    = "/orders_grpc.OrdersService/GetRefunds"
+	OrdersService_AddOrder_FullMethodName          = "/orders_grpc.OrdersService/AddOrder"
+	OrdersService_ReturnOrder_FullMethodName       = "/orders_grpc.OrdersService/ReturnOrder"
+	OrdersService_MoveOrder_FullMethodName         = "/orders_grpc.OrdersService/MoveOrder"
+	OrdersService_ReceiveOrders_FullMethodName     = "/orders_grpc.OrdersService/ReceiveOrders"
+	OrdersService_StartTryOn_FullMethodName        = "/orders_grpc.OrdersService/StartTryOn"
+	OrdersService_ConfirmTryOn_FullMethodName      = "/orders_grpc.OrdersService/ConfirmTryOn"
+	OrdersService_GetOrders_FullMethodName         = "/orders_grpc.OrdersService/GetOrders"
+	OrdersService_CreateRefund_FullMethodName      = "/orders_grpc.OrdersService/CreateRefund"
+	OrdersService_DecideRefund_FullMethodName      = "/orders_grpc.OrdersService/DecideRefund"
+	OrdersService_GetRefund_FullMethodName         = "/orders_grpc.OrdersService/GetRefund"
+	OrdersService_GetRefunds_FullMethodName        = "/orders_grpc.OrdersService/GetRefunds"
+	OrdersService_GetOrderHistory_FullMethodName   = "/orders_grpc.OrdersService/GetOrderHistory"
+	OrdersService_CreatePackageType_FullMethodName = "/orders_grpc.OrdersService/CreatePackageType"
+	OrdersService_UpdatePackageType_FullMethodName = "/orders_grpc.OrdersService/UpdatePackageType"
+	OrdersService_RetirePackageType_FullMethodName = "/orders_grpc.OrdersService/RetirePackageType"
+	OrdersService_ListPackageTypes_FullMethodName  = "/orders_grpc.OrdersService/ListPackageTypes"
+	OrdersService_GetQuote_FullMethodName          = "/orders_grpc.OrdersService/GetQuote"
 )
 
 // OrdersServiceClient is the client API for OrdersService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OrdersServiceClient interface {
-	AddOrder(ctx context.Context, in *AddOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddOrder(ctx context.Context, in *AddOrderRequest, opts ...grpc.CallOption) (*AddOrderResponse, error)
 	ReturnOrder(ctx context.Context, in *ReturnOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Перекладывает заказ в другую ячейку хранения или кладет в ячейку заказ, принятый без нее.
+	MoveOrder(ctx context.Context, in *MoveOrderRequest, opts ...grpc.CallOption) (*MoveOrderResponse, error)
 	ReceiveOrders(ctx context.Context, in *ReceiveOrdersRequest, opts ...grpc.CallOption) (*ReceiveOrdersResponse, error)
+	// Отдает заказы клиенту в примерочную. Заказы резервируются до try_on_until, решение по ним принимает ConfirmTryOn.
+	StartTryOn(ctx context.Context, in *StartTryOnRequest, opts ...grpc.CallOption) (*StartTryOnResponse, error)
+	ConfirmTryOn(ctx context.Context, in *ConfirmTryOnRequest, opts ...grpc.CallOption) (*ConfirmTryOnResponse, error)
 	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
-	CreateRefund(ctx context.Context, in *CreateRefundRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateRefund(ctx context.Context, in *CreateRefundRequest, opts ...grpc.CallOption) (*CreateRefundResponse, error)
+	DecideRefund(ctx context.Context, in *DecideRefundRequest, opts ...grpc.CallOption) (*DecideRefundResponse, error)
+	GetRefund(ctx context.Context, in *GetRefundRequest, opts ...grpc.CallOption) (*GetRefundResponse, error)
 	GetRefunds(ctx context.Context, in *GetRefundsRequest, opts ...grpc.CallOption) (*GetRefundsResponse, error)
+	GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error)
+	// Администрирование каталога упаковок. Каталог общий для всех пунктов, поэтому методы доступны только сотрудникам
+	// с ролью администратора в токене.
+	CreatePackageType(ctx context.Context, in *CreatePackageTypeRequest, opts ...grpc.CallOption) (*CreatePackageTypeResponse, error)
+	UpdatePackageType(ctx context.Context, in *UpdatePackageTypeRequest, opts ...grpc.CallOption) (*UpdatePackageTypeResponse, error)
+	RetirePackageType(ctx context.Context, in *RetirePackageTypeRequest, opts ...grpc.CallOption) (*RetirePackageTypeResponse, error)
+	ListPackageTypes(ctx context.Context, in *ListPackageTypesRequest, opts ...grpc.CallOption) (*ListPackageTypesResponse, error)
+	// Подбор упаковки до приема заказа: подходящие варианты из каталога по возрастанию итоговой стоимости.
+	GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error)
 }
 
 type ordersServiceClient struct {
@@ -48,9 +75,9 @@ func NewOrdersServiceClient(cc grpc.ClientConnInterface) OrdersServiceClient {
 	return &ordersServiceClient{cc}
 }
 
-func (c *ordersServiceClient) AddOrder(ctx context.Context, in *AddOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *ordersServiceClient) AddOrder(ctx context.Context, in *AddOrderRequest, opts ...grpc.CallOption) (*AddOrderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(AddOrderResponse)
 	err := c.cc.Invoke(ctx, OrdersService_AddOrder_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -68,6 +95,16 @@ func (c *ordersServiceClient) ReturnOrder(ctx context.Context, in *ReturnOrderRe
 	return out, nil
 }
 
+func (c *ordersServiceClient) MoveOrder(ctx context.Context, in *MoveOrderRequest, opts ...grpc.CallOption) (*MoveOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveOrderResponse)
+	err := c.cc.Invoke(ctx, OrdersService_MoveOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ordersServiceClient) ReceiveOrders(ctx context.Context, in *ReceiveOrdersRequest, opts ...grpc.CallOption) (*ReceiveOrdersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReceiveOrdersResponse)
@@ -78,6 +115,26 @@ func (c *ordersServiceClient) ReceiveOrders(ctx context.Context, in *ReceiveOrde
 	return out, nil
 }
 
+func (c *ordersServiceClient) StartTryOn(ctx context.Context, in *StartTryOnRequest, opts ...grpc.CallOption) (*StartTryOnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartTryOnResponse)
+	err := c.cc.Invoke(ctx, OrdersService_StartTryOn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ConfirmTryOn(ctx context.Context, in *ConfirmTryOnRequest, opts ...grpc.CallOption) (*ConfirmTryOnResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmTryOnResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ConfirmTryOn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ordersServiceClient) GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetOrdersResponse)
@@ -88,9 +145,9 @@ func (c *ordersServiceClient) GetOrders(ctx context.Context, in *GetOrdersReques
 	return out, nil
 }
 
-func (c *ordersServiceClient) CreateRefund(ctx context.Context, in *CreateRefundRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *ordersServiceClient) CreateRefund(ctx context.Context, in *CreateRefundRequest, opts ...grpc.CallOption) (*CreateRefundResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(CreateRefundResponse)
 	err := c.cc.Invoke(ctx, OrdersService_CreateRefund_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -98,6 +155,26 @@ func (c *ordersServiceClient) CreateRefund(ctx context.Context, in *CreateRefund
 	return out, nil
 }
 
+func (c *ordersServiceClient) DecideRefund(ctx context.Context, in *DecideRefundRequest, opts ...grpc.CallOption) (*DecideRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecideRefundResponse)
+	err := c.cc.Invoke(ctx, OrdersService_DecideRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetRefund(ctx context.Context, in *GetRefundRequest, opts ...grpc.CallOption) (*GetRefundResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRefundResponse)
+	err := c.cc.Invoke(ctx, OrdersService_GetRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ordersServiceClient) GetRefunds(ctx context.Context, in *GetRefundsRequest, opts ...grpc.CallOption) (*GetRefundsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetRefundsResponse)
@@ -108,16 +185,92 @@ func (c *ordersServiceClient) GetRefunds(ctx context.Context, in *GetRefundsRequ
 	return out, nil
 }
 
+func (c *ordersServiceClient) GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderHistoryResponse)
+	err := c.cc.Invoke(ctx, OrdersService_GetOrderHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) CreatePackageType(ctx context.Context, in *CreatePackageTypeRequest, opts ...grpc.CallOption) (*CreatePackageTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePackageTypeResponse)
+	err := c.cc.Invoke(ctx, OrdersService_CreatePackageType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) UpdatePackageType(ctx context.Context, in *UpdatePackageTypeRequest, opts ...grpc.CallOption) (*UpdatePackageTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePackageTypeResponse)
+	err := c.cc.Invoke(ctx, OrdersService_UpdatePackageType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) RetirePackageType(ctx context.Context, in *RetirePackageTypeRequest, opts ...grpc.CallOption) (*RetirePackageTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetirePackageTypeResponse)
+	err := c.cc.Invoke(ctx, OrdersService_RetirePackageType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) ListPackageTypes(ctx context.Context, in *ListPackageTypesRequest, opts ...grpc.CallOption) (*ListPackageTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPackageTypesResponse)
+	err := c.cc.Invoke(ctx, OrdersService_ListPackageTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ordersServiceClient) GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*GetQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetQuoteResponse)
+	err := c.cc.Invoke(ctx, OrdersService_GetQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrdersServiceServer is the server API for OrdersService service.
 // All implementations must embed UnimplementedOrdersServiceServer
 // for forward compatibility
 type OrdersServiceServer interface {
-	AddOrder(context.Context, *AddOrderRequest) (*emptypb.Empty, error)
+	AddOrder(context.Context, *AddOrderRequest) (*AddOrderResponse, error)
 	ReturnOrder(context.Context, *ReturnOrderRequest) (*emptypb.Empty, error)
+	// Перекладывает заказ в другую ячейку хранения или кладет в ячейку заказ, принятый без нее.
+	MoveOrder(context.Context, *MoveOrderRequest) (*MoveOrderResponse, error)
 	ReceiveOrders(context.Context, *ReceiveOrdersRequest) (*ReceiveOrdersResponse, error)
+	// Отдает заказы клиенту в примерочную. Заказы резервируются до try_on_until, решение по ним принимает ConfirmTryOn.
+	StartTryOn(context.Context, *StartTryOnRequest) (*StartTryOnResponse, error)
+	ConfirmTryOn(context.Context, *ConfirmTryOnRequest) (*ConfirmTryOnResponse, error)
 	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
-	CreateRefund(context.Context, *CreateRefundRequest) (*emptypb.Empty, error)
+	CreateRefund(context.Context, *CreateRefundRequest) (*CreateRefundResponse, error)
+	DecideRefund(context.Context, *DecideRefundRequest) (*DecideRefundResponse, error)
+	GetRefund(context.Context, *GetRefundRequest) (*GetRefundResponse, error)
 	GetRefunds(context.Context, *GetRefundsRequest) (*GetRefundsResponse, error)
+	GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error)
+	// Администрирование каталога упаковок. Каталог общий для всех пунктов, поэтому методы доступны только сотрудникам
+	// с ролью администратора в токене.
+	CreatePackageType(context.Context, *CreatePackageTypeRequest) (*CreatePackageTypeResponse, error)
+	UpdatePackageType(context.Context, *UpdatePackageTypeRequest) (*UpdatePackageTypeResponse, error)
+	RetirePackageType(context.Context, *RetirePackageTypeRequest) (*RetirePackageTypeResponse, error)
+	ListPackageTypes(context.Context, *ListPackageTypesRequest) (*ListPackageTypesResponse, error)
+	// Подбор упаковки до приема заказа: подходящие варианты из каталога по возрастанию итоговой стоимости.
+	GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error)
 	mustEmbedUnimplementedOrdersServiceServer()
 }
 
@@ -125,24 +278,57 @@ type OrdersServiceServer interface {
 type UnimplementedOrdersServiceServer struct {
 }
 
-func (UnimplementedOrdersServiceServer) AddOrder(context.Context, *AddOrderRequest) (*emptypb.Empty, error) {
+func (UnimplementedOrdersServiceServer) AddOrder(context.Context, *AddOrderRequest) (*AddOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddOrder not implemented")
 }
 func (UnimplementedOrdersServiceServer) ReturnOrder(context.Context, *ReturnOrderRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReturnOrder not implemented")
 }
+func (UnimplementedOrdersServiceServer) MoveOrder(context.Context, *MoveOrderRequest) (*MoveOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveOrder not implemented")
+}
 func (UnimplementedOrdersServiceServer) ReceiveOrders(context.Context, *ReceiveOrdersRequest) (*ReceiveOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReceiveOrders not implemented")
 }
+func (UnimplementedOrdersServiceServer) StartTryOn(context.Context, *StartTryOnRequest) (*StartTryOnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTryOn not implemented")
+}
+func (UnimplementedOrdersServiceServer) ConfirmTryOn(context.Context, *ConfirmTryOnRequest) (*ConfirmTryOnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTryOn not implemented")
+}
 func (UnimplementedOrdersServiceServer) GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
 }
-func (UnimplementedOrdersServiceServer) CreateRefund(context.Context, *CreateRefundRequest) (*emptypb.Empty, error) {
+func (UnimplementedOrdersServiceServer) CreateRefund(context.Context, *CreateRefundRequest) (*CreateRefundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRefund not implemented")
 }
+func (UnimplementedOrdersServiceServer) DecideRefund(context.Context, *DecideRefundRequest) (*DecideRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecideRefund not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetRefund(context.Context, *GetRefundRequest) (*GetRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefund not implemented")
+}
 func (UnimplementedOrdersServiceServer) GetRefunds(context.Context, *GetRefundsRequest) (*GetRefundsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRefunds not implemented")
 }
+func (UnimplementedOrdersServiceServer) GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
+}
+func (UnimplementedOrdersServiceServer) CreatePackageType(context.Context, *CreatePackageTypeRequest) (*CreatePackageTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePackageType not implemented")
+}
+func (UnimplementedOrdersServiceServer) UpdatePackageType(context.Context, *UpdatePackageTypeRequest) (*UpdatePackageTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePackageType not implemented")
+}
+func (UnimplementedOrdersServiceServer) RetirePackageType(context.Context, *RetirePackageTypeRequest) (*RetirePackageTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetirePackageType not implemented")
+}
+func (UnimplementedOrdersServiceServer) ListPackageTypes(context.Context, *ListPackageTypesRequest) (*ListPackageTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPackageTypes not implemented")
+}
+func (UnimplementedOrdersServiceServer) GetQuote(context.Context, *GetQuoteRequest) (*GetQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuote not implemented")
+}
 func (UnimplementedOrdersServiceServer) mustEmbedUnimplementedOrdersServiceServer() {}
 
 // UnsafeOrdersServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -192,6 +378,24 @@ func _OrdersService_ReturnOrder_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersService_MoveOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).MoveOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_MoveOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).MoveOrder(ctx, req.(*MoveOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrdersService_ReceiveOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReceiveOrdersRequest)
 	if err := dec(in); err != nil {
@@ -210,6 +414,42 @@ func _OrdersService_ReceiveOrders_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersService_StartTryOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTryOnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).StartTryOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_StartTryOn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).StartTryOn(ctx, req.(*StartTryOnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ConfirmTryOn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTryOnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ConfirmTryOn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ConfirmTryOn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ConfirmTryOn(ctx, req.(*ConfirmTryOnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrdersService_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetOrdersRequest)
 	if err := dec(in); err != nil {
@@ -246,6 +486,42 @@ func _OrdersService_CreateRefund_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersService_DecideRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecideRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).DecideRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_DecideRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).DecideRefund(ctx, req.(*DecideRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetRefund(ctx, req.(*GetRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrdersService_GetRefunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRefundsRequest)
 	if err := dec(in); err != nil {
@@ -264,6 +540,114 @@ func _OrdersService_GetRefunds_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrdersService_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetOrderHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetOrderHistory(ctx, req.(*GetOrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_CreatePackageType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePackageTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).CreatePackageType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_CreatePackageType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).CreatePackageType(ctx, req.(*CreatePackageTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_UpdatePackageType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePackageTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).UpdatePackageType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_UpdatePackageType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).UpdatePackageType(ctx, req.(*UpdatePackageTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_RetirePackageType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetirePackageTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).RetirePackageType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_RetirePackageType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).RetirePackageType(ctx, req.(*RetirePackageTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_ListPackageTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPackageTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).ListPackageTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_ListPackageTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).ListPackageTypes(ctx, req.(*ListPackageTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrdersService_GetQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrdersServiceServer).GetQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrdersService_GetQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrdersServiceServer).GetQuote(ctx, req.(*GetQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrdersService_ServiceDesc is the grpc.ServiceDesc for OrdersService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -279,10 +663,22 @@ var OrdersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReturnOrder",
 			Handler:    _OrdersService_ReturnOrder_Handler,
 		},
+		{
+			MethodName: "MoveOrder",
+			Handler:    _OrdersService_MoveOrder_Handler,
+		},
 		{
 			MethodName: "ReceiveOrders",
 			Handler:    _OrdersService_ReceiveOrders_Handler,
 		},
+		{
+			MethodName: "StartTryOn",
+			Handler:    _OrdersService_StartTryOn_Handler,
+		},
+		{
+			MethodName: "ConfirmTryOn",
+			Handler:    _OrdersService_ConfirmTryOn_Handler,
+		},
 		{
 			MethodName: "GetOrders",
 			Handler:    _OrdersService_GetOrders_Handler,
@@ -291,10 +687,42 @@ var OrdersService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateRefund",
 			Handler:    _OrdersService_CreateRefund_Handler,
 		},
+		{
+			MethodName: "DecideRefund",
+			Handler:    _OrdersService_DecideRefund_Handler,
+		},
+		{
+			MethodName: "GetRefund",
+			Handler:    _OrdersService_GetRefund_Handler,
+		},
 		{
 			MethodName: "GetRefunds",
 			Handler:    _OrdersService_GetRefunds_Handler,
 		},
+		{
+			MethodName: "GetOrderHistory",
+			Handler:    _OrdersService_GetOrderHistory_Handler,
+		},
+		{
+			MethodName: "CreatePackageType",
+			Handler:    _OrdersService_CreatePackageType_Handler,
+		},
+		{
+			MethodName: "UpdatePackageType",
+			Handler:    _OrdersService_UpdatePackageType_Handler,
+		},
+		{
+			MethodName: "RetirePackageType",
+			Handler:    _OrdersService_RetirePackageType_Handler,
+		},
+		{
+			MethodName: "ListPackageTypes",
+			Handler:    _OrdersService_ListPackageTypes_Handler,
+		},
+		{
+			MethodName: "GetQuote",
+			Handler:    _OrdersService_GetQuote_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "orders_grpc/v1/orders.proto",